@@ -8,42 +8,110 @@ import (
 	"time"
 
 	httpSwagger "github.com/swaggo/http-swagger"
+	"github.com/zouipo/yumsday/backend/internal/constant"
 	"github.com/zouipo/yumsday/backend/internal/handler"
 	"github.com/zouipo/yumsday/backend/internal/middleware"
 	"github.com/zouipo/yumsday/backend/internal/migration"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
 	"github.com/zouipo/yumsday/backend/internal/repository"
 	"github.com/zouipo/yumsday/backend/internal/service"
 	_ "github.com/zouipo/yumsday/docs"
 	"github.com/zouipo/yumsday/front"
 )
 
-// NewAPIServer registers API routes on a new ServeMux.
-func NewAPIServer(db *sql.DB, migrationsFs fs.FS, tasksWG *sync.WaitGroup) http.Handler {
+// NewAPIServer migrates the database and registers API routes on a new ServeMux.
+func NewAPIServer(db *sql.DB, migrationsFs fs.FS, tasksWG *sync.WaitGroup) (http.Handler, error) {
 	err := migration.Migrate(db, migrationsFs)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	// Initializing every layers
 	userRepo := repository.NewUserRepository(db)
 	userService := service.NewUserService(userRepo)
-	userHandler := handler.NewUserHandler(userService)
+
+	apiTokenRepo := repository.NewAPITokenRepository(db)
+	apiTokenService := service.NewAPITokenService(apiTokenRepo)
 
 	sessionRepo := repository.NewSessionRepository(db)
 	sessionService := service.NewSessionService(
 		sessionRepo,
+		apiTokenRepo,
 		"yumsday_session",
 		30*24*time.Hour,
 	)
 	sessionInjector := middleware.SessionInjector(sessionService, tasksWG)
+	userHandler := handler.NewUserHandler(userService, sessionService)
+	sessionHandler := handler.NewSessionHandler(sessionService)
+	apiTokenHandler := handler.NewAPITokenHandler(apiTokenService)
+
+	loginThrottleRepo := repository.NewLoginThrottleRepository(db)
+	loginThrottleService := service.NewLoginThrottleService(loginThrottleRepo)
 
-	authService := service.NewAuthService(sessionService, userService)
+	authService := service.NewAuthService(sessionService, userService, loginThrottleService)
 	authHandler := handler.NewAuthHandler(authService)
 
+	groupRepo := repository.NewGroupRepository(db)
+	groupService := service.NewGroupService(groupRepo)
+	groupHandler := handler.NewGroupHandler(groupService)
+
+	groupInvitationRepo := repository.NewGroupInvitationRepository(db)
+	groupInvitationService := service.NewGroupInvitationService(groupInvitationRepo)
+	groupInvitationHandler := handler.NewGroupInvitationHandler(groupInvitationService, groupService)
+
+	itemCategoryRepo := repository.NewItemCategoryRepository(db)
+	itemCategoryService := service.NewItemCategoryService(itemCategoryRepo)
+	itemCategoryHandler := handler.NewItemCategoryHandler(itemCategoryService, groupService)
+
+	unitRepo := repository.NewUnitRepository(db)
+	unitService := service.NewUnitService(unitRepo)
+	unitHandler := handler.NewUnitHandler(unitService, groupService)
+
+	recipeCategoryRepo := repository.NewRecipeCategoryRepository(db)
+	itemRepo := repository.NewItemRepository(db)
+
+	recipeRepo := repository.NewRecipeRepository(db)
+	recipeService := service.NewRecipeService(recipeRepo, itemRepo, unitRepo, recipeCategoryRepo)
+	recipeHandler := handler.NewRecipeHandler(recipeService, groupService)
+
+	recipeImportService := service.NewRecipeImportService(itemRepo, unitRepo, utils.NewPublicHTTPClient(constant.RECIPE_IMPORT_TIMEOUT))
+	recipeImportHandler := handler.NewRecipeImportHandler(recipeImportService, groupService)
+
+	recipeBundleRepo := repository.NewRecipeBundleRepository(db)
+	recipeBundleService := service.NewRecipeBundleService(recipeBundleRepo, recipeRepo, itemRepo, recipeCategoryRepo)
+	recipeBundleHandler := handler.NewRecipeBundleHandler(recipeBundleService, groupService)
+
+	recipeShareService := service.NewRecipeShareService(recipeRepo, recipeBundleService)
+	recipeShareHandler := handler.NewRecipeShareHandler(recipeShareService, groupService)
+
+	pantryRepo := repository.NewPantryRepository(db)
+	pantryService := service.NewPantryService(pantryRepo, itemRepo, unitRepo)
+	pantryHandler := handler.NewPantryHandler(pantryService, groupService)
+
+	dishRepo := repository.NewDishRepository(db)
+	dishService := service.NewDishService(dishRepo, recipeRepo, itemRepo, pantryRepo)
+	dishHandler := handler.NewDishHandler(dishService, groupService)
+
+	recipeCategoryService := service.NewRecipeCategoryService(recipeCategoryRepo, recipeRepo)
+	recipeCategoryHandler := handler.NewRecipeCategoryHandler(recipeCategoryService, groupService)
+
+	groceryRepo := repository.NewGroceryRepository(db)
+	groceryService := service.NewGroceryService(groceryRepo, itemRepo, unitRepo, dishRepo, recipeRepo, pantryRepo)
+	groceryHandler := handler.NewGroceryHandler(groceryService, groupService)
+
+	itemService := service.NewItemService(itemRepo, recipeService, groceryService, groupService, itemCategoryService)
+	itemHandler := handler.NewItemHandler(itemService, groupService)
+
+	searchRepo := repository.NewSearchRepository(db)
+	searchService := service.NewSearchService(searchRepo)
+	searchHandler := handler.NewSearchHandler(searchService, groupService)
+
 	middlewareStack := middleware.Stack(
 		middleware.ResponseWriter,
 		middleware.Logger,
 		sessionInjector,
+		middleware.CSRF(sessionService),
+		middleware.APITokenInjector(apiTokenService, userService),
 		middleware.UserInjector(userService),
 	)
 
@@ -52,19 +120,45 @@ func NewAPIServer(db *sql.DB, migrationsFs fs.FS, tasksWG *sync.WaitGroup) http.
 		middleware.Logger,
 	)
 
+	// Public routes are read-only and don't require any session.
+	publicMiddlewareStack := middleware.Stack(
+		middleware.ResponseWriter,
+		middleware.Logger,
+	)
+
 	// ServeMux = HTTP request multiplexer, a router.
 	// It matches the URL of each incoming request against a list of registered patterns
 	// and calls the handler for the pattern tha most closely matches the URL.
 	mux := http.NewServeMux()
 	backMux := http.NewServeMux()
+	publicMux := http.NewServeMux()
 
 	mux.Handle("/swagger/", swaggerMiddlewareStack(httpSwagger.Handler()))
 	mux.Handle("/api/", middlewareStack(backMux))
 	mux.Handle("/auth/", middlewareStack(backMux))
+	mux.Handle("/public/", publicMiddlewareStack(publicMux))
 
 	userHandler.RegisterRoutes(backMux, "/api/user")
 	authHandler.RegisterRoutes(backMux, "/auth")
+	authHandler.RegisterUserRoutes(backMux, "/api/user")
+	sessionHandler.RegisterRoutes(backMux, "/api/user")
+	apiTokenHandler.RegisterRoutes(backMux, "/api/user/me/tokens")
+	groupHandler.RegisterRoutes(backMux, "/api/group")
+	groupInvitationHandler.RegisterRoutes(backMux, "/api")
+	itemHandler.RegisterRoutes(backMux, "/api/group/{groupId}/item")
+	itemCategoryHandler.RegisterRoutes(backMux, "/api/group/{groupId}/item-category")
+	unitHandler.RegisterRoutes(backMux, "/api/group/{groupId}/unit")
+	recipeHandler.RegisterRoutes(backMux, "/api/group/{groupId}/recipe")
+	recipeImportHandler.RegisterRoutes(backMux, "/api/group/{groupId}/recipe/import")
+	recipeBundleHandler.RegisterRoutes(backMux, "/api/group/{groupId}/recipe/bundle")
+	recipeShareHandler.RegisterRoutes(backMux, "/api")
+	recipeShareHandler.RegisterPublicRoutes(publicMux, "/public/recipe")
+	recipeCategoryHandler.RegisterRoutes(backMux, "/api/group/{groupId}/recipe-category")
+	groceryHandler.RegisterRoutes(backMux, "/api/group/{groupId}/grocery")
+	pantryHandler.RegisterRoutes(backMux, "/api/group/{groupId}/pantry")
+	dishHandler.RegisterRoutes(backMux, "/api/group/{groupId}/dish")
+	searchHandler.RegisterRoutes(backMux, "/api/group/{groupId}/search")
 
 	mux.Handle("/", front.Handler())
-	return mux
+	return mux, nil
 }
@@ -0,0 +1,17 @@
+-- Invitations allowing users to join a group through a shareable token
+CREATE TABLE IF NOT EXISTS group_invitations (
+    id INTEGER PRIMARY KEY NOT NULL UNIQUE,
+    token VARCHAR(255) NOT NULL UNIQUE,
+    group_id INTEGER NOT NULL,
+    created_by INTEGER,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    -- NULL means the invitation can be used an unlimited number of times until it expires
+    max_uses INTEGER,
+    uses INTEGER DEFAULT 0 NOT NULL,
+    revoked BOOLEAN DEFAULT FALSE NOT NULL,
+    FOREIGN KEY (group_id) REFERENCES groups(id),
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_invitations_group_id ON group_invitations(group_id);
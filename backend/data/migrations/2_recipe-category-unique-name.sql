@@ -0,0 +1,24 @@
+-- Recipe categories whose name only differs by its case from an older one of the group are merged into it:
+-- their recipes are moved to the older category, then they are removed
+INSERT OR IGNORE INTO recipes_categories_junction (recipe_id, category_id)
+SELECT junction.recipe_id, (
+    SELECT MIN(kept.id) FROM recipe_categories AS kept
+    WHERE kept.group_id = duplicate.group_id AND kept.name = duplicate.name COLLATE NOCASE
+)
+FROM recipes_categories_junction AS junction
+JOIN recipe_categories AS duplicate ON duplicate.id = junction.category_id;
+
+DELETE FROM recipes_categories_junction WHERE category_id IN (
+    SELECT duplicate.id FROM recipe_categories AS duplicate
+    JOIN recipe_categories AS kept
+    ON kept.group_id = duplicate.group_id AND kept.name = duplicate.name COLLATE NOCASE AND kept.id < duplicate.id
+);
+
+DELETE FROM recipe_categories WHERE id IN (
+    SELECT duplicate.id FROM recipe_categories AS duplicate
+    JOIN recipe_categories AS kept
+    ON kept.group_id = duplicate.group_id AND kept.name = duplicate.name COLLATE NOCASE AND kept.id < duplicate.id
+);
+
+-- Recipe category names are unique within a group, regardless of their case
+CREATE UNIQUE INDEX IF NOT EXISTS idx_recipe_categories_group_id_name ON recipe_categories(group_id, name COLLATE NOCASE);
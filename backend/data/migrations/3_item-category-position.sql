@@ -0,0 +1,33 @@
+-- Item categories whose name only differs by its case from an older one of the group are merged into it:
+-- their items are moved to the older category, then they are removed
+UPDATE items SET item_category_id = (
+    SELECT MIN(kept.id) FROM item_categories AS kept
+    JOIN item_categories AS duplicate ON kept.group_id = duplicate.group_id AND kept.name = duplicate.name COLLATE NOCASE
+    WHERE duplicate.id = items.item_category_id
+);
+
+DELETE FROM item_categories WHERE id IN (
+    SELECT duplicate.id FROM item_categories AS duplicate
+    JOIN item_categories AS kept
+    ON kept.group_id = duplicate.group_id AND kept.name = duplicate.name COLLATE NOCASE AND kept.id < duplicate.id
+);
+
+-- Item categories are displayed in an order chosen by the group
+ALTER TABLE item_categories ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+UPDATE item_categories SET position = (
+    SELECT COUNT(*) FROM item_categories AS previous
+    WHERE previous.group_id = item_categories.group_id AND previous.id < item_categories.id
+);
+
+-- Every group has an "Uncategorized" category, receiving the items without category
+INSERT INTO item_categories (name, group_id, position)
+SELECT 'Uncategorized', groups.id, (SELECT COUNT(*) FROM item_categories WHERE item_categories.group_id = groups.id)
+FROM groups
+WHERE NOT EXISTS (
+    SELECT 1 FROM item_categories
+    WHERE item_categories.group_id = groups.id AND item_categories.name = 'Uncategorized' COLLATE NOCASE
+);
+
+-- Item category names are unique within a group, regardless of their case
+CREATE UNIQUE INDEX IF NOT EXISTS idx_item_categories_group_id_name ON item_categories(group_id, name COLLATE NOCASE);
@@ -0,0 +1,35 @@
+-- Units without group form the catalogue shared by every group; the others are the custom units of a group
+ALTER TABLE units ADD COLUMN group_id INTEGER REFERENCES groups(id);
+
+-- Unit names are unique within the catalogue and within each group, regardless of their case
+CREATE UNIQUE INDEX IF NOT EXISTS idx_units_group_id_name ON units(IFNULL(group_id, 0), name COLLATE NOCASE);
+
+-- Factors convert a quantity to the base unit of its type: gram, milliliter or one piece, bag or count
+INSERT INTO units (name, factor, unit_type)
+SELECT catalogue.name, catalogue.factor, catalogue.unit_type
+FROM (
+    SELECT 'Kilogram' AS name, 1000.0 AS factor, 'WEIGHT' AS unit_type
+    UNION ALL SELECT 'Gram', 1.0, 'WEIGHT'
+    UNION ALL SELECT 'Liter', 1000.0, 'VOLUME'
+    UNION ALL SELECT 'Milliliter', 1.0, 'VOLUME'
+    UNION ALL SELECT 'Cup', 240.0, 'VOLUME'
+    UNION ALL SELECT 'Tablespoon', 15.0, 'VOLUME'
+    UNION ALL SELECT 'Teaspoon', 5.0, 'VOLUME'
+    UNION ALL SELECT 'Piece', 1.0, 'PIECE'
+    UNION ALL SELECT 'Bag', 1.0, 'BAG'
+    UNION ALL SELECT 'Count', 1.0, 'NUMERIC'
+    UNION ALL SELECT 'Undefined', 1.0, 'UNDEFINED'
+    UNION ALL SELECT 'Milligram', 0.001, 'WEIGHT'
+    UNION ALL SELECT 'Ounce', 28.349523125, 'WEIGHT'
+    UNION ALL SELECT 'Pound', 453.59237, 'WEIGHT'
+    UNION ALL SELECT 'Centiliter', 10.0, 'VOLUME'
+    UNION ALL SELECT 'Deciliter', 100.0, 'VOLUME'
+    UNION ALL SELECT 'Fluid ounce', 29.5735295625, 'VOLUME'
+    UNION ALL SELECT 'Pint', 473.176473, 'VOLUME'
+    UNION ALL SELECT 'Quart', 946.352946, 'VOLUME'
+    UNION ALL SELECT 'Gallon', 3785.411784, 'VOLUME'
+    UNION ALL SELECT 'Dozen', 12.0, 'PIECE'
+) AS catalogue
+WHERE NOT EXISTS (
+    SELECT 1 FROM units WHERE units.group_id IS NULL AND units.name = catalogue.name COLLATE NOCASE
+);
@@ -0,0 +1,48 @@
+-- Full-text indexes of the recipes and items, kept in sync with their tables by the triggers below.
+-- They are external content tables: the text is read from the indexed tables, whose id is the rowid of the index.
+-- Requires SQLite to be built with FTS5 (the sqlite_fts5 build tag of go-sqlite3).
+CREATE VIRTUAL TABLE IF NOT EXISTS recipes_fts USING fts5(
+    name, description, instructions, comment,
+    content = 'recipes', content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+CREATE VIRTUAL TABLE IF NOT EXISTS items_fts USING fts5(
+    name, description,
+    content = 'items', content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER IF NOT EXISTS recipes_fts_after_insert AFTER INSERT ON recipes BEGIN
+    INSERT INTO recipes_fts (rowid, name, description, instructions, comment)
+    VALUES (new.id, new.name, new.description, new.instructions, new.comment);
+END;
+
+CREATE TRIGGER IF NOT EXISTS recipes_fts_after_delete AFTER DELETE ON recipes BEGIN
+    INSERT INTO recipes_fts (recipes_fts, rowid, name, description, instructions, comment)
+    VALUES ('delete', old.id, old.name, old.description, old.instructions, old.comment);
+END;
+
+CREATE TRIGGER IF NOT EXISTS recipes_fts_after_update AFTER UPDATE ON recipes BEGIN
+    INSERT INTO recipes_fts (recipes_fts, rowid, name, description, instructions, comment)
+    VALUES ('delete', old.id, old.name, old.description, old.instructions, old.comment);
+    INSERT INTO recipes_fts (rowid, name, description, instructions, comment)
+    VALUES (new.id, new.name, new.description, new.instructions, new.comment);
+END;
+
+CREATE TRIGGER IF NOT EXISTS items_fts_after_insert AFTER INSERT ON items BEGIN
+    INSERT INTO items_fts (rowid, name, description) VALUES (new.id, new.name, new.description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS items_fts_after_delete AFTER DELETE ON items BEGIN
+    INSERT INTO items_fts (items_fts, rowid, name, description) VALUES ('delete', old.id, old.name, old.description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS items_fts_after_update AFTER UPDATE ON items BEGIN
+    INSERT INTO items_fts (items_fts, rowid, name, description) VALUES ('delete', old.id, old.name, old.description);
+    INSERT INTO items_fts (rowid, name, description) VALUES (new.id, new.name, new.description);
+END;
+
+-- Indexes the recipes and items created before this migration
+INSERT INTO recipes_fts (recipes_fts) VALUES ('rebuild');
+INSERT INTO items_fts (items_fts) VALUES ('rebuild');
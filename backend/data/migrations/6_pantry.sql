@@ -0,0 +1,18 @@
+-- Stock of the items owned by a group, a line per item and unit
+CREATE TABLE IF NOT EXISTS pantry (
+    id INTEGER PRIMARY KEY NOT NULL UNIQUE,
+    quantity FLOAT NOT NULL CHECK (quantity >= 0),
+    item_id INTEGER NOT NULL,
+    unit_id INTEGER NOT NULL,
+    group_id INTEGER NOT NULL,
+    -- The stock of an item is meaningless once the item is deleted
+    FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE,
+    FOREIGN KEY (unit_id) REFERENCES units(id),
+    FOREIGN KEY (group_id) REFERENCES groups(id),
+    UNIQUE (item_id, unit_id, group_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pantry_group_id ON pantry(group_id);
+
+-- The ingredients of a dish are deducted from the pantry once, when it is cooked
+ALTER TABLE dishes ADD COLUMN cooked BOOLEAN DEFAULT FALSE NOT NULL;
@@ -0,0 +1,12 @@
+-- Failed login attempts, counted per username and per client IP to throttle brute-force attacks
+CREATE TABLE IF NOT EXISTS login_throttles (
+    id INTEGER PRIMARY KEY NOT NULL UNIQUE,
+    -- 'USERNAME' or 'IP'
+    kind VARCHAR(16) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    failures INTEGER DEFAULT 0 NOT NULL,
+    last_failure_at TIMESTAMP NOT NULL,
+    -- NULL while logins aren't delayed yet
+    blocked_until TIMESTAMP,
+    UNIQUE (kind, key)
+);
@@ -0,0 +1,18 @@
+-- Personal API tokens authenticating the scripts of a user through the Authorization header
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id INTEGER PRIMARY KEY NOT NULL UNIQUE,
+    user_id INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    -- SHA-256 digest of the token, which is only shown to the user when created
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    -- 'READ' or 'WRITE'
+    scope VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    -- NULL means the token never expires
+    expires_at TIMESTAMP,
+    -- NULL until the token is used
+    last_used_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_tokens_user_id ON api_tokens(user_id);
@@ -32,29 +32,25 @@ INSERT INTO sessions (id, created_at, last_activity, ip_address, user_agent, use
     ('session789ghi', 0, datetime('now', '-1 day', '+2 hours'), '192.168.1.102', 'Safari/17.0', (SELECT id FROM users WHERE username = 'testuser3')),
     ('session999xyz', 0, datetime('now', '-1 day', '+3 hours'), '192.168.1.103', NULL, (SELECT id FROM users WHERE username = 'testuser4'));
 
-INSERT INTO units (name, factor, unit_type) VALUES
-    ('Kilogram', 1000.0, 'WEIGHT'),
-    ('Gram', 1.0, 'WEIGHT'),
-    ('Liter', 1000.0, 'VOLUME'),
-    ('Milliliter', 1.0, 'VOLUME'),
-    ('Cup', 240.0, 'VOLUME'),
-    ('Tablespoon', 15.0, 'VOLUME'),
-    ('Teaspoon', 5.0, 'VOLUME'),
-    ('Piece', 1.0, 'PIECE'),
-    ('Bag', 1.0, 'BAG'),
-    ('Count', 1.0, 'NUMERIC'),
-    ('Undefined', 1.0, 'UNDEFINED');
-
-INSERT INTO item_categories (name, group_id) VALUES
-    ('GRAINS AND PASTA', (SELECT id FROM groups WHERE name = 'Family')),
-    ('BAKED GOODS', (SELECT id FROM groups WHERE name = 'Family')),
-    ('SPICES AND CONDIMENTS', (SELECT id FROM groups WHERE name = 'Family')),
-    ('DAIRY', (SELECT id FROM groups WHERE name = 'Family')),
-    ('MEAT', (SELECT id FROM groups WHERE name = 'Friends')),
-    ('VEGETABLES', (SELECT id FROM groups WHERE name = 'Friends')),
-    ('SNACKS', (SELECT id FROM groups WHERE name = 'Friends')),
-    ('CANNED GOODS', (SELECT id FROM groups WHERE name = 'Family')),
-    ('BEVERAGE', (SELECT id FROM groups WHERE name = 'Friends'));
+-- The catalogue units are seeded by the migrations
+INSERT INTO units (name, factor, unit_type, group_id) VALUES
+    ('Bowl', 250.0, 'VOLUME', (SELECT id FROM groups WHERE name = 'Family')),
+    ('Handful', 30.0, 'WEIGHT', (SELECT id FROM groups WHERE name = 'Friends'));
+
+INSERT INTO item_categories (name, group_id, position) VALUES
+    ('GRAINS AND PASTA', (SELECT id FROM groups WHERE name = 'Family'), 0),
+    ('BAKED GOODS', (SELECT id FROM groups WHERE name = 'Family'), 1),
+    ('SPICES AND CONDIMENTS', (SELECT id FROM groups WHERE name = 'Family'), 2),
+    ('DAIRY', (SELECT id FROM groups WHERE name = 'Family'), 3),
+    ('MEAT', (SELECT id FROM groups WHERE name = 'Friends'), 0),
+    ('VEGETABLES', (SELECT id FROM groups WHERE name = 'Friends'), 1),
+    ('SNACKS', (SELECT id FROM groups WHERE name = 'Friends'), 2),
+    ('CANNED GOODS', (SELECT id FROM groups WHERE name = 'Family'), 4),
+    ('BEVERAGE', (SELECT id FROM groups WHERE name = 'Friends'), 3),
+    ('Uncategorized', (SELECT id FROM groups WHERE name = 'Family'), 5),
+    ('Uncategorized', (SELECT id FROM groups WHERE name = 'Friends'), 4),
+    ('Uncategorized', (SELECT id FROM groups WHERE name = 'Work'), 0),
+    ('Uncategorized', (SELECT id FROM groups WHERE name = 'NoRecipe'), 0);
 
 INSERT INTO items (name, description, average_market_price, unit_type, item_category_id, group_id) VALUES
     ('Flour', 'All-purpose flour', 2.50, 'WEIGHT', (SELECT id FROM item_categories WHERE name = 'GRAINS AND PASTA'), (SELECT id FROM groups WHERE name = 'Family')),
@@ -139,3 +135,26 @@ INSERT INTO groceries (quantity_bought, user_quantity, item_id, unit_id, group_i
     (2.0, 4.0, 8, 8, 2),    -- 4 tomatoes (2 bought)
     (0.0, 1.0, 12, 11, 1),  -- Pepper with undefined unit
     (0.0, 3.0, 11, 11, 2);  -- Water with undefined unit
+
+-- Pantry
+INSERT INTO pantry (quantity, item_id, unit_id, group_id) VALUES
+    (500.0, 1, 2, 1),       -- 500 g flour
+    (6.0, 4, 8, 1),         -- 6 eggs
+    (0.5, 5, 3, 1),         -- 0.5 liter milk
+    (3.0, 8, 8, 2);         -- 3 tomatoes
+
+-- Group invitations
+INSERT INTO group_invitations (token, group_id, created_by, created_at, expires_at, max_uses, uses, revoked) VALUES
+    ('familyinvitetoken', 1, 2, 0, datetime('now', '+7 days'), NULL, 2, 0),     -- unlimited uses
+    ('familysingleuse', 1, 2, 0, datetime('now', '+1 day'), 1, 0, 0),           -- single use, not used yet
+    ('familyexhausted', 1, 3, 0, datetime('now', '+1 day'), 1, 1, 0),           -- single use, already used
+    ('familyexpired', 1, 2, 0, datetime('now', '-1 day'), NULL, 0, 0),          -- expired
+    ('familyrevoked', 1, 2, 0, datetime('now', '+7 days'), 5, 0, 1),            -- revoked
+    ('friendsinvitetoken', 2, 4, 0, datetime('now', '+7 days'), 10, 3, 0);
+
+-- API tokens
+INSERT INTO api_tokens (user_id, name, token_hash, scope, created_at, expires_at, last_used_at) VALUES
+    (1, 'Grocery export', 'readtokenhash', 'READ', datetime('now', '-2 days'), NULL, NULL),                                      -- never expires, never used
+    (1, 'Meal planner', 'writetokenhash', 'WRITE', datetime('now', '-1 day'), datetime('now', '+30 days'), datetime('now', '-1 hour')),
+    (1, 'Old script', 'expiredtokenhash', 'READ', datetime('now', '-10 days'), datetime('now', '-1 day'), NULL),                 -- expired
+    (2, 'Admin script', 'admintokenhash', 'WRITE', datetime('now', '-3 days'), NULL, NULL);
@@ -0,0 +1,15 @@
+package constant
+
+import "time"
+
+const (
+	AUTHORIZATION_HEADER = "Authorization"
+	// BEARER_PREFIX precedes the API token in the Authorization header.
+	BEARER_PREFIX = "Bearer "
+
+	// API_TOKEN_NAME_MAX_LENGTH is the maximum number of characters of the name of an API token.
+	API_TOKEN_NAME_MAX_LENGTH = 100
+	// API_TOKEN_LAST_USE_PRECISION is the time after which the last use of a token is updated again,
+	// so that a script sending many requests doesn't cause a write on each of them.
+	API_TOKEN_LAST_USE_PRECISION = time.Minute
+)
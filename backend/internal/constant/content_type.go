@@ -3,4 +3,6 @@ package constant
 const (
 	CONTENT_TYPE_HEADER = "Content-Type"
 	CONTENT_TYPE_VALUE  = "application/json"
+	// CONTENT_TYPE_YAML_VALUE is the content type of the YAML documents, such as the recipe bundles.
+	CONTENT_TYPE_YAML_VALUE = "application/yaml"
 )
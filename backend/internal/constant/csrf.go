@@ -0,0 +1,8 @@
+package constant
+
+const (
+	// CSRF_COOKIE_NAME is the cookie carrying the CSRF token of the session; JS reads it to send the token back.
+	CSRF_COOKIE_NAME = "yumsday_csrf"
+	// CSRF_HEADER is the header in which the CSRF token must be sent with the unsafe requests.
+	CSRF_HEADER = "X-CSRF-Token"
+)
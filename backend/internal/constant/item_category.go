@@ -0,0 +1,5 @@
+package constant
+
+// UNCATEGORIZED_ITEM_CATEGORY is the name of the item category every group owns,
+// receiving the items created without category and the ones of deleted categories.
+const UNCATEGORIZED_ITEM_CATEGORY = "Uncategorized"
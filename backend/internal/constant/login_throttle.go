@@ -0,0 +1,23 @@
+package constant
+
+import "time"
+
+const (
+	// Kinds of keys whose failed login attempts are counted.
+	LOGIN_THROTTLE_USERNAME = "USERNAME"
+	LOGIN_THROTTLE_IP       = "IP"
+
+	// LOGIN_FREE_ATTEMPTS_PER_USERNAME is the number of failed attempts on a username before its logins are delayed.
+	LOGIN_FREE_ATTEMPTS_PER_USERNAME = 5
+	// LOGIN_FREE_ATTEMPTS_PER_IP is the number of failed attempts from a client IP before its logins are delayed;
+	// higher than the one of usernames, as an IP can be shared by several users.
+	LOGIN_FREE_ATTEMPTS_PER_IP = 20
+	// LOGIN_BACKOFF_BASE is the delay after the first delayed failure, doubled on each following one.
+	LOGIN_BACKOFF_BASE = time.Second
+	// LOGIN_LOCKOUT_DURATION is the longest delay, for which an account is locked out once reached.
+	LOGIN_LOCKOUT_DURATION = 15 * time.Minute
+	// LOGIN_FAILURE_WINDOW is the time after which failed attempts are forgotten.
+	LOGIN_FAILURE_WINDOW = 24 * time.Hour
+
+	RETRY_AFTER_HEADER = "Retry-After"
+)
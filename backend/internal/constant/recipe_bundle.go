@@ -0,0 +1,8 @@
+package constant
+
+const (
+	// RECIPE_BUNDLE_VERSION is the version of the recipe bundle format, increased whenever the format changes.
+	RECIPE_BUNDLE_VERSION = 1
+	// MAX_RECIPE_BUNDLE_SIZE is the maximum size in bytes of an imported recipe bundle.
+	MAX_RECIPE_BUNDLE_SIZE = 10 << 20
+)
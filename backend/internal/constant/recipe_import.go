@@ -0,0 +1,12 @@
+package constant
+
+import "time"
+
+const (
+	// RECIPE_IMPORT_TIMEOUT bounds the time spent fetching the page of an imported recipe.
+	RECIPE_IMPORT_TIMEOUT = 10 * time.Second
+	// MAX_RECIPE_IMPORT_SIZE is the maximum size in bytes of the page of an imported recipe, fetched or pasted.
+	MAX_RECIPE_IMPORT_SIZE = 5 << 20
+	// MAX_INGREDIENT_LINES is the maximum number of ingredient lines parsed at once.
+	MAX_INGREDIENT_LINES = 200
+)
@@ -0,0 +1,8 @@
+package constant
+
+const (
+	// DEFAULT_SEARCH_LIMIT is the number of hits returned by a full-text search when the client doesn't limit it.
+	DEFAULT_SEARCH_LIMIT = 20
+	// MAX_SEARCH_LIMIT is the maximum number of hits a full-text search can return.
+	MAX_SEARCH_LIMIT = 100
+)
@@ -5,3 +5,5 @@ package ctx
 // or any other built-in type to avoid collisions between packages using context."
 type SessionCtxKey struct{}
 type UserCtxKey struct{}
+type GroupMemberCtxKey struct{}
+type APITokenCtxKey struct{}
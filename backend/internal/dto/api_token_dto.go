@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+type APITokenDto struct {
+	ID         int64           `json:"id"`
+	Name       string          `json:"name"`
+	Scope      enum.TokenScope `json:"scope" swaggertype:"string"`
+	CreatedAt  time.Time       `json:"created_at"`
+	ExpiresAt  *time.Time      `json:"expires_at"`
+	LastUsedAt *time.Time      `json:"last_used_at"`
+}
+
+type NewAPITokenDto struct {
+	Name      string          `json:"name"`
+	Scope     enum.TokenScope `json:"scope" swaggertype:"string"`
+	ExpiresAt *time.Time      `json:"expires_at"`
+}
+
+// CreatedAPITokenDto is the API token returned on creation, the only time its secret is shown.
+type CreatedAPITokenDto struct {
+	APITokenDto
+	Token string `json:"token"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+type CostEstimateDto struct {
+	Cost     float64 `json:"cost"`
+	Servings *int    `json:"servings"`
+	// Omitted when the number of servings is unknown.
+	CostPerServing      *float64        `json:"cost_per_serving,omitempty"`
+	UnpricedIngredients []IngredientDto `json:"unpriced_ingredients"`
+}
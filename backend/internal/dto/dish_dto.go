@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type DishDto struct {
+	ID       int64              `json:"id"`
+	Portion  int                `json:"portion"`
+	Bought   bool               `json:"bought"`
+	Cooked   bool               `json:"cooked"`
+	Datetime time.Time          `json:"datetime"`
+	Recipes  []RecipeSummaryDto `json:"recipes"`
+}
+
+type NewDishDto struct {
+	Portion   int       `json:"portion" binding:"required"`
+	Datetime  time.Time `json:"datetime" binding:"required"`
+	RecipeIDs []int64   `json:"recipe_ids" binding:"required"`
+}
+
+type DishMoveDto struct {
+	Datetime time.Time `json:"datetime" binding:"required"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+type GroceryDto struct {
+	ID             int64          `json:"id"`
+	UserQuantity   float64        `json:"user_quantity"`
+	QuantityBought float64        `json:"quantity_bought"`
+	Bought         bool           `json:"bought"`
+	Item           ItemSummaryDto `json:"item"`
+	Unit           UnitSummaryDto `json:"unit"`
+}
+
+// GroceryAisleDto gathers the grocery lines whose items belong to the same item category.
+type GroceryAisleDto struct {
+	ItemCategory ItemCategoryDto `json:"item_category"`
+	Groceries    []GroceryDto    `json:"groceries"`
+}
+
+type NewGroceryDto struct {
+	UserQuantity float64 `json:"user_quantity" binding:"required"`
+	ItemID       int64   `json:"item_id" binding:"required"`
+	UnitID       int64   `json:"unit_id" binding:"required"`
+}
+
+type GroceryBoughtDto struct {
+	// QuantityBought defaults to the whole wanted quantity when omitted.
+	QuantityBought *float64 `json:"quantity_bought"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type GroupAdminPayload struct {
+	Admin bool `json:"admin"`
+}
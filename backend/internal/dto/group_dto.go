@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+type GroupDto struct {
+	ID        int64            `json:"id"`
+	Name      string           `json:"name"`
+	ImageURL  *string          `json:"image_url"`
+	CreatedAt time.Time        `json:"created_at"`
+	Members   []GroupMemberDto `json:"members"`
+}
+
+type NewGroupDto struct {
+	Name     string  `json:"name" binding:"required"`
+	ImageURL *string `json:"image_url"`
+}
+
+type GroupMemberDto struct {
+	UserID   int64     `json:"user_id"`
+	Admin    bool      `json:"admin"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type NewGroupMemberDto struct {
+	UserID int64 `json:"user_id" binding:"required"`
+	Admin  bool  `json:"admin"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+type ItemCategoryDto struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Position int    `json:"position"`
+}
+
+type ItemCategorySummaryDto struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type NewItemCategoryDto struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type ItemCategoryOrderDto struct {
+	// IDs of every item category of the group, in their new order.
+	IDs []int64 `json:"item_category_ids" binding:"required"`
+}
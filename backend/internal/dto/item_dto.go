@@ -0,0 +1,22 @@
+package dto
+
+import "github.com/zouipo/yumsday/backend/internal/model/enum"
+
+type ItemDto struct {
+	ID                 int64                  `json:"id"`
+	Name               string                 `json:"name"`
+	Description        *string                `json:"description"`
+	AverageMarketPrice *float64               `json:"average_market_price"`
+	UnitType           enum.UnitType          `json:"unit_type" swaggertype:"string"`
+	GroupID            int64                  `json:"group_id"`
+	ItemCategory       ItemCategorySummaryDto `json:"item_category"`
+}
+
+type NewItemDto struct {
+	Name               string        `json:"name"`
+	Description        *string       `json:"description"`
+	AverageMarketPrice *float64      `json:"average_market_price"`
+	UnitType           enum.UnitType `json:"unit_type" swaggertype:"string"`
+	// If omitted, the item is assigned to the "Uncategorized" category of its group.
+	ItemCategoryID int64 `json:"item_category_id"`
+}
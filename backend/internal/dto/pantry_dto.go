@@ -0,0 +1,14 @@
+package dto
+
+type PantryItemDto struct {
+	ID       int64          `json:"id"`
+	Quantity float64        `json:"quantity"`
+	Item     ItemSummaryDto `json:"item"`
+	Unit     UnitSummaryDto `json:"unit"`
+}
+
+type NewPantryItemDto struct {
+	Quantity float64 `json:"quantity" binding:"required"`
+	ItemID   int64   `json:"item_id" binding:"required"`
+	UnitID   int64   `json:"unit_id" binding:"required"`
+}
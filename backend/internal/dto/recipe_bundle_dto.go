@@ -0,0 +1,52 @@
+package dto
+
+import "github.com/zouipo/yumsday/backend/internal/model/enum"
+
+// RecipeBundleDto is the portable format of exported recipes, serialized in JSON or YAML.
+// Its parts reference each other by name, e.g. ingredients name their item and unit.
+type RecipeBundleDto struct {
+	Version          int               `json:"version" yaml:"version"`
+	Units            []BundleUnitDto   `json:"units" yaml:"units"`
+	ItemCategories   []string          `json:"item_categories" yaml:"item_categories"`
+	Items            []BundleItemDto   `json:"items" yaml:"items"`
+	RecipeCategories []string          `json:"recipe_categories" yaml:"recipe_categories"`
+	Recipes          []BundleRecipeDto `json:"recipes" yaml:"recipes"`
+}
+
+type BundleUnitDto struct {
+	Name     string        `json:"name" yaml:"name"`
+	Factor   float64       `json:"factor" yaml:"factor"`
+	UnitType enum.UnitType `json:"unit_type" yaml:"unit_type" swaggertype:"string"`
+}
+
+type BundleItemDto struct {
+	Name               string        `json:"name" yaml:"name"`
+	Description        *string       `json:"description" yaml:"description,omitempty"`
+	AverageMarketPrice *float64      `json:"average_market_price" yaml:"average_market_price,omitempty"`
+	UnitType           enum.UnitType `json:"unit_type" yaml:"unit_type" swaggertype:"string"`
+	// Name of the item category; the "Uncategorized" category when omitted.
+	ItemCategory string `json:"item_category" yaml:"item_category,omitempty"`
+}
+
+type BundleRecipeDto struct {
+	Name               string  `json:"name" yaml:"name"`
+	Description        *string `json:"description" yaml:"description,omitempty"`
+	ImageURL           *string `json:"image_url" yaml:"image_url,omitempty"`
+	OriginalLink       *string `json:"original_link" yaml:"original_link,omitempty"`
+	PreparationTimeMin *int    `json:"preparation_time_min" yaml:"preparation_time_min,omitempty"`
+	CookingTimeMin     *int    `json:"cooking_time_min" yaml:"cooking_time_min,omitempty"`
+	Servings           *int    `json:"servings" yaml:"servings,omitempty"`
+	Instructions       *string `json:"instructions" yaml:"instructions,omitempty"`
+	Public             bool    `json:"public" yaml:"public"`
+	Comment            *string `json:"comment" yaml:"comment,omitempty"`
+	// Names of the recipe categories.
+	Categories  []string              `json:"recipe_categories" yaml:"recipe_categories"`
+	Ingredients []BundleIngredientDto `json:"ingredients" yaml:"ingredients"`
+}
+
+type BundleIngredientDto struct {
+	Quantity *float64 `json:"quantity" yaml:"quantity,omitempty"`
+	// Names of the item and the unit.
+	Item string `json:"item" yaml:"item"`
+	Unit string `json:"unit" yaml:"unit"`
+}
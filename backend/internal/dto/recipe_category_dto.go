@@ -0,0 +1,10 @@
+package dto
+
+type RecipeCategoryDto struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type NewRecipeCategoryDto struct {
+	Name string `json:"name" binding:"required"`
+}
@@ -0,0 +1,137 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+type RecipeSummaryDto struct {
+	ID                 int64   `json:"id"`
+	Name               string  `json:"name"`
+	ImageURL           *string `json:"image_url"`
+	PreparationTimeMin *int    `json:"preparation_time_min"`
+	CookingTimeMin     *int    `json:"cooking_time_min"`
+	Servings           *int    `json:"servings"`
+}
+
+type RecipeDto struct {
+	ID                 int64               `json:"id"`
+	Name               string              `json:"name"`
+	Description        *string             `json:"description"`
+	ImageURL           *string             `json:"image_url"`
+	OriginalLink       *string             `json:"original_link"`
+	PreparationTimeMin *int                `json:"preparation_time_min"`
+	CookingTimeMin     *int                `json:"cooking_time_min"`
+	Servings           *int                `json:"servings"`
+	Instructions       *string             `json:"instructions"`
+	CreatedAt          time.Time           `json:"created_at"`
+	Public             bool                `json:"public"`
+	Comment            *string             `json:"comment"`
+	GroupID            int64               `json:"group_id"`
+	Categories         []RecipeCategoryDto `json:"recipe_categories"`
+	Ingredients        []IngredientDto     `json:"ingredients"`
+}
+
+// PublicRecipeDto is the read-only view of a public recipe, shared with anyone.
+// It leaves out the private fields of the recipe, such as its comment and group.
+type PublicRecipeDto struct {
+	ID                 int64                 `json:"id"`
+	Name               string                `json:"name"`
+	Description        *string               `json:"description"`
+	ImageURL           *string               `json:"image_url"`
+	OriginalLink       *string               `json:"original_link"`
+	PreparationTimeMin *int                  `json:"preparation_time_min"`
+	CookingTimeMin     *int                  `json:"cooking_time_min"`
+	Servings           *int                  `json:"servings"`
+	Instructions       *string               `json:"instructions"`
+	CreatedAt          time.Time             `json:"created_at"`
+	Categories         []string              `json:"recipe_categories"`
+	Ingredients        []PublicIngredientDto `json:"ingredients"`
+}
+
+type NewRecipeDto struct {
+	Name               string             `json:"name" binding:"required"`
+	Description        *string            `json:"description"`
+	ImageURL           *string            `json:"image_url"`
+	OriginalLink       *string            `json:"original_link"`
+	PreparationTimeMin *int               `json:"preparation_time_min"`
+	CookingTimeMin     *int               `json:"cooking_time_min"`
+	Servings           *int               `json:"servings"`
+	Instructions       *string            `json:"instructions"`
+	Public             bool               `json:"public"`
+	Comment            *string            `json:"comment"`
+	CategoryIDs        []int64            `json:"recipe_category_ids"`
+	Ingredients        []NewIngredientDto `json:"ingredients"`
+}
+
+type IngredientDto struct {
+	ID       int64          `json:"id"`
+	Quantity *float64       `json:"quantity"`
+	Item     ItemSummaryDto `json:"item"`
+	Unit     UnitSummaryDto `json:"unit"`
+}
+
+type NewIngredientDto struct {
+	// ID of the ingredient to update; omitted for a new ingredient.
+	ID       int64    `json:"id"`
+	Quantity *float64 `json:"quantity"`
+	ItemID   int64    `json:"item_id" binding:"required"`
+	UnitID   int64    `json:"unit_id" binding:"required"`
+}
+
+type PublicIngredientDto struct {
+	Quantity *float64 `json:"quantity"`
+	// Names of the item and the unit.
+	Item string `json:"item"`
+	Unit string `json:"unit"`
+}
+
+type ItemSummaryDto struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type UnitSummaryDto struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type RecipeMatchDto struct {
+	Recipe RecipeSummaryDto `json:"recipe"`
+	// Share of the ingredients of the recipe whose item is available, between 0 and 1.
+	Coverage           float64         `json:"coverage"`
+	MissingIngredients []IngredientDto `json:"missing_ingredients"`
+}
+
+type RecipeImportDto struct {
+	// URL of the page to fetch; omitted when the page is pasted in HTML.
+	URL *string `json:"url"`
+	// HTML of the pasted page; omitted when the page is fetched from URL.
+	HTML *string `json:"html"`
+	// Language of the ingredient lines; the inLanguage of the recipe, or English, by default.
+	Language *enum.Language `json:"language" swaggertype:"string"`
+}
+
+type RecipeDraftDto struct {
+	Recipe               RecipeDto `json:"recipe"`
+	UnmatchedIngredients []string  `json:"unmatched_ingredients"`
+}
+
+type IngredientLinesDto struct {
+	Lines []string `json:"lines" binding:"required"`
+	// Language of the lines; the language of the user by default.
+	Language *enum.Language `json:"language" swaggertype:"string"`
+}
+
+type ParsedIngredientDto struct {
+	Line        string   `json:"line"`
+	Quantity    *float64 `json:"quantity"`
+	MinQuantity *float64 `json:"min_quantity"`
+	// Omitted when the line names a unit unknown to the group.
+	Unit *UnitSummaryDto `json:"unit"`
+	// Omitted when the line names an item unknown to the group, which is offered as NewItem.
+	Item    *ItemSummaryDto `json:"item"`
+	NewItem *NewItemDto     `json:"new_item"`
+	Note    string          `json:"note"`
+}
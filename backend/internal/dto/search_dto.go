@@ -0,0 +1,11 @@
+package dto
+
+type SearchHitDto struct {
+	// Kind is "recipe" or "item".
+	Kind string `json:"kind"`
+	ID   int64  `json:"id"`
+	// Name and Snippet are HTML escaped, their matching terms being wrapped in <mark> elements.
+	Name    string  `json:"name"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
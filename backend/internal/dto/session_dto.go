@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// SessionDto describes a session of the user; its ID is a digest of the session ID,
+// which stays secret as it authenticates the user.
+type SessionDto struct {
+	ID           string    `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	LastActivity time.Time `json:"last_activity"`
+	IPAddress    string    `json:"ip_address"`
+	Browser      string    `json:"browser"`
+	OS           string    `json:"os"`
+	Label        string    `json:"label"`
+	Current      bool      `json:"current"`
+}
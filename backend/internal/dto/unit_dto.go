@@ -0,0 +1,25 @@
+package dto
+
+import "github.com/zouipo/yumsday/backend/internal/model/enum"
+
+type UnitDto struct {
+	ID       int64         `json:"id"`
+	Name     string        `json:"name"`
+	Factor   float64       `json:"factor"`
+	UnitType enum.UnitType `json:"unit_type" swaggertype:"string"`
+	// Custom reports whether the unit belongs to the group rather than to the shared catalogue.
+	Custom bool `json:"custom"`
+}
+
+type NewUnitDto struct {
+	Name     string        `json:"name" binding:"required"`
+	Factor   float64       `json:"factor" binding:"required"`
+	UnitType enum.UnitType `json:"unit_type" binding:"required" swaggertype:"string"`
+}
+
+type UnitConversionDto struct {
+	Quantity float64        `json:"quantity"`
+	From     UnitSummaryDto `json:"from"`
+	To       UnitSummaryDto `json:"to"`
+	Result   float64        `json:"result"`
+}
@@ -1,8 +1,49 @@
 package error
 
 const (
-	USERNAME_FIELD_ERROR = "invalid username format"
-	PASSWORD_FIELD_ERROR = "invalid password length"
-	SERIALIZE_USER_ERROR = "failed to serialize user"
-	FETCH_RECIPES_ERROR  = "failed to fetch recipes"
+	USERNAME_FIELD_ERROR   = "invalid username format"
+	PASSWORD_FIELD_ERROR   = "invalid password length"
+	SERIALIZE_USER_ERROR   = "failed to serialize user"
+	SERIALIZE_ITEM_ERROR   = "failed to serialize item"
+	SERIALIZE_RECIPE_ERROR = "failed to serialize recipe"
+	FETCH_RECIPES_ERROR    = "failed to fetch recipes"
+	RECIPE_SCALE_ERROR     = "a recipe can only be scaled when its servings are known"
+	GROUP_NAME_FIELD_ERROR = "group name must contain between 1 and 100 characters"
+	SERIALIZE_GROUP_ERROR  = "failed to serialize group"
+	SERIALIZE_INVITE_ERROR = "failed to serialize group invitation"
+
+	RECIPE_CATEGORY_NAME_FIELD_ERROR = "recipe category name must contain between 1 and 100 characters"
+	SERIALIZE_RECIPE_CATEGORY_ERROR  = "failed to serialize recipe category"
+
+	ITEM_CATEGORY_NAME_FIELD_ERROR  = "item category name must contain between 1 and 100 characters"
+	ITEM_CATEGORY_ORDER_FIELD_ERROR = "item category order must contain every category of the group exactly once"
+	SERIALIZE_ITEM_CATEGORY_ERROR   = "failed to serialize item category"
+
+	UNIT_CONVERSION_ERROR = "a quantity can only be converted between units of the same unit type"
+	SERIALIZE_UNIT_ERROR  = "failed to serialize unit"
+
+	SERIALIZE_GROCERY_ERROR = "failed to serialize grocery"
+
+	DISH_PERIOD_FIELD_ERROR = "the end of the period must be after its start"
+	SERIALIZE_DISH_ERROR    = "failed to serialize dish"
+
+	SERIALIZE_COST_ESTIMATE_ERROR = "failed to serialize cost estimate"
+
+	SERIALIZE_SEARCH_ERROR = "failed to serialize search hits"
+
+	SERIALIZE_PANTRY_ERROR = "failed to serialize pantry item"
+
+	RECIPE_IMPORT_FETCH_ERROR     = "failed to fetch the recipe page"
+	RECIPE_IMPORT_NOT_FOUND_ERROR = "no schema.org recipe found in the page"
+	SERIALIZE_RECIPE_DRAFT_ERROR  = "failed to serialize recipe draft"
+	SERIALIZE_INGREDIENT_ERROR    = "failed to serialize parsed ingredients"
+
+	SERIALIZE_RECIPE_BUNDLE_ERROR = "failed to serialize recipe bundle"
+
+	SERIALIZE_SESSION_ERROR = "failed to serialize session"
+	CSRF_TOKEN_ERROR        = "missing or invalid CSRF token"
+
+	API_TOKEN_NAME_FIELD_ERROR = "API token name must contain between 1 and 100 characters"
+	API_TOKEN_SCOPE_ERROR      = "the scope of the API token doesn't allow this request"
+	SERIALIZE_API_TOKEN_ERROR  = "failed to serialize API token"
 )
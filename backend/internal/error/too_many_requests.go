@@ -0,0 +1,32 @@
+package error
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type TooManyRequestsError struct {
+	// RetryAfter is the time to wait before retrying.
+	RetryAfter time.Duration
+	err        error
+}
+
+func NewTooManyRequestsError(retryAfter time.Duration, err error) error {
+	return &TooManyRequestsError{
+		RetryAfter: retryAfter,
+		err:        err,
+	}
+}
+
+func (e *TooManyRequestsError) Error() string {
+	return fmt.Sprintf("Too many requests: retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+func (e *TooManyRequestsError) HTTPStatus() int {
+	return http.StatusTooManyRequests
+}
+
+func (e *TooManyRequestsError) Unwrap() error {
+	return e.err
+}
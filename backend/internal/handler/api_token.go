@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// APITokenHandler handles HTTP requests managing the personal API tokens of the users.
+type APITokenHandler struct {
+	apiTokenService service.APITokenServiceInterface
+}
+
+// NewAPITokenHandler constructs a new APITokenHandler with the provided APITokenService.
+func NewAPITokenHandler(apiTokenService service.APITokenServiceInterface) *APITokenHandler {
+	return &APITokenHandler{
+		apiTokenService: apiTokenService,
+	}
+}
+
+// RegisterRoutes registers the API token-related routes on the provided ServeMux with the given prefix.
+// The tokens can only be managed from a session, not with another API token.
+func (h *APITokenHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	mux.Handle("GET "+prefix, middleware.NoAPIToken(http.HandlerFunc(h.getTokens)))
+	mux.Handle("POST "+prefix, middleware.NoAPIToken(http.HandlerFunc(h.createToken)))
+	mux.Handle("DELETE "+prefix+"/{id}", middleware.Stack(middleware.NoAPIToken, middleware.IntPathValues("id"))(http.HandlerFunc(h.revokeToken)))
+}
+
+// GetTokens godoc
+// @Summary Get the API tokens of the authenticated user
+// @Description Get all the personal API tokens of the authenticated user, including the expired ones, the most recent first
+// @Tags token
+// @Produce json
+// @Success 200 {array} dto.APITokenDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/user/me/tokens [get]
+func (h *APITokenHandler) getTokens(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	tokens, err := h.apiTokenService.GetByUserID(u.ID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(tokens, mapper.ToAPITokenDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_API_TOKEN_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateToken godoc
+// @Summary Create an API token
+// @Description Generate a personal API token for the authenticated user, read-only and without expiry by default.
+// @Description The token is only returned in this response; send it in the Authorization header as "Bearer <token>".
+// @Tags token
+// @Accept json
+// @Produce json
+// @Param token body dto.NewAPITokenDto true "Token name, scope (READ or WRITE) and expiry date"
+// @Success 201 {object} dto.CreatedAPITokenDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/user/me/tokens [post]
+func (h *APITokenHandler) createToken(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var newTokenDto dto.NewAPITokenDto
+	if err := json.NewDecoder(r.Body).Decode(&newTokenDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	token := mapper.FromNewAPITokenDtoToAPIToken(&newTokenDto, u.ID)
+
+	secret, err := h.apiTokenService.Create(token)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(mapper.ToCreatedAPITokenDto(token, secret)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_API_TOKEN_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// RevokeToken godoc
+// @Summary Revoke an API token
+// @Description Delete a personal API token of the authenticated user, which can't be used anymore
+// @Tags token
+// @Param id path int true "Token ID"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Token not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/user/me/tokens/{id} [delete]
+func (h *APITokenHandler) revokeToken(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.apiTokenService.Revoke(u.ID, r.Context().Value("id").(int64)); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+// MockAPITokenService is a mock implementation of APITokenService for testing handlers.
+type MockAPITokenService struct {
+	tokens    []model.APIToken
+	getErr    error
+	created   []model.APIToken
+	createErr error
+	revoked   []int64
+}
+
+func (m *MockAPITokenService) GetByUserID(userID int64) ([]model.APIToken, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+
+	tokens := []model.APIToken{}
+	for _, t := range m.tokens {
+		if t.UserID == userID {
+			tokens = append(tokens, t)
+		}
+	}
+	return tokens, nil
+}
+
+func (m *MockAPITokenService) Create(token *model.APIToken) (string, error) {
+	if m.createErr != nil {
+		return "", m.createErr
+	}
+
+	token.ID = int64(len(m.tokens) + 1)
+	m.created = append(m.created, *token)
+	return "yum_secret", nil
+}
+
+func (m *MockAPITokenService) Revoke(userID, id int64) error {
+	for _, t := range m.tokens {
+		if t.ID == id && t.UserID == userID {
+			m.revoked = append(m.revoked, id)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("api_tokens", "id", nil)
+}
+
+func (m *MockAPITokenService) Authenticate(_ string) (*model.APIToken, error) {
+	return nil, customErrors.NewUnauthorizedError("invalid API token", nil)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// setupAPITokenTestData returns a mock service holding two tokens of memberUser and one of another user.
+func setupAPITokenTestData() *MockAPITokenService {
+	return &MockAPITokenService{
+		tokens: []model.APIToken{
+			{ID: 1, UserID: memberUser.ID, Name: "Export", TokenHash: "readtokenhash", Scope: enum.ReadScope},
+			{ID: 2, UserID: memberUser.ID, Name: "Meal planner", TokenHash: "writetokenhash", Scope: enum.WriteScope},
+			{ID: 3, UserID: 2, Name: "Other", TokenHash: "othertokenhash", Scope: enum.WriteScope},
+		},
+	}
+}
+
+// serveAPITokenRequest routes a request of the user, authenticated by the given API token if any,
+// through the routes of an APITokenHandler.
+func serveAPITokenRequest(service *MockAPITokenService, method, target, body string, user *model.User, token *model.APIToken) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	NewAPITokenHandler(service).RegisterRoutes(mux, "/api/user/me/tokens")
+
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	c := context.WithValue(r.Context(), ctx.UserCtxKey{}, user)
+	if token != nil {
+		c = context.WithValue(c, ctx.APITokenCtxKey{}, token)
+	}
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, r.WithContext(c))
+	return w
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewAPITokenHandler(t *testing.T) {
+	service := &MockAPITokenService{}
+	handler := NewAPITokenHandler(service)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.apiTokenService != service {
+		t.Error("handler apiTokenService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetAPITokens_Success(t *testing.T) {
+	service := setupAPITokenTestData()
+
+	w := serveAPITokenRequest(service, http.MethodGet, "/api/user/me/tokens", "", memberUser, nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d instead of %d", http.StatusOK, w.Code)
+	}
+
+	if strings.Contains(w.Body.String(), "tokenhash") {
+		t.Errorf("expected the digests of the tokens not to be exposed, got %s", w.Body.String())
+	}
+
+	var actual []dto.APITokenDto
+	if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 tokens instead of %d", len(actual))
+	}
+	if actual[1].ID != 2 || actual[1].Name != "Meal planner" || actual[1].Scope != enum.WriteScope {
+		t.Errorf("unexpected second token: %+v", actual[1])
+	}
+}
+
+func TestGetAPITokens_Unauthorized(t *testing.T) {
+	w := serveAPITokenRequest(setupAPITokenTestData(), http.MethodGet, "/api/user/me/tokens", "", nil, nil)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d instead of %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestGetAPITokens_WithAPIToken(t *testing.T) {
+	service := setupAPITokenTestData()
+
+	w := serveAPITokenRequest(service, http.MethodGet, "/api/user/me/tokens", "", memberUser, &service.tokens[0])
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d instead of %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestGetAPITokens_ServiceError(t *testing.T) {
+	service := setupAPITokenTestData()
+	service.getErr = customErrors.NewInternalError("failed to fetch API tokens", nil)
+
+	w := serveAPITokenRequest(service, http.MethodGet, "/api/user/me/tokens", "", memberUser, nil)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d instead of %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateAPIToken_Success(t *testing.T) {
+	service := setupAPITokenTestData()
+
+	w := serveAPITokenRequest(service, http.MethodPost, "/api/user/me/tokens", `{"name":"Script","scope":"WRITE"}`, memberUser, nil)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d instead of %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var actual dto.CreatedAPITokenDto
+	if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if actual.Token != "yum_secret" || actual.Name != "Script" || actual.Scope != enum.WriteScope {
+		t.Errorf("unexpected created token: %+v", actual)
+	}
+	if len(service.created) != 1 || service.created[0].UserID != memberUser.ID {
+		t.Errorf("expected a token of user %d to be created, got %+v", memberUser.ID, service.created)
+	}
+}
+
+func TestCreateAPIToken_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		token          *model.APIToken
+		createErr      error
+		expectedStatus int
+	}{
+		{"Invalid JSON", `{"name":`, nil, nil, http.StatusBadRequest},
+		{"Invalid scope", `{"name":"Script","scope":"ADMIN"}`, nil, nil, http.StatusBadRequest},
+		{"Validation error", `{"name":""}`, nil, customErrors.NewValidationError("name", customErrors.API_TOKEN_NAME_FIELD_ERROR, nil), http.StatusBadRequest},
+		{"With API token", `{"name":"Script"}`, &model.APIToken{ID: 2, Scope: enum.WriteScope}, nil, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setupAPITokenTestData()
+			service.createErr = tt.createErr
+
+			w := serveAPITokenRequest(service, http.MethodPost, "/api/user/me/tokens", tt.body, memberUser, tt.token)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+			if len(service.created) != 0 {
+				t.Errorf("expected no token to be created, got %+v", service.created)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestRevokeAPIToken(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		token          *model.APIToken
+		expectedStatus int
+	}{
+		{"Own token", "/api/user/me/tokens/2", nil, http.StatusNoContent},
+		{"Token of another user", "/api/user/me/tokens/3", nil, http.StatusNotFound},
+		{"Invalid ID", "/api/user/me/tokens/abc", nil, http.StatusBadRequest},
+		{"With API token", "/api/user/me/tokens/2", &model.APIToken{ID: 2, Scope: enum.WriteScope}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setupAPITokenTestData()
+
+			w := serveAPITokenRequest(service, http.MethodDelete, tt.target, "", memberUser, tt.token)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+			if tt.expectedStatus == http.StatusNoContent && (len(service.revoked) != 1 || service.revoked[0] != 2) {
+				t.Errorf("expected token 2 to be revoked, got %v", service.revoked)
+			}
+		})
+	}
+}
@@ -3,13 +3,16 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/zouipo/yumsday/backend/internal/constant"
 	"github.com/zouipo/yumsday/backend/internal/ctx"
 	"github.com/zouipo/yumsday/backend/internal/dto"
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
 	"github.com/zouipo/yumsday/backend/internal/model"
 	"github.com/zouipo/yumsday/backend/internal/service"
 )
@@ -29,6 +32,12 @@ func (h *AuthHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
 	mux.HandleFunc("POST "+prefix+"/logout", h.postLogout)
 }
 
+// RegisterUserRoutes registers the routes managing the authentication of the users on the provided ServeMux with the given prefix.
+// They are restricted to the admins of the application, from a session.
+func (h *AuthHandler) RegisterUserRoutes(mux *http.ServeMux, prefix string) {
+	mux.Handle("DELETE "+prefix+"/{id}/lockout", middleware.Stack(middleware.NoAPIToken, middleware.AppAdmin, middleware.IntPathValues("id"))(http.HandlerFunc(h.unlockUser)))
+}
+
 // @Summary Authenticate user
 // @Description Authenticate user with username and password
 // @Tags auth
@@ -38,6 +47,7 @@ func (h *AuthHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
 // @Success 200 {string} string "Login successful"
 // @Failure 400 {string} string "Missing username or password"
 // @Failure 401 {string} string "Invalid credentials"
+// @Failure 429 {string} string "Too many failed attempts; the Retry-After header gives the seconds to wait"
 // @Failure 500 {string} string "Internal server error"
 // @Router /auth/login [post]
 func (h *AuthHandler) postLogin(w http.ResponseWriter, r *http.Request) {
@@ -58,8 +68,11 @@ func (h *AuthHandler) postLogin(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "session not available", http.StatusInternalServerError)
 		return
 	}
-	user, err := h.s.Authenticate(session, loginReq.Username, loginReq.Password)
+	user, err := h.s.Authenticate(session, loginReq.Username, loginReq.Password, r.RemoteAddr)
 	if err != nil {
+		if tooManyErr, ok := errors.AsType[*customErrors.TooManyRequestsError](err); ok {
+			w.Header().Set(constant.RETRY_AFTER_HEADER, strconv.Itoa(int(math.Ceil(tooManyErr.RetryAfter.Seconds()))))
+		}
 		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
 			http.Error(w, err.Error(), appErr.HTTPStatus())
 			return
@@ -95,3 +108,30 @@ func (h *AuthHandler) postLogout(w http.ResponseWriter, r *http.Request) {
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// UnlockUser godoc
+// @Summary Unlock a user account
+// @Description Forget the failed login attempts on the username of a user, lifting the lockout of their account; only an admin of the application can do so
+// @Tags auth
+// @Param id path int true "User ID"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "User not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/user/{id}/lockout [delete]
+func (h *AuthHandler) unlockUser(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("id").(int64)
+
+	if err := h.s.Unlock(userID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
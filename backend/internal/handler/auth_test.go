@@ -30,18 +30,22 @@ type mockAuthService struct {
 	authErr      error
 	authUser     *model.User
 	logoutErr    error
+	unlockErr    error
+	lastUnlocked int64
 	authCalls    int
 	logoutCalls  int
 	lastSession  *model.Session
 	lastUsername string
 	lastPassword string
+	lastIP       string
 }
 
-func (m *mockAuthService) Authenticate(session *model.Session, username, password string) (*model.User, error) {
+func (m *mockAuthService) Authenticate(session *model.Session, username, password, ipAddress string) (*model.User, error) {
 	m.authCalls++
 	m.lastSession = session
 	m.lastUsername = username
 	m.lastPassword = password
+	m.lastIP = ipAddress
 	if m.authErr != nil {
 		return nil, m.authErr
 	}
@@ -54,6 +58,11 @@ func (m *mockAuthService) Logout(session *model.Session) error {
 	return m.logoutErr
 }
 
+func (m *mockAuthService) Unlock(userID int64) error {
+	m.lastUnlocked = userID
+	return m.unlockErr
+}
+
 func TestNewAuthHandler(t *testing.T) {
 	mockService := &mockAuthService{}
 	handler := NewAuthHandler(mockService)
@@ -125,6 +134,10 @@ func TestPostLogin_Success(t *testing.T) {
 	if mockService.lastPassword != password {
 		t.Errorf("expected password %q instead of %q", password, mockService.lastPassword)
 	}
+
+	if mockService.lastIP != r.RemoteAddr {
+		t.Errorf("expected client IP %q instead of %q", r.RemoteAddr, mockService.lastIP)
+	}
 }
 
 func TestPostLogin_MissingCredentials(t *testing.T) {
@@ -185,6 +198,32 @@ func TestPostLogin_AppError(t *testing.T) {
 	}
 }
 
+func TestPostLogin_TooManyRequests(t *testing.T) {
+	mockService := &mockAuthService{
+		authErr: customErrors.NewTooManyRequestsError(1500*time.Millisecond, nil),
+	}
+	handler := NewAuthHandler(mockService)
+	session := model.NewSession("", "")
+
+	body, _ := json.Marshal(dto.LoginDto{Username: username, Password: wrongPassword})
+
+	r := httptest.NewRequest(http.MethodPost, loginRoute, bytes.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	r = r.WithContext(context.WithValue(r.Context(), ctx.SessionCtxKey{}, session))
+	w := httptest.NewRecorder()
+
+	handler.postLogin(w, r)
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected status %d instead of %d", http.StatusTooManyRequests, w.Code)
+	}
+
+	// Rounded up to the next second
+	if retryAfter := w.Header().Get("Retry-After"); retryAfter != "2" {
+		t.Errorf("expected Retry-After 2 instead of %q", retryAfter)
+	}
+}
+
 func TestPostLogin_GenericError(t *testing.T) {
 	mockService := &mockAuthService{
 		authErr: customErrors.NewInternalError("an error occurred while checking credentials", nil),
@@ -259,3 +298,44 @@ func TestPostLogout_Error(t *testing.T) {
 		t.Errorf("expected error message containing %q instead of %q", "Failed to remove session", w.Body.String())
 	}
 }
+
+/*** TESTS UnlockUser ***/
+
+func TestUnlockUser(t *testing.T) {
+	admin := &model.User{ID: 1, Username: "admin", AppAdmin: true}
+	notFoundErr := customErrors.NewNotFoundError("users", "id", nil)
+
+	tests := []struct {
+		name           string
+		user           *model.User
+		target         string
+		unlockErr      error
+		expectedStatus int
+	}{
+		{"Admin", admin, "/api/user/2/lockout", nil, http.StatusNoContent},
+		{"Not an admin", memberUser, "/api/user/2/lockout", nil, http.StatusForbidden},
+		{"Invalid ID", admin, "/api/user/abc/lockout", nil, http.StatusBadRequest},
+		{"User not found", admin, "/api/user/99/lockout", notFoundErr, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mockAuthService{unlockErr: tt.unlockErr}
+			mux := http.NewServeMux()
+			NewAuthHandler(mockService).RegisterUserRoutes(mux, "/api/user")
+
+			r := httptest.NewRequest(http.MethodDelete, tt.target, nil)
+			r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, tt.user))
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+			if tt.expectedStatus == http.StatusNoContent && mockService.lastUnlocked != 2 {
+				t.Errorf("expected user 2 to be unlocked instead of %d", mockService.lastUnlocked)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// DishHandler handles HTTP requests related to the dishes planned by a group.
+type DishHandler struct {
+	dishService  service.DishServiceInterface
+	groupService service.GroupServiceInterface
+}
+
+// NewDishHandler constructs a new DishHandler with the provided services.
+func NewDishHandler(dishService service.DishServiceInterface, groupService service.GroupServiceInterface) *DishHandler {
+	return &DishHandler{
+		dishService:  dishService,
+		groupService: groupService,
+	}
+}
+
+// RegisterRoutes registers the dish-related routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; every route is restricted to the members of the group.
+func (h *DishHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+	dishMember := middleware.GroupMember(h.groupService, middleware.GroupFromResource("dishes", "id", h.dishGroup))
+	dishScoped := middleware.Stack(middleware.IntPathValues("groupId", "id"), member, dishMember)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.getDishes)))
+	mux.Handle("GET "+prefix+"/cost", groupScoped(http.HandlerFunc(h.getDishesCost)))
+	mux.Handle("GET "+prefix+"/{id}", dishScoped(http.HandlerFunc(h.getDishByID)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.createDish)))
+	mux.Handle("PUT "+prefix+"/{id}", dishScoped(http.HandlerFunc(h.updateDish)))
+	mux.Handle("PUT "+prefix+"/{id}/move", dishScoped(http.HandlerFunc(h.moveDish)))
+	mux.Handle("POST "+prefix+"/{id}/cook", dishScoped(http.HandlerFunc(h.cookDish)))
+	mux.Handle("DELETE "+prefix+"/{id}", dishScoped(http.HandlerFunc(h.deleteDish)))
+}
+
+// GetDishes godoc
+// @Summary Get planned dishes
+// @Description Get the dishes of a group planned over a period, e.g. a week or a month, sorted by date.
+// @Description Dates are either RFC 3339 dates and times or days (YYYY-MM-DD) starting at midnight UTC.
+// @Tags dish
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param from query string true "Start of the period, included"
+// @Param to query string true "End of the period, excluded"
+// @Success 200 {array} dto.DishDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/dish [get]
+func (h *DishHandler) getDishes(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	from, err := requiredTimeQueryParam(r, "from")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	to, err := requiredTimeQueryParam(r, "to")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dishes, err := h.dishService.GetByGroupIDAndPeriod(groupID, from, to)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(dishes, mapper.ToDishDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_DISH_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetDishesCost godoc
+// @Summary Estimate the cost of the meal plan
+// @Description Estimate the cost of the dishes of a group scheduled from the from date included to the to date excluded,
+// @Description in total and per portion, from the average market price of the items of their recipes. The ingredients which can't be priced are listed.
+// @Tags dish
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param from query string true "Start of the period, as a date or an RFC 3339 date and time"
+// @Param to query string true "End of the period, excluded, as a date or an RFC 3339 date and time"
+// @Success 200 {object} dto.CostEstimateDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/dish/cost [get]
+func (h *DishHandler) getDishesCost(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	from, err := requiredTimeQueryParam(r, "from")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	to, err := requiredTimeQueryParam(r, "to")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	estimate, err := h.dishService.EstimateCost(groupID, from, to)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToCostEstimateDto(estimate)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_COST_ESTIMATE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetDishByID godoc
+// @Summary Get dish by ID
+// @Description Get a dish planned by a group by its ID, with a summary of its recipes
+// @Tags dish
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Dish ID"
+// @Success 200 {object} dto.DishDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Dish not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/dish/{id} [get]
+func (h *DishHandler) getDishByID(w http.ResponseWriter, r *http.Request) {
+	dish, err := h.dishService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToDishDto(dish)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_DISH_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateDish godoc
+// @Summary Plan a new dish
+// @Description Schedule a dish made of one or more recipes of a group, at a date and for a number of portions
+// @Tags dish
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param dish body dto.NewDishDto true "New Dish Data"
+// @Success 201 {object} map[string]int "Returns the new dish ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: invalid recipe"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/dish [post]
+func (h *DishHandler) createDish(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newDishDto dto.NewDishDto
+	if err := json.NewDecoder(r.Body).Decode(&newDishDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.dishService.Create(r.Context(), mapper.FromNewDishDtoToDish(&newDishDto, groupID))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// UpdateDish godoc
+// @Summary Update a dish
+// @Description Replace the date, portions and recipes of a dish planned by a group
+// @Tags dish
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Dish ID"
+// @Param dish body dto.NewDishDto true "Dish Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Dish not found"
+// @Failure 409 {string} string "Conflict: invalid recipe"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/dish/{id} [put]
+func (h *DishHandler) updateDish(w http.ResponseWriter, r *http.Request) {
+	currentDish, err := h.dishService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var dishDto dto.NewDishDto
+	if err := json.NewDecoder(r.Body).Decode(&dishDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dish := mapper.FromNewDishDtoToDish(&dishDto, currentDish.GroupID)
+	dish.ID = currentDish.ID
+
+	if err := h.dishService.Update(r.Context(), dish); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MoveDish godoc
+// @Summary Move a dish
+// @Description Reschedule a dish planned by a group at another date, keeping its portions and recipes
+// @Tags dish
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Dish ID"
+// @Param move body dto.DishMoveDto true "New date of the dish"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Dish not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/dish/{id}/move [put]
+func (h *DishHandler) moveDish(w http.ResponseWriter, r *http.Request) {
+	dish, err := h.dishService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var moveDto dto.DishMoveDto
+	if err := json.NewDecoder(r.Body).Decode(&moveDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dishService.Move(r.Context(), dish.ID, moveDto.Datetime); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CookDish godoc
+// @Summary Cook a dish
+// @Description Deduct the ingredients of the recipes of a dish, scaled to its portions, from the pantry of the group.
+// @Description Cooking a dish is optional and done once; ingredients missing from the pantry are ignored and stocks never go below zero.
+// @Tags dish
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Dish ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Dish not found"
+// @Failure 409 {string} string "Dish already cooked"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/dish/{id}/cook [post]
+func (h *DishHandler) cookDish(w http.ResponseWriter, r *http.Request) {
+	dish, err := h.dishService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.dishService.Cook(r.Context(), dish.ID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteDish godoc
+// @Summary Delete a dish
+// @Description Remove a dish from the planner of a group; its recipes are kept
+// @Tags dish
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Dish ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Dish not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/dish/{id} [delete]
+func (h *DishHandler) deleteDish(w http.ResponseWriter, r *http.Request) {
+	dish, err := h.dishService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.dishService.Delete(r.Context(), dish.ID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// dishGroup returns the ID of the group owning a dish, for middleware.GroupFromResource.
+func (h *DishHandler) dishGroup(id int64) (*int64, error) {
+	dish, err := h.dishService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &dish.GroupID, nil
+}
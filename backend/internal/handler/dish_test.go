@@ -0,0 +1,539 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockDishService is a mock implementation of DishServiceInterface for testing handler
+type mockDishService struct {
+	dishes    []model.Dish
+	nextID    int64
+	createErr error
+	updateErr error
+	cookedIDs []int64
+}
+
+func (m *mockDishService) GetByID(id int64) (*model.Dish, error) {
+	for i := range m.dishes {
+		if m.dishes[i].ID == id {
+			return &m.dishes[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("dishes", "id", nil)
+}
+
+func (m *mockDishService) GetByGroupIDAndPeriod(groupID int64, from, to time.Time) ([]model.Dish, error) {
+	if !to.After(from) {
+		return nil, customErrors.NewValidationError("to", customErrors.DISH_PERIOD_FIELD_ERROR, nil)
+	}
+	result := []model.Dish{}
+	for _, dish := range m.dishes {
+		if dish.GroupID == groupID && !dish.Datetime.Before(from) && dish.Datetime.Before(to) {
+			result = append(result, dish)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockDishService) EstimateCost(groupID int64, from, to time.Time) (*model.CostEstimate, error) {
+	dishes, err := m.GetByGroupIDAndPeriod(groupID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	estimate := &model.CostEstimate{Servings: new(0), UnpricedIngredients: []model.Ingredient{}}
+	for _, dish := range dishes {
+		estimate.Cost += 10
+		*estimate.Servings += dish.Portion
+	}
+	return estimate, nil
+}
+
+func (m *mockDishService) Create(_ context.Context, dish *model.Dish) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+	dish.ID = m.nextID
+	m.nextID++
+	m.dishes = append(m.dishes, *dish)
+	return dish.ID, nil
+}
+
+func (m *mockDishService) Update(_ context.Context, dish *model.Dish) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for i := range m.dishes {
+		if m.dishes[i].ID == dish.ID {
+			m.dishes[i] = *dish
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("dishes", "id", nil)
+}
+
+func (m *mockDishService) Move(_ context.Context, id int64, datetime time.Time) error {
+	dish, err := m.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if datetime.IsZero() {
+		return customErrors.NewInvalidParamsError([]string{"datetime"}, nil)
+	}
+	dish.Datetime = datetime
+	return nil
+}
+
+func (m *mockDishService) Cook(_ context.Context, id int64) error {
+	if _, err := m.GetByID(id); err != nil {
+		return err
+	}
+	m.cookedIDs = append(m.cookedIDs, id)
+	return nil
+}
+
+func (m *mockDishService) Delete(_ context.Context, id int64) error {
+	for i := range m.dishes {
+		if m.dishes[i].ID == id {
+			m.dishes = append(m.dishes[:i], m.dishes[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("dishes", "id", nil)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+var dishDatetime = time.Date(2026, time.October, 20, 19, 30, 0, 0, time.UTC)
+
+func setupDishTestData() (*mockDishService, *mockGroupService) {
+	dishService := &mockDishService{
+		dishes: []model.Dish{
+			{ID: 1, Portion: 4, Datetime: dishDatetime, GroupID: 1, Recipes: []model.Recipe{{ID: 1, Name: "Grilled Chicken"}}},
+			{ID: 2, Portion: 2, Datetime: dishDatetime.Add(7 * 24 * time.Hour), GroupID: 1, Recipes: []model.Recipe{{ID: 2, Name: "Cookies"}}},
+			{ID: 3, Portion: 6, Datetime: dishDatetime, GroupID: 2, Recipes: []model.Recipe{{ID: 3, Name: "Tomato Soup"}}},
+		},
+		nextID: 4,
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return dishService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewDishHandler(t *testing.T) {
+	dishService, groupService := setupDishTestData()
+	handler := NewDishHandler(dishService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.dishService != dishService {
+		t.Error("handler dishService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetDishes(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedIDs    []int64
+	}{
+		{"Week as days", "?from=2026-10-19&to=2026-10-26", http.StatusOK, []int64{1}},
+		{"Month as dates and times", "?from=2026-10-01T00:00:00Z&to=2026-11-01T00:00:00%2B01:00", http.StatusOK, []int64{1, 2}},
+		{"Missing end", "?from=2026-10-19", http.StatusBadRequest, nil},
+		{"Invalid start", "?from=monday&to=2026-10-26", http.StatusBadRequest, nil},
+		{"Reversed period", "?from=2026-10-26&to=2026-10-19", http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dishService, groupService := setupDishTestData()
+			handler := NewDishHandler(dishService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/dish"+tt.query, nil, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.getDishes(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual []dto.DishDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d dishes instead of %d", len(tt.expectedIDs), len(actual))
+			}
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected dish %d instead of %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestGetDishesCost(t *testing.T) {
+	tests := []struct {
+		name                   string
+		query                  string
+		expectedStatus         int
+		expectedCost           float64
+		expectedCostPerServing float64
+	}{
+		{"Week of a dish", "?from=2026-10-19&to=2026-10-26", http.StatusOK, 10, 2.5},
+		{"Month of two dishes", "?from=2026-10-01&to=2026-11-01", http.StatusOK, 20, 20.0 / 6},
+		{"Missing end", "?from=2026-10-19", http.StatusBadRequest, 0, 0},
+		{"Reversed period", "?from=2026-10-26&to=2026-10-19", http.StatusBadRequest, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dishService, groupService := setupDishTestData()
+			handler := NewDishHandler(dishService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/dish/cost"+tt.query, nil, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.getDishesCost(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.CostEstimateDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.Cost != tt.expectedCost || actual.CostPerServing == nil || *actual.CostPerServing != tt.expectedCostPerServing {
+				t.Errorf("expected a cost of %v and %v per serving, got %+v", tt.expectedCost, tt.expectedCostPerServing, actual)
+			}
+		})
+	}
+}
+
+func TestGetDishByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		dishID         int64
+		expectedStatus int
+	}{
+		{"Dish of the group", 1, http.StatusOK},
+		{"Unknown dish", -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dishService, groupService := setupDishTestData()
+			handler := NewDishHandler(dishService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/dish", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.dishID})
+			w := httptest.NewRecorder()
+
+			handler.getDishByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.DishDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != 1 || len(actual.Recipes) != 1 || actual.Recipes[0].Name != "Grilled Chicken" {
+				t.Errorf("unexpected dish %+v", actual)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateDish(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewDishDto{Portion: 2, Datetime: dishDatetime, RecipeIDs: []int64{1, 2}})
+
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid dish", validBody, nil, http.StatusCreated},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Invalid date", []byte(`{"portion": 2, "datetime": "tomorrow", "recipe_ids": [1]}`), nil, http.StatusBadRequest},
+		{"Recipe of another group", validBody, customErrors.NewConflictError("Recipe", "recipe must belongs to the same group as the dish", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dishService, groupService := setupDishTestData()
+			dishService.createErr = tt.createErr
+			handler := NewDishHandler(dishService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/dish", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.createDish(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var result map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			created, err := dishService.GetByID(result["id"])
+			if err != nil {
+				t.Fatalf("failed to retrieve created dish: %v", err)
+			}
+
+			if created.Portion != 2 || created.GroupID != 1 || len(created.Recipes) != 2 || !created.Datetime.Equal(dishDatetime) {
+				t.Errorf("unexpected created dish %+v", created)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdateDish(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewDishDto{Portion: 8, Datetime: dishDatetime, RecipeIDs: []int64{1}})
+
+	tests := []struct {
+		name           string
+		dishID         int64
+		body           []byte
+		updateErr      error
+		expectedStatus int
+	}{
+		{"Valid update", 1, validBody, nil, http.StatusNoContent},
+		{"Invalid body", 1, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Invalid portion", 1, validBody, customErrors.NewInvalidParamsError([]string{"portion"}, nil), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dishService, groupService := setupDishTestData()
+			dishService.updateErr = tt.updateErr
+			handler := NewDishHandler(dishService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/dish", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.dishID})
+			w := httptest.NewRecorder()
+
+			handler.updateDish(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, _ := dishService.GetByID(tt.dishID)
+			if updated.Portion != 8 || updated.GroupID != 1 {
+				t.Errorf("unexpected updated dish %+v", updated)
+			}
+		})
+	}
+}
+
+func TestMoveDish(t *testing.T) {
+	newDatetime := dishDatetime.Add(48 * time.Hour)
+	validBody, _ := json.Marshal(dto.DishMoveDto{Datetime: newDatetime})
+
+	tests := []struct {
+		name           string
+		dishID         int64
+		body           []byte
+		expectedStatus int
+	}{
+		{"Valid move", 1, validBody, http.StatusNoContent},
+		{"Missing date", 1, []byte("{}"), http.StatusBadRequest},
+		{"Invalid body", 1, []byte("{invalid"), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dishService, groupService := setupDishTestData()
+			handler := NewDishHandler(dishService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/dish/move", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.dishID})
+			w := httptest.NewRecorder()
+
+			handler.moveDish(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			moved, _ := dishService.GetByID(tt.dishID)
+			if !moved.Datetime.Equal(newDatetime) {
+				t.Errorf("expected dish moved to %v instead of %v", newDatetime, moved.Datetime)
+			}
+		})
+	}
+}
+
+func TestCookDish(t *testing.T) {
+	tests := []struct {
+		name           string
+		dishID         int64
+		expectedStatus int
+	}{
+		{"Dish of the group", 1, http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dishService, groupService := setupDishTestData()
+			handler := NewDishHandler(dishService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/dish/cook", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.dishID})
+			w := httptest.NewRecorder()
+
+			handler.cookDish(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			expectedCooked := 0
+			if tt.expectedStatus == http.StatusNoContent {
+				expectedCooked = 1
+			}
+			if len(dishService.cookedIDs) != expectedCooked {
+				t.Errorf("expected %d cooked dishes instead of %v", expectedCooked, dishService.cookedIDs)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeleteDish(t *testing.T) {
+	tests := []struct {
+		name           string
+		dishID         int64
+		expectedStatus int
+	}{
+		{"Dish of the group", 1, http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dishService, groupService := setupDishTestData()
+			handler := NewDishHandler(dishService, groupService)
+			dishesNb := len(dishService.dishes)
+
+			r := newItemRequest(http.MethodDelete, "/dish", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.dishID})
+			w := httptest.NewRecorder()
+
+			handler.deleteDish(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			expectedNb := dishesNb
+			if tt.expectedStatus == http.StatusNoContent {
+				expectedNb--
+			}
+			if len(dishService.dishes) != expectedNb {
+				t.Errorf("expected %d dishes instead of %d", expectedNb, len(dishService.dishes))
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestDishRegisterRoutes(t *testing.T) {
+	dishService, groupService := setupDishTestData()
+	handler := NewDishHandler(dishService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/dish")
+
+	r := httptest.NewRequest(http.MethodGet, "/api/group/1/dish?from=2026-10-19&to=2026-10-26", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/dish instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/dish/cost?from=2026-10-19&to=2026-10-26", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/dish/cost instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/dish/1", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/dish/1 as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	// Dishes of another group are reported as not found on every route.
+	assertRoutesStatus(t, mux, memberUser, []string{
+		"GET /api/group/1/dish/3",
+		"PUT /api/group/1/dish/3",
+		"PUT /api/group/1/dish/3/move",
+		"POST /api/group/1/dish/3/cook",
+		"DELETE /api/group/1/dish/3",
+	}, http.StatusNotFound)
+}
@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// GroceryHandler handles HTTP requests related to the grocery list of a group.
+type GroceryHandler struct {
+	groceryService service.GroceryServiceInterface
+	groupService   service.GroupServiceInterface
+}
+
+// NewGroceryHandler constructs a new GroceryHandler with the provided services.
+func NewGroceryHandler(groceryService service.GroceryServiceInterface, groupService service.GroupServiceInterface) *GroceryHandler {
+	return &GroceryHandler{
+		groceryService: groceryService,
+		groupService:   groupService,
+	}
+}
+
+// RegisterRoutes registers the grocery-related routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; every route is restricted to the members of the group.
+func (h *GroceryHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+	groceryMember := middleware.GroupMember(h.groupService, middleware.GroupFromResource("groceries", "id", h.groceryGroup))
+	groceryScoped := middleware.Stack(middleware.IntPathValues("groupId", "id"), member, groceryMember)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.getGroceries)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.createGrocery)))
+	mux.Handle("POST "+prefix+"/generate", groupScoped(http.HandlerFunc(h.generateGroceries)))
+	mux.Handle("PUT "+prefix+"/{id}", groceryScoped(http.HandlerFunc(h.updateGrocery)))
+	mux.Handle("PUT "+prefix+"/{id}/bought", groceryScoped(http.HandlerFunc(h.setGroceryBought)))
+	mux.Handle("DELETE "+prefix+"/bought", groupScoped(http.HandlerFunc(h.deleteBoughtGroceries)))
+	mux.Handle("DELETE "+prefix+"/{id}", groceryScoped(http.HandlerFunc(h.deleteGrocery)))
+}
+
+// GetGroceries godoc
+// @Summary Get the grocery list
+// @Description Get the grocery list of a group, grouped by item category in the order of the categories,
+// @Description the lines of a category being sorted by item name
+// @Tags grocery
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 200 {array} dto.GroceryAisleDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/grocery [get]
+func (h *GroceryHandler) getGroceries(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	groceries, err := h.groceryService.GetByGroupID(groupID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToGroceryAisleDtos(groceries)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_GROCERY_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateGrocery godoc
+// @Summary Add a grocery line
+// @Description Add a line to the grocery list of a group; its item must belong to the group and its unit to the catalogue or to the group
+// @Tags grocery
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param grocery body dto.NewGroceryDto true "New Grocery Data"
+// @Success 201 {object} map[string]int "Returns the new grocery ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: invalid item or unit"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/grocery [post]
+func (h *GroceryHandler) createGrocery(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newGroceryDto dto.NewGroceryDto
+	if err := json.NewDecoder(r.Body).Decode(&newGroceryDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.groceryService.Create(mapper.FromNewGroceryDtoToGrocery(&newGroceryDto, groupID))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// GenerateGroceries godoc
+// @Summary Generate the grocery list from the meal plan
+// @Description Add to the grocery list of a group the ingredients of the dishes not bought yet, scheduled from the from date included to the to date excluded,
+// @Description then flag these dishes as bought. Quantities are scaled by the portion of each dish against the servings of its recipe,
+// @Description and merged per item into the existing grocery lines when their units can be converted.
+// @Tags grocery
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param from query string true "Start of the period, as a date or an RFC 3339 date and time"
+// @Param to query string true "End of the period, excluded, as a date or an RFC 3339 date and time"
+// @Success 200 {array} dto.GroceryAisleDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/grocery/generate [post]
+func (h *GroceryHandler) generateGroceries(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	from, err := requiredTimeQueryParam(r, "from")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	to, err := requiredTimeQueryParam(r, "to")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	groceries, err := h.groceryService.GenerateFromDishes(r.Context(), groupID, from, to)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToGroceryAisleDtos(groceries)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_GROCERY_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpdateGrocery godoc
+// @Summary Update a grocery line
+// @Description Replace the item, unit and wanted quantity of a grocery line of a group; the quantity already bought is kept
+// @Tags grocery
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Grocery ID"
+// @Param grocery body dto.NewGroceryDto true "Grocery Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Grocery not found"
+// @Failure 409 {string} string "Conflict: invalid item or unit"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/grocery/{id} [put]
+func (h *GroceryHandler) updateGrocery(w http.ResponseWriter, r *http.Request) {
+	currentGrocery, err := h.groceryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var groceryDto dto.NewGroceryDto
+	if err := json.NewDecoder(r.Body).Decode(&groceryDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grocery := mapper.FromNewGroceryDtoToGrocery(&groceryDto, currentGrocery.GroupID)
+	grocery.ID = currentGrocery.ID
+
+	if err := h.groceryService.Update(grocery); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetGroceryBought godoc
+// @Summary Mark a grocery line as bought
+// @Description Set the quantity bought of a grocery line of a group; the line is checked off when the quantity is omitted.
+// @Description The quantity newly bought is added to the pantry of the group, and the quantity unchecked is taken back from it.
+// @Tags grocery
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Grocery ID"
+// @Param bought body dto.GroceryBoughtDto false "Quantity bought"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Grocery not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/grocery/{id}/bought [put]
+func (h *GroceryHandler) setGroceryBought(w http.ResponseWriter, r *http.Request) {
+	grocery, err := h.groceryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// The body is optional: an empty one checks the line off.
+	var boughtDto dto.GroceryBoughtDto
+	if err := json.NewDecoder(r.Body).Decode(&boughtDto); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.groceryService.SetBought(r.Context(), grocery.ID, boughtDto.QuantityBought); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteGrocery godoc
+// @Summary Remove a grocery line
+// @Description Remove a line from the grocery list of a group
+// @Tags grocery
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Grocery ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Grocery not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/grocery/{id} [delete]
+func (h *GroceryHandler) deleteGrocery(w http.ResponseWriter, r *http.Request) {
+	grocery, err := h.groceryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.groceryService.Delete(grocery.ID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteBoughtGroceries godoc
+// @Summary Clear the bought grocery lines
+// @Description Remove the lines of the grocery list of a group whose whole quantity has been bought
+// @Tags grocery
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 200 {object} map[string]int "Returns the number of removed lines"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/grocery/bought [delete]
+func (h *GroceryHandler) deleteBoughtGroceries(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	deletedNb, err := h.groceryService.DeleteBought(groupID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	fmt.Fprintf(w, `{"deleted": %d}`, deletedNb)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// groceryGroup returns the ID of the group owning a grocery line, for middleware.GroupFromResource.
+func (h *GroceryHandler) groceryGroup(id int64) (*int64, error) {
+	grocery, err := h.groceryService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &grocery.GroupID, nil
+}
@@ -0,0 +1,489 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockGroceryService is a mock implementation of GroceryServiceInterface for testing handler
+type mockGroceryService struct {
+	groceries []model.Grocery
+	nextID    int64
+	createErr error
+	updateErr error
+	generated bool
+}
+
+func (m *mockGroceryService) HasItem(itemID int64) (bool, error) {
+	for _, grocery := range m.groceries {
+		if grocery.Item.ID == itemID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockGroceryService) GetByID(id int64) (*model.Grocery, error) {
+	for i := range m.groceries {
+		if m.groceries[i].ID == id {
+			return &m.groceries[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("groceries", "id", nil)
+}
+
+func (m *mockGroceryService) GetByGroupID(groupID int64) ([]model.Grocery, error) {
+	result := []model.Grocery{}
+	for _, grocery := range m.groceries {
+		if grocery.GroupID == groupID {
+			result = append(result, grocery)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockGroceryService) Create(grocery *model.Grocery) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+	grocery.ID = m.nextID
+	m.nextID++
+	m.groceries = append(m.groceries, *grocery)
+	return grocery.ID, nil
+}
+
+func (m *mockGroceryService) GenerateFromDishes(_ context.Context, groupID int64, from, to time.Time) ([]model.Grocery, error) {
+	if !to.After(from) {
+		return nil, customErrors.NewValidationError("to", customErrors.DISH_PERIOD_FIELD_ERROR, nil)
+	}
+	m.generated = true
+	return m.GetByGroupID(groupID)
+}
+
+func (m *mockGroceryService) Update(grocery *model.Grocery) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for i := range m.groceries {
+		if m.groceries[i].ID == grocery.ID {
+			grocery.QuantityBought = m.groceries[i].QuantityBought
+			m.groceries[i] = *grocery
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("groceries", "id", nil)
+}
+
+func (m *mockGroceryService) SetBought(_ context.Context, id int64, quantityBought *float64) error {
+	grocery, err := m.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if quantityBought == nil {
+		grocery.QuantityBought = grocery.UserQuantity
+		return nil
+	}
+	if *quantityBought < 0 {
+		return customErrors.NewInvalidParamsError([]string{"quantity_bought"}, nil)
+	}
+	grocery.QuantityBought = *quantityBought
+	return nil
+}
+
+func (m *mockGroceryService) Delete(id int64) error {
+	for i := range m.groceries {
+		if m.groceries[i].ID == id {
+			m.groceries = append(m.groceries[:i], m.groceries[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("groceries", "id", nil)
+}
+
+func (m *mockGroceryService) DeleteBought(groupID int64) (int64, error) {
+	var deletedNb int64
+	result := []model.Grocery{}
+	for _, grocery := range m.groceries {
+		if grocery.GroupID == groupID && grocery.QuantityBought >= grocery.UserQuantity {
+			deletedNb++
+			continue
+		}
+		result = append(result, grocery)
+	}
+	m.groceries = result
+	return deletedNb, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupGroceryTestData() (*mockGroceryService, *mockGroupService) {
+	grains := model.ItemCategory{ID: 1, Name: "GRAINS AND PASTA", GroupID: 1, Position: 0}
+	dairy := model.ItemCategory{ID: 4, Name: "DAIRY", GroupID: 1, Position: 3}
+
+	groceryService := &mockGroceryService{
+		groceries: []model.Grocery{
+			{ID: 1, UserQuantity: 2, Item: model.Item{ID: 1, Name: "Flour", ItemCategory: grains}, Unit: model.Unit{ID: 1, Name: "Kilogram"}, GroupID: 1},
+			{ID: 3, QuantityBought: 12, UserQuantity: 12, Item: model.Item{ID: 4, Name: "Eggs", ItemCategory: dairy}, Unit: model.Unit{ID: 8, Name: "Piece"}, GroupID: 1},
+			{ID: 4, UserQuantity: 2, Item: model.Item{ID: 5, Name: "Milk", ItemCategory: dairy}, Unit: model.Unit{ID: 3, Name: "Liter"}, GroupID: 1},
+			{ID: 5, UserQuantity: 1, Item: model.Item{ID: 7, Name: "Chicken Breast"}, Unit: model.Unit{ID: 1, Name: "Kilogram"}, GroupID: 2},
+		},
+		nextID: 9,
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return groceryService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewGroceryHandler(t *testing.T) {
+	groceryService, groupService := setupGroceryTestData()
+	handler := NewGroceryHandler(groceryService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.groceryService != groceryService {
+		t.Error("handler groceryService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetGroceries(t *testing.T) {
+	groceryService, groupService := setupGroceryTestData()
+	handler := NewGroceryHandler(groceryService, groupService)
+
+	r := newItemRequest(http.MethodGet, "/grocery", nil, memberUser, map[string]int64{"groupId": 1})
+	w := httptest.NewRecorder()
+
+	handler.getGroceries(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d instead of %d", http.StatusOK, w.Code)
+	}
+
+	var actual []dto.GroceryAisleDto
+	if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(actual) != 2 || actual[0].ItemCategory.Name != "GRAINS AND PASTA" || actual[1].ItemCategory.Name != "DAIRY" {
+		t.Fatalf("expected the aisles GRAINS AND PASTA and DAIRY, got %+v", actual)
+	}
+	if len(actual[1].Groceries) != 2 || !actual[1].Groceries[0].Bought || actual[1].Groceries[1].Bought {
+		t.Errorf("expected bought eggs and milk to buy in the DAIRY aisle, got %+v", actual[1].Groceries)
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateGrocery(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewGroceryDto{UserQuantity: 6, ItemID: 4, UnitID: 8})
+
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid grocery", validBody, nil, http.StatusCreated},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Invalid quantity", validBody, customErrors.NewInvalidParamsError([]string{"user_quantity"}, nil), http.StatusBadRequest},
+		{"Item of another group", validBody, customErrors.NewConflictError("Item", "item must belongs to the same group as the grocery", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groceryService, groupService := setupGroceryTestData()
+			groceryService.createErr = tt.createErr
+			handler := NewGroceryHandler(groceryService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/grocery", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.createGrocery(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var result map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			created, err := groceryService.GetByID(result["id"])
+			if err != nil {
+				t.Fatalf("failed to retrieve created grocery: %v", err)
+			}
+
+			if created.UserQuantity != 6 || created.Item.ID != 4 || created.Unit.ID != 8 || created.GroupID != 1 {
+				t.Errorf("unexpected created grocery %+v", created)
+			}
+		})
+	}
+}
+
+func TestGenerateGroceries(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+	}{
+		{"Week as days", "?from=2026-10-19&to=2026-10-26", http.StatusOK},
+		{"Missing start", "?to=2026-10-26", http.StatusBadRequest},
+		{"Invalid end", "?from=2026-10-19&to=sunday", http.StatusBadRequest},
+		{"Reversed period", "?from=2026-10-26&to=2026-10-19", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groceryService, groupService := setupGroceryTestData()
+			handler := NewGroceryHandler(groceryService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/grocery/generate"+tt.query, nil, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.generateGroceries(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				if groceryService.generated {
+					t.Error("expected the grocery list not to be generated")
+				}
+				return
+			}
+
+			var actual []dto.GroceryAisleDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if !groceryService.generated || len(actual) != 2 {
+				t.Errorf("expected the generated grocery list of the group, got %+v", actual)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdateGrocery(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewGroceryDto{UserQuantity: 3, ItemID: 5, UnitID: 3})
+
+	tests := []struct {
+		name           string
+		groceryID      int64
+		body           []byte
+		updateErr      error
+		expectedStatus int
+	}{
+		{"Valid update", 4, validBody, nil, http.StatusNoContent},
+		{"Invalid body", 4, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Unknown unit", 4, validBody, customErrors.NewConflictError("Unit", "unit must exists", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groceryService, groupService := setupGroceryTestData()
+			groceryService.updateErr = tt.updateErr
+			handler := NewGroceryHandler(groceryService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/grocery", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.groceryID})
+			w := httptest.NewRecorder()
+
+			handler.updateGrocery(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, _ := groceryService.GetByID(tt.groceryID)
+			if updated.UserQuantity != 3 || updated.GroupID != 1 {
+				t.Errorf("unexpected updated grocery %+v", updated)
+			}
+		})
+	}
+}
+
+func TestSetGroceryBought(t *testing.T) {
+	tests := []struct {
+		name           string
+		groceryID      int64
+		body           []byte
+		expectedStatus int
+		expected       float64
+	}{
+		{"Check off without body", 4, nil, http.StatusNoContent, 2},
+		{"Check off with an empty body", 4, []byte("{}"), http.StatusNoContent, 2},
+		{"Partial quantity", 4, []byte(`{"quantity_bought": 0.5}`), http.StatusNoContent, 0.5},
+		{"Negative quantity", 4, []byte(`{"quantity_bought": -1}`), http.StatusBadRequest, 0},
+		{"Invalid body", 4, []byte("{invalid"), http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groceryService, groupService := setupGroceryTestData()
+			handler := NewGroceryHandler(groceryService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/grocery/bought", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.groceryID})
+			w := httptest.NewRecorder()
+
+			handler.setGroceryBought(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			grocery, _ := groceryService.GetByID(tt.groceryID)
+			if grocery.QuantityBought != tt.expected {
+				t.Errorf("expected %v bought instead of %v", tt.expected, grocery.QuantityBought)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeleteGrocery(t *testing.T) {
+	tests := []struct {
+		name           string
+		groceryID      int64
+		expectedStatus int
+	}{
+		{"Existing grocery", 1, http.StatusNoContent},
+		{"Unknown grocery", -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groceryService, groupService := setupGroceryTestData()
+			handler := NewGroceryHandler(groceryService, groupService)
+			groceriesNb := len(groceryService.groceries)
+
+			r := newItemRequest(http.MethodDelete, "/grocery", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.groceryID})
+			w := httptest.NewRecorder()
+
+			handler.deleteGrocery(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			expectedNb := groceriesNb
+			if tt.expectedStatus == http.StatusNoContent {
+				expectedNb--
+			}
+			if len(groceryService.groceries) != expectedNb {
+				t.Errorf("expected %d groceries instead of %d", expectedNb, len(groceryService.groceries))
+			}
+		})
+	}
+}
+
+func TestDeleteBoughtGroceries(t *testing.T) {
+	groceryService, groupService := setupGroceryTestData()
+	handler := NewGroceryHandler(groceryService, groupService)
+
+	r := newItemRequest(http.MethodDelete, "/grocery/bought", nil, memberUser, map[string]int64{"groupId": 1})
+	w := httptest.NewRecorder()
+
+	handler.deleteBoughtGroceries(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d instead of %d", http.StatusOK, w.Code)
+	}
+
+	var result map[string]int64
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if result["deleted"] != 1 || len(groceryService.groceries) != 3 {
+		t.Errorf("expected the eggs only to be deleted, got %v deleted and %d remaining", result["deleted"], len(groceryService.groceries))
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestGroceryRegisterRoutes(t *testing.T) {
+	groceryService, groupService := setupGroceryTestData()
+	handler := NewGroceryHandler(groceryService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/grocery")
+
+	r := httptest.NewRequest(http.MethodDelete, "/api/group/1/grocery/bought", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for DELETE /api/group/1/grocery/bought instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodPut, "/api/group/1/grocery/1/bought", bytes.NewReader(nil))
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d for PUT /api/group/1/grocery/1/bought instead of %d", http.StatusNoContent, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/api/group/1/grocery/generate?from=2026-10-19&to=2026-10-26", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for POST /api/group/1/grocery/generate instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/grocery", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/grocery as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	// Grocery lines of another group are reported as not found on every route.
+	assertRoutesStatus(t, mux, memberUser, []string{
+		"PUT /api/group/1/grocery/5",
+		"PUT /api/group/1/grocery/5/bought",
+		"DELETE /api/group/1/grocery/5",
+	}, http.StatusNotFound)
+}
@@ -0,0 +1,361 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// GroupHandler handles HTTP requests related to groups and their members.
+type GroupHandler struct {
+	groupService service.GroupServiceInterface
+}
+
+// NewGroupHandler constructs a new GroupHandler with the provided GroupService.
+func NewGroupHandler(groupService service.GroupServiceInterface) *GroupHandler {
+	return &GroupHandler{
+		groupService: groupService,
+	}
+}
+
+// RegisterRoutes registers the group-related routes on the provided ServeMux with the given prefix.
+func (h *GroupHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	admin := middleware.GroupAdmin(h.groupService, middleware.GroupFromPath("groupId"))
+
+	mux.HandleFunc("GET "+prefix, h.getGroups)
+	mux.Handle("GET "+prefix+"/{groupId}", middleware.Stack(middleware.IntPathValues("groupId"), member)(http.HandlerFunc(h.getGroupByID)))
+	mux.HandleFunc("POST "+prefix, h.createGroup)
+	mux.Handle("PUT "+prefix+"/{groupId}", middleware.Stack(middleware.IntPathValues("groupId"), admin)(http.HandlerFunc(h.updateGroup)))
+	mux.Handle("DELETE "+prefix+"/{groupId}", middleware.Stack(middleware.IntPathValues("groupId"), admin)(http.HandlerFunc(h.deleteGroup)))
+	mux.Handle("POST "+prefix+"/{groupId}/member", middleware.Stack(middleware.IntPathValues("groupId"), admin)(http.HandlerFunc(h.addGroupMember)))
+	mux.Handle("PATCH "+prefix+"/{groupId}/member/{userId}/admin", middleware.Stack(middleware.IntPathValues("groupId", "userId"), admin)(http.HandlerFunc(h.updateGroupMemberAdmin)))
+	mux.Handle("DELETE "+prefix+"/{groupId}/member/{userId}", middleware.Stack(middleware.IntPathValues("groupId", "userId"), member)(http.HandlerFunc(h.removeGroupMember)))
+	mux.Handle("POST "+prefix+"/{groupId}/leave", middleware.Stack(middleware.IntPathValues("groupId"), member)(http.HandlerFunc(h.leaveGroup)))
+}
+
+// GetGroups godoc
+// @Summary Get the groups of the authenticated user
+// @Description Get all the groups the authenticated user is a member of
+// @Tags group
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.GroupDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group [get]
+func (h *GroupHandler) getGroups(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	groups, err := h.groupService.GetByUserID(u.ID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(groups, mapper.ToGroupDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_GROUP_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetGroupByID godoc
+// @Summary Get group by ID
+// @Description Get a group the authenticated user is a member of, with its members
+// @Tags group
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 200 {object} dto.GroupDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId} [get]
+func (h *GroupHandler) getGroupByID(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	group, err := h.groupService.GetByID(groupID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToGroupDto(group)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_GROUP_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateGroup godoc
+// @Summary Create a new group
+// @Description Create a new group whose admin is the authenticated user
+// @Tags group
+// @Accept json
+// @Produce json
+// @Param group body dto.NewGroupDto true "New Group Data"
+// @Success 201 {object} map[string]int "Returns the new group ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group [post]
+func (h *GroupHandler) createGroup(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var newGroupDto dto.NewGroupDto
+	if err := json.NewDecoder(r.Body).Decode(&newGroupDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.groupService.Create(r.Context(), mapper.FromNewGroupDtoToGroup(&newGroupDto), u.ID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// UpdateGroup godoc
+// @Summary Update a group
+// @Description Rename a group and/or change its image; only a group admin can do so
+// @Tags group
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param group body dto.NewGroupDto true "Group Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId} [put]
+func (h *GroupHandler) updateGroup(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var groupDto dto.NewGroupDto
+	if err := json.NewDecoder(r.Body).Decode(&groupDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	group := mapper.FromNewGroupDtoToGroup(&groupDto)
+	group.ID = groupID
+
+	if err := h.groupService.Update(group); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteGroup godoc
+// @Summary Delete a group
+// @Description Delete a group and all its data (recipes, items, dishes, groceries...); only a group admin can do so
+// @Tags group
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId} [delete]
+func (h *GroupHandler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	if err := h.groupService.Delete(r.Context(), groupID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddGroupMember godoc
+// @Summary Add a member to a group
+// @Description Add a user to a group, optionally as an admin; only a group admin can do so
+// @Tags group
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param member body dto.NewGroupMemberDto true "New Member Data"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Group or user not found"
+// @Failure 409 {string} string "Conflict: user already a member"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/member [post]
+func (h *GroupHandler) addGroupMember(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newMemberDto dto.NewGroupMemberDto
+	if err := json.NewDecoder(r.Body).Decode(&newMemberDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.groupService.AddMember(mapper.FromNewGroupMemberDtoToGroupMember(&newMemberDto, groupID)); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UpdateGroupMemberAdmin godoc
+// @Summary Promote or demote a group member
+// @Description Grant or revoke the group admin role of a member; only a group admin can do so and the last admin cannot be demoted
+// @Tags group
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param userId path int true "User ID"
+// @Param payload body dto.GroupAdminPayload true "Admin role"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Group or member not found"
+// @Failure 409 {string} string "Conflict: last admin of the group"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/member/{userId}/admin [patch]
+func (h *GroupHandler) updateGroupMemberAdmin(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+	userID := r.Context().Value("userId").(int64)
+
+	var payload dto.GroupAdminPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.groupService.UpdateMemberAdmin(groupID, userID, payload.Admin); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveGroupMember godoc
+// @Summary Remove a member from a group
+// @Description Remove a user from a group; only a group admin, or the member themselves, can do so and the last admin cannot be removed
+// @Tags group
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param userId path int true "User ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Group or member not found"
+// @Failure 409 {string} string "Conflict: last admin of the group"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/member/{userId} [delete]
+func (h *GroupHandler) removeGroupMember(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+	userID := r.Context().Value("userId").(int64)
+
+	// Only admins can remove other members.
+	if member := sessionGroupMember(r); !member.Admin && member.UserID != userID {
+		http.Error(w, customErrors.NewForbiddenError(nil).Error(), http.StatusForbidden)
+		return
+	}
+
+	if err := h.groupService.RemoveMember(groupID, userID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// LeaveGroup godoc
+// @Summary Leave a group
+// @Description Remove the authenticated user from a group; the last admin cannot leave it
+// @Tags group
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: last admin of the group"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/leave [post]
+func (h *GroupHandler) leaveGroup(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	if err := h.groupService.RemoveMember(groupID, sessionGroupMember(r).UserID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
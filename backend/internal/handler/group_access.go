@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// sessionUser returns the authenticated user of the request.
+// Returns an UnauthorizedError if there is none.
+func sessionUser(r *http.Request) (*model.User, error) {
+	u, ok := r.Context().Value(ctx.UserCtxKey{}).(*model.User)
+	if !ok || u == nil {
+		return nil, customErrors.NewUnauthorizedError("no authenticated user", nil)
+	}
+
+	return u, nil
+}
+
+// sessionGroupMember returns the membership of the session user in the group of the request,
+// as injected by the middleware.GroupMember and middleware.GroupAdmin middlewares.
+func sessionGroupMember(r *http.Request) *model.GroupMember {
+	return r.Context().Value(ctx.GroupMemberCtxKey{}).(*model.GroupMember)
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// GroupInvitationHandler handles HTTP requests related to the invitations to join a group.
+type GroupInvitationHandler struct {
+	invitationService service.GroupInvitationServiceInterface
+	groupService      service.GroupServiceInterface
+}
+
+// NewGroupInvitationHandler constructs a new GroupInvitationHandler with the provided services.
+func NewGroupInvitationHandler(invitationService service.GroupInvitationServiceInterface, groupService service.GroupServiceInterface) *GroupInvitationHandler {
+	return &GroupInvitationHandler{
+		invitationService: invitationService,
+		groupService:      groupService,
+	}
+}
+
+// RegisterRoutes registers the invitation-related routes on the provided ServeMux with the given prefix.
+// Invitations are managed under <prefix>/group/{groupId}/invite and accepted under <prefix>/invite/{token}/accept.
+func (h *GroupInvitationHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	groupPrefix := prefix + "/group/{groupId}/invite"
+	admin := middleware.GroupAdmin(h.groupService, middleware.GroupFromPath("groupId"))
+
+	mux.Handle("GET "+groupPrefix, middleware.Stack(middleware.IntPathValues("groupId"), admin)(http.HandlerFunc(h.getInvitations)))
+	mux.Handle("POST "+groupPrefix, middleware.Stack(middleware.IntPathValues("groupId"), admin)(http.HandlerFunc(h.createInvitation)))
+	mux.Handle("DELETE "+groupPrefix+"/{id}", middleware.Stack(middleware.IntPathValues("groupId", "id"), admin)(http.HandlerFunc(h.revokeInvitation)))
+	mux.HandleFunc("POST "+prefix+"/invite/{token}/accept", h.acceptInvitation)
+}
+
+// GetInvitations godoc
+// @Summary Get the invitations of a group
+// @Description Get all the invitations of a group, including the expired and revoked ones; only a group admin can do so
+// @Tags invitation
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 200 {array} dto.GroupInvitationDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/invite [get]
+func (h *GroupInvitationHandler) getInvitations(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	invitations, err := h.invitationService.GetByGroupID(groupID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(invitations, mapper.ToGroupInvitationDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_INVITE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateInvitation godoc
+// @Summary Create an invitation
+// @Description Generate an invitation token to join a group, valid 7 days and without use limit by default; only a group admin can do so
+// @Tags invitation
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param invitation body dto.NewGroupInvitationDto true "Invitation expiry date and max uses"
+// @Success 201 {object} dto.GroupInvitationDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/invite [post]
+func (h *GroupInvitationHandler) createInvitation(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newInvitationDto dto.NewGroupInvitationDto
+	if err := json.NewDecoder(r.Body).Decode(&newInvitationDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	invitation := mapper.FromNewGroupInvitationDtoToGroupInvitation(&newInvitationDto, groupID, sessionGroupMember(r).UserID)
+
+	if _, err := h.invitationService.Create(invitation); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(mapper.ToGroupInvitationDto(invitation)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_INVITE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// RevokeInvitation godoc
+// @Summary Revoke an invitation
+// @Description Prevent any further use of an invitation of a group; only a group admin can do so
+// @Tags invitation
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Invitation ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Invitation not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/invite/{id} [delete]
+func (h *GroupInvitationHandler) revokeInvitation(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	if err := h.invitationService.Revoke(groupID, r.Context().Value("id").(int64)); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AcceptInvitation godoc
+// @Summary Accept an invitation
+// @Description Join the group of the invitation as the authenticated user
+// @Tags invitation
+// @Accept json
+// @Produce json
+// @Param token path string true "Invitation token"
+// @Success 200 {object} map[string]int "Returns the joined group ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Invitation not found"
+// @Failure 409 {string} string "Conflict: invitation expired, revoked or exhausted, or user already a member"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/invite/{token}/accept [post]
+func (h *GroupInvitationHandler) acceptInvitation(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	groupID, err := h.invitationService.Accept(r.Context(), r.PathValue("token"), u.ID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	fmt.Fprintf(w, `{"group_id": %d}`, groupID)
+}
@@ -0,0 +1,357 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockGroupInvitationService is a mock implementation of GroupInvitationServiceInterface for testing handlers
+type mockGroupInvitationService struct {
+	invitations     []model.GroupInvitation
+	nextID          int64
+	createErr       error
+	revokeErr       error
+	acceptErr       error
+	lastAcceptToken string
+	lastAcceptUser  int64
+}
+
+func (m *mockGroupInvitationService) GetByGroupID(groupID int64) ([]model.GroupInvitation, error) {
+	invitations := []model.GroupInvitation{}
+	for _, invitation := range m.invitations {
+		if invitation.GroupID == groupID {
+			invitations = append(invitations, invitation)
+		}
+	}
+	return invitations, nil
+}
+
+func (m *mockGroupInvitationService) Create(invitation *model.GroupInvitation) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	invitation.ID = m.nextID
+	invitation.Token = "generated"
+	m.invitations = append(m.invitations, *invitation)
+	m.nextID++
+	return invitation.ID, nil
+}
+
+func (m *mockGroupInvitationService) Revoke(groupID, id int64) error {
+	if m.revokeErr != nil {
+		return m.revokeErr
+	}
+
+	for i := range m.invitations {
+		if m.invitations[i].ID == id && m.invitations[i].GroupID == groupID {
+			m.invitations[i].Revoked = true
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("group_invitations", "id", nil)
+}
+
+func (m *mockGroupInvitationService) Accept(_ context.Context, token string, userID int64) (int64, error) {
+	if m.acceptErr != nil {
+		return 0, m.acceptErr
+	}
+
+	m.lastAcceptToken = token
+	m.lastAcceptUser = userID
+	for _, invitation := range m.invitations {
+		if invitation.Token == token {
+			return invitation.GroupID, nil
+		}
+	}
+	return 0, customErrors.NewNotFoundError("group_invitations", "token", nil)
+}
+
+func setupGroupInvitationTestData() (*mockGroupInvitationService, *mockGroupService) {
+	invitationService := &mockGroupInvitationService{
+		invitations: []model.GroupInvitation{
+			{ID: 1, Token: "familytoken", GroupID: 1, ExpiresAt: time.Now().Add(time.Hour)},
+			{ID: 2, Token: "friendstoken", GroupID: 2, ExpiresAt: time.Now().Add(time.Hour)},
+		},
+		nextID: 3,
+	}
+
+	return invitationService, setupGroupTestData()
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewGroupInvitationHandler(t *testing.T) {
+	invitationService, groupService := setupGroupInvitationTestData()
+	handler := NewGroupInvitationHandler(invitationService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.invitationService != invitationService {
+		t.Error("handler invitationService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetInvitations(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		expectedStatus int
+		expectedIDs    []int64
+	}{
+		{"Admin of the group", adminUser, 1, http.StatusOK, []int64{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitationService, groupService := setupGroupInvitationTestData()
+			handler := NewGroupInvitationHandler(invitationService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/invite", nil, tt.user, map[string]int64{"groupId": tt.groupID})
+			w := httptest.NewRecorder()
+
+			handler.getInvitations(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual []dto.GroupInvitationDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d invitations instead of %d", len(tt.expectedIDs), len(actual))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected invitation %d instead of %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateInvitation(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		body           string
+		createErr      error
+		expectedStatus int
+	}{
+		{"Admin creates a single use invitation", adminUser, 1, `{"max_uses": 1}`, nil, http.StatusCreated},
+		{"Admin creates a default invitation", adminUser, 1, `{}`, nil, http.StatusCreated},
+		{"Invalid JSON", adminUser, 1, `{"max_uses": `, nil, http.StatusBadRequest},
+		{
+			"Validation error",
+			adminUser,
+			1,
+			`{"max_uses": 0}`,
+			customErrors.NewValidationError("max_uses", "max uses must be at least 1", nil),
+			http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitationService, groupService := setupGroupInvitationTestData()
+			invitationService.createErr = tt.createErr
+			handler := NewGroupInvitationHandler(invitationService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/invite", []byte(tt.body), tt.user, map[string]int64{"groupId": tt.groupID})
+			r = withGroupMember(t, r, groupService, tt.groupID, tt.user.ID)
+			w := httptest.NewRecorder()
+
+			handler.createInvitation(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			contentType := w.Header().Get(constant.CONTENT_TYPE_HEADER)
+			if contentType != constant.CONTENT_TYPE_VALUE {
+				t.Errorf("expected content type %s instead of %s", constant.CONTENT_TYPE_VALUE, contentType)
+			}
+
+			var actual dto.GroupInvitationDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != 3 || actual.Token != "generated" || actual.GroupID != tt.groupID {
+				t.Errorf("unexpected invitation %+v", actual)
+			}
+
+			if actual.CreatedBy == nil || *actual.CreatedBy != tt.user.ID {
+				t.Errorf("expected invitation created by %d instead of %v", tt.user.ID, actual.CreatedBy)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestRevokeInvitation(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		id             int64
+		expectedStatus int
+	}{
+		{"Admin revokes an invitation", adminUser, 1, 1, http.StatusNoContent},
+		{"Invitation of another group", adminUser, 1, 2, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitationService, groupService := setupGroupInvitationTestData()
+			handler := NewGroupInvitationHandler(invitationService, groupService)
+
+			r := newItemRequest(http.MethodDelete, "/invite", nil, tt.user, map[string]int64{"groupId": tt.groupID, "id": tt.id})
+			w := httptest.NewRecorder()
+
+			handler.revokeInvitation(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusNoContent && !invitationService.invitations[0].Revoked {
+				t.Error("expected invitation to be revoked")
+			}
+		})
+	}
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	tests := []struct {
+		name            string
+		user            *model.User
+		token           string
+		acceptErr       error
+		expectedStatus  int
+		expectedGroupID int64
+	}{
+		{"Valid invitation", nonMemberUser, "friendstoken", nil, http.StatusOK, 2},
+		{"Unknown token", nonMemberUser, "unknown", nil, http.StatusNotFound, 0},
+		{"No authenticated user", nil, "friendstoken", nil, http.StatusUnauthorized, 0},
+		{
+			"Expired invitation",
+			nonMemberUser,
+			"friendstoken",
+			customErrors.NewConflictError("GroupInvitation", "invitation has expired", nil),
+			http.StatusConflict,
+			0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitationService, groupService := setupGroupInvitationTestData()
+			invitationService.acceptErr = tt.acceptErr
+			handler := NewGroupInvitationHandler(invitationService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/invite/"+tt.token+"/accept", nil, tt.user, nil)
+			r.SetPathValue("token", tt.token)
+			w := httptest.NewRecorder()
+
+			handler.acceptInvitation(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var response map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if response["group_id"] != tt.expectedGroupID {
+				t.Errorf("expected group %d instead of %d", tt.expectedGroupID, response["group_id"])
+			}
+
+			if invitationService.lastAcceptUser != tt.user.ID {
+				t.Errorf("expected user %d to accept instead of %d", tt.user.ID, invitationService.lastAcceptUser)
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestGroupInvitationRegisterRoutes(t *testing.T) {
+	invitationService, groupService := setupGroupInvitationTestData()
+	handler := NewGroupInvitationHandler(invitationService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api")
+
+	r := httptest.NewRequest(http.MethodPost, "/api/invite/familytoken/accept", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for POST /api/invite/familytoken/accept instead of %d", http.StatusOK, w.Code)
+	}
+
+	if invitationService.lastAcceptToken != "familytoken" {
+		t.Errorf("expected token familytoken instead of %q", invitationService.lastAcceptToken)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/invite", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, adminUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/invite instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/invite", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, simpleUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/invite as a member not admin instead of %d", http.StatusForbidden, w.Code)
+	}
+}
@@ -0,0 +1,686 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+var (
+	adminUser  = &model.User{ID: 2, Username: "admin"}
+	simpleUser = &model.User{ID: 3, Username: "simple"}
+)
+
+// mockGroupService is a mock implementation of GroupServiceInterface for testing handlers
+type mockGroupService struct {
+	groups         []model.Group
+	nextID         int64
+	getByIDErr     error
+	getByUserErr   error
+	createErr      error
+	updateErr      error
+	deleteErr      error
+	addMemberErr   error
+	updateAdminErr error
+	removeErr      error
+	lastCreatorID  int64
+	lastRemovedID  int64
+}
+
+func (m *mockGroupService) GetByID(id int64) (*model.Group, error) {
+	if m.getByIDErr != nil {
+		return nil, m.getByIDErr
+	}
+
+	for i := range m.groups {
+		if m.groups[i].ID == id {
+			return &m.groups[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("groups", "id", nil)
+}
+
+func (m *mockGroupService) GetByUserID(userID int64) ([]model.Group, error) {
+	if m.getByUserErr != nil {
+		return nil, m.getByUserErr
+	}
+
+	groups := []model.Group{}
+	for _, group := range m.groups {
+		for _, member := range group.Members {
+			if member.UserID == userID {
+				groups = append(groups, group)
+				break
+			}
+		}
+	}
+	return groups, nil
+}
+
+func (m *mockGroupService) GetMember(groupID, userID int64) (*model.GroupMember, error) {
+	group, err := m.GetByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range group.Members {
+		if group.Members[i].UserID == userID {
+			return &group.Members[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("group_members", "group_id, user_id", nil)
+}
+
+func (m *mockGroupService) Create(_ context.Context, group *model.Group, creatorID int64) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	m.lastCreatorID = creatorID
+	group.ID = m.nextID
+	group.Members = []model.GroupMember{{UserID: creatorID, GroupId: group.ID, Admin: true}}
+	m.groups = append(m.groups, *group)
+	m.nextID++
+	return group.ID, nil
+}
+
+func (m *mockGroupService) Update(group *model.Group) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+
+	for i := range m.groups {
+		if m.groups[i].ID == group.ID {
+			m.groups[i].Name = group.Name
+			m.groups[i].ImageURL = group.ImageURL
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("groups", "id", nil)
+}
+
+func (m *mockGroupService) Delete(_ context.Context, id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	for i := range m.groups {
+		if m.groups[i].ID == id {
+			m.groups = append(m.groups[:i], m.groups[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("groups", "id", nil)
+}
+
+func (m *mockGroupService) AddMember(member *model.GroupMember) error {
+	if m.addMemberErr != nil {
+		return m.addMemberErr
+	}
+
+	group, err := m.GetByID(member.GroupId)
+	if err != nil {
+		return err
+	}
+	group.Members = append(group.Members, *member)
+	return nil
+}
+
+func (m *mockGroupService) UpdateMemberAdmin(groupID, userID int64, admin bool) error {
+	if m.updateAdminErr != nil {
+		return m.updateAdminErr
+	}
+
+	group, err := m.GetByID(groupID)
+	if err != nil {
+		return err
+	}
+	for i := range group.Members {
+		if group.Members[i].UserID == userID {
+			group.Members[i].Admin = admin
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("group_members", "group_id, user_id", nil)
+}
+
+func (m *mockGroupService) RemoveMember(groupID, userID int64) error {
+	if m.removeErr != nil {
+		return m.removeErr
+	}
+
+	m.lastRemovedID = userID
+	return nil
+}
+
+func setupGroupTestData() *mockGroupService {
+	return &mockGroupService{
+		groups: []model.Group{
+			{
+				ID:   1,
+				Name: "Family",
+				Members: []model.GroupMember{
+					{UserID: adminUser.ID, GroupId: 1, Admin: true},
+					{UserID: simpleUser.ID, GroupId: 1, Admin: false},
+				},
+			},
+			{
+				ID:   2,
+				Name: "Friends",
+				Members: []model.GroupMember{
+					{UserID: simpleUser.ID, GroupId: 2, Admin: true},
+				},
+			},
+		},
+		nextID: 3,
+	}
+}
+
+// withGroupMember adds the membership of the user in the group to the request context,
+// as the middleware.GroupMember middleware does.
+func withGroupMember(t *testing.T, r *http.Request, groupService *mockGroupService, groupID, userID int64) *http.Request {
+	t.Helper()
+
+	member, err := groupService.GetMember(groupID, userID)
+	if err != nil {
+		t.Fatalf("user %d is not a member of group %d: %v", userID, groupID, err)
+	}
+	return r.WithContext(context.WithValue(r.Context(), ctx.GroupMemberCtxKey{}, member))
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewGroupHandler(t *testing.T) {
+	groupService := setupGroupTestData()
+	handler := NewGroupHandler(groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetGroups(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		getByUserErr   error
+		expectedStatus int
+		expectedIDs    []int64
+	}{
+		{"Groups of an admin", adminUser, nil, http.StatusOK, []int64{1}},
+		{"Groups of a member of several groups", simpleUser, nil, http.StatusOK, []int64{1, 2}},
+		{"User without group", nonMemberUser, nil, http.StatusOK, []int64{}},
+		{"No authenticated user", nil, nil, http.StatusUnauthorized, nil},
+		{"Service error", adminUser, customErrors.NewInternalError("failed to fetch groups", nil), http.StatusInternalServerError, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			groupService.getByUserErr = tt.getByUserErr
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodGet, "/group", nil, tt.user, nil)
+			w := httptest.NewRecorder()
+
+			handler.getGroups(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			contentType := w.Header().Get(constant.CONTENT_TYPE_HEADER)
+			if contentType != constant.CONTENT_TYPE_VALUE {
+				t.Errorf("expected content type %s instead of %s", constant.CONTENT_TYPE_VALUE, contentType)
+			}
+
+			var actual []dto.GroupDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d groups instead of %d", len(tt.expectedIDs), len(actual))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected group %d instead of %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestGetGroupByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		expectedStatus int
+	}{
+		{"Member of the group", simpleUser, 1, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodGet, "/group", nil, tt.user, map[string]int64{"groupId": tt.groupID})
+			w := httptest.NewRecorder()
+
+			handler.getGroupByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.GroupDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != tt.groupID {
+				t.Errorf("expected group %d instead of %d", tt.groupID, actual.ID)
+			}
+
+			if len(actual.Members) != 2 {
+				t.Errorf("expected 2 members instead of %d", len(actual.Members))
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateGroup(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		body           string
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid group", simpleUser, `{"name": "Neighbours"}`, nil, http.StatusCreated},
+		{"Invalid JSON", simpleUser, `{"name": `, nil, http.StatusBadRequest},
+		{"No authenticated user", nil, `{"name": "Neighbours"}`, nil, http.StatusUnauthorized},
+		{
+			"Validation error",
+			simpleUser,
+			`{"name": ""}`,
+			customErrors.NewValidationError("name", customErrors.GROUP_NAME_FIELD_ERROR, nil),
+			http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			groupService.createErr = tt.createErr
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodPost, "/group", []byte(tt.body), tt.user, nil)
+			w := httptest.NewRecorder()
+
+			handler.createGroup(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var response map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if response["id"] != 3 {
+				t.Errorf("expected id 3 instead of %d", response["id"])
+			}
+
+			if groupService.lastCreatorID != tt.user.ID {
+				t.Errorf("expected creator %d instead of %d", tt.user.ID, groupService.lastCreatorID)
+			}
+		})
+	}
+}
+
+func TestAddGroupMember(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		body           string
+		addMemberErr   error
+		expectedStatus int
+	}{
+		{"Admin adds a member", adminUser, 1, `{"user_id": 4}`, nil, http.StatusNoContent},
+		{"Invalid JSON", adminUser, 1, `{"user_id": `, nil, http.StatusBadRequest},
+		{
+			"Already a member",
+			adminUser,
+			1,
+			`{"user_id": 3}`,
+			customErrors.NewConflictError("GroupMember", "user is already a member of the group", nil),
+			http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			groupService.addMemberErr = tt.addMemberErr
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodPost, "/group/member", []byte(tt.body), tt.user, map[string]int64{"groupId": tt.groupID})
+			w := httptest.NewRecorder()
+
+			handler.addGroupMember(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			if len(groupService.groups[0].Members) != 3 {
+				t.Errorf("expected 3 members instead of %d", len(groupService.groups[0].Members))
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdateGroup(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		body           string
+		expectedStatus int
+	}{
+		{"Admin renames the group", adminUser, 1, `{"name": "Home", "image_url": "/static/images/home.jpg"}`, http.StatusNoContent},
+		{"Invalid JSON", adminUser, 1, `{"name": `, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodPut, "/group", []byte(tt.body), tt.user, map[string]int64{"groupId": tt.groupID})
+			w := httptest.NewRecorder()
+
+			handler.updateGroup(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			if groupService.groups[0].Name != "Home" {
+				t.Errorf("expected name Home instead of %s", groupService.groups[0].Name)
+			}
+
+			if groupService.groups[0].ImageURL == nil || *groupService.groups[0].ImageURL != "/static/images/home.jpg" {
+				t.Errorf("expected image /static/images/home.jpg instead of %v", groupService.groups[0].ImageURL)
+			}
+		})
+	}
+}
+
+func TestUpdateGroupMemberAdmin(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		userID         int64
+		body           string
+		updateAdminErr error
+		expectedStatus int
+	}{
+		{"Admin promotes a member", adminUser, 1, 3, `{"admin": true}`, nil, http.StatusNoContent},
+		{"Unknown member", adminUser, 1, 99, `{"admin": true}`, nil, http.StatusNotFound},
+		{"Invalid JSON", adminUser, 1, 3, `{"admin": `, nil, http.StatusBadRequest},
+		{
+			"Last admin demoted",
+			adminUser,
+			1,
+			2,
+			`{"admin": false}`,
+			customErrors.NewConflictError("GroupMember", "the last admin of a group cannot leave it or be demoted", nil),
+			http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			groupService.updateAdminErr = tt.updateAdminErr
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodPatch, "/group/member/admin", []byte(tt.body), tt.user, map[string]int64{"groupId": tt.groupID, "userId": tt.userID})
+			w := httptest.NewRecorder()
+
+			handler.updateGroupMemberAdmin(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			if !groupService.groups[0].Members[1].Admin {
+				t.Error("expected member to be promoted")
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeleteGroup(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		expectedStatus int
+	}{
+		{"Admin deletes the group", adminUser, 1, http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodDelete, "/group", nil, tt.user, map[string]int64{"groupId": tt.groupID})
+			w := httptest.NewRecorder()
+
+			handler.deleteGroup(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusNoContent && len(groupService.groups) != 1 {
+				t.Errorf("expected 1 group left instead of %d", len(groupService.groups))
+			}
+		})
+	}
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		userID         int64
+		removeErr      error
+		expectedStatus int
+	}{
+		{"Admin removes a member", adminUser, 1, 3, nil, http.StatusNoContent},
+		{"Member removes themselves", simpleUser, 1, 3, nil, http.StatusNoContent},
+		{"Member removes another member", simpleUser, 1, 2, nil, http.StatusForbidden},
+		{
+			"Last admin removed",
+			adminUser,
+			1,
+			2,
+			customErrors.NewConflictError("GroupMember", "the last admin of a group cannot leave it or be demoted", nil),
+			http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			groupService.removeErr = tt.removeErr
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodDelete, "/group/member", nil, tt.user, map[string]int64{"groupId": tt.groupID, "userId": tt.userID})
+			r = withGroupMember(t, r, groupService, tt.groupID, tt.user.ID)
+			w := httptest.NewRecorder()
+
+			handler.removeGroupMember(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusNoContent && groupService.lastRemovedID != tt.userID {
+				t.Errorf("expected member %d to be removed instead of %d", tt.userID, groupService.lastRemovedID)
+			}
+		})
+	}
+}
+
+func TestLeaveGroup(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		removeErr      error
+		expectedStatus int
+	}{
+		{"Member leaves the group", simpleUser, 1, nil, http.StatusNoContent},
+		{
+			"Last admin leaves the group",
+			adminUser,
+			1,
+			customErrors.NewConflictError("GroupMember", "the last admin of a group cannot leave it or be demoted", nil),
+			http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := setupGroupTestData()
+			groupService.removeErr = tt.removeErr
+			handler := NewGroupHandler(groupService)
+
+			r := newItemRequest(http.MethodPost, "/group/leave", nil, tt.user, map[string]int64{"groupId": tt.groupID})
+			r = withGroupMember(t, r, groupService, tt.groupID, tt.user.ID)
+			w := httptest.NewRecorder()
+
+			handler.leaveGroup(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusNoContent && groupService.lastRemovedID != tt.user.ID {
+				t.Errorf("expected member %d to be removed instead of %d", tt.user.ID, groupService.lastRemovedID)
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestGroupRegisterRoutes(t *testing.T) {
+	groupService := setupGroupTestData()
+	handler := NewGroupHandler(groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group")
+
+	r := httptest.NewRequest(http.MethodGet, "/api/group/1", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, adminUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1 instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/api/group/1/leave", strings.NewReader(""))
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, simpleUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d for POST /api/group/1/leave instead of %d", http.StatusNoContent, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodPut, "/api/group/1", strings.NewReader(`{"name": "Home"}`))
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, simpleUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for PUT /api/group/1 as a member not admin instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/2", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, adminUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/2 as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodDelete, "/api/group/1/member/abc", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for DELETE /api/group/1/member/abc instead of %d", http.StatusBadRequest, w.Code)
+	}
+}
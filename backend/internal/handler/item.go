@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// ItemHandler handles HTTP requests related to the items of a group.
+type ItemHandler struct {
+	itemService  service.ItemServiceInterface
+	groupService service.GroupServiceInterface
+}
+
+// NewItemHandler constructs a new ItemHandler with the provided services.
+func NewItemHandler(itemService service.ItemServiceInterface, groupService service.GroupServiceInterface) *ItemHandler {
+	return &ItemHandler{
+		itemService:  itemService,
+		groupService: groupService,
+	}
+}
+
+// RegisterRoutes registers the item-related routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; every route is restricted to the members of the group.
+func (h *ItemHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+	itemMember := middleware.GroupMember(h.groupService, middleware.GroupFromResource("items", "id", h.itemGroup))
+	itemScoped := middleware.Stack(middleware.IntPathValues("groupId", "id"), member, itemMember)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.getItems)))
+	mux.Handle("GET "+prefix+"/{id}", itemScoped(http.HandlerFunc(h.getItemByID)))
+	mux.Handle("GET "+prefix+"/{id}/recipes", itemScoped(http.HandlerFunc(h.getItemRecipes)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.createItem)))
+	mux.Handle("PUT "+prefix+"/{id}", itemScoped(http.HandlerFunc(h.updateItem)))
+	mux.Handle("DELETE "+prefix+"/{id}", itemScoped(http.HandlerFunc(h.deleteItem)))
+}
+
+// GetItems godoc
+// @Summary Get items
+// @Description Get all the items of a group, or the ones whose name contains the given one
+// @Tags item
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param name query string false "Name to search for"
+// @Param sort query string false "Sort key: name, average_market_price, unit_type or category (ignored when searching by name)"
+// @Param desc query bool false "Sort in descending order"
+// @Success 200 {array} dto.ItemDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item [get]
+func (h *ItemHandler) getItems(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	descending, err := descendingQueryParam(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var items []model.Item
+	if name := r.URL.Query().Get("name"); name != "" {
+		items, err = h.itemService.SearchByNameAndGroupID(name, groupID, descending)
+	} else {
+		items, err = h.itemService.GetByGroupID(groupID, r.URL.Query().Get("sort"), descending)
+	}
+
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(items, mapper.ToItemDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_ITEM_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetItemByID godoc
+// @Summary Get item by ID
+// @Description Get an item of a group by its ID
+// @Tags item
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Item ID"
+// @Success 200 {object} dto.ItemDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Item not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item/{id} [get]
+func (h *ItemHandler) getItemByID(w http.ResponseWriter, r *http.Request) {
+	item, err := h.itemService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToItemDto(item)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_ITEM_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetItemRecipes godoc
+// @Summary Get the recipes using an item
+// @Description Get the recipes in which the item is used as an ingredient
+// @Tags item
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Item ID"
+// @Param desc query bool false "Sort by name in descending order"
+// @Success 200 {array} dto.RecipeSummaryDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Item not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item/{id}/recipes [get]
+func (h *ItemHandler) getItemRecipes(w http.ResponseWriter, r *http.Request) {
+	item, err := h.itemService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	descending, err := descendingQueryParam(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	recipes, err := h.itemService.GetRecipesByID(item.ID, descending)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(recipes, mapper.ToRecipeSummaryDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateItem godoc
+// @Summary Create a new item
+// @Description Create a new item in a group
+// @Tags item
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param item body dto.NewItemDto true "New Item Data"
+// @Success 201 {object} map[string]int "Returns the new item ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: invalid item category"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item [post]
+func (h *ItemHandler) createItem(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newItemDto dto.NewItemDto
+	if err := json.NewDecoder(r.Body).Decode(&newItemDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.itemService.Create(mapper.FromNewItemDtoToItem(&newItemDto, groupID))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// UpdateItem godoc
+// @Summary Update an item
+// @Description Update the details of an existing item of a group
+// @Tags item
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Item ID"
+// @Param item body dto.NewItemDto true "Item Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Item not found"
+// @Failure 409 {string} string "Conflict: invalid item category"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item/{id} [put]
+func (h *ItemHandler) updateItem(w http.ResponseWriter, r *http.Request) {
+	currentItem, err := h.itemService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var itemDto dto.NewItemDto
+	if err := json.NewDecoder(r.Body).Decode(&itemDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	item := mapper.FromNewItemDtoToItem(&itemDto, currentItem.GroupID)
+	item.ID = currentItem.ID
+
+	if err := h.itemService.Update(item); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteItem godoc
+// @Summary Delete an item
+// @Description Delete the item with the specified ID, if it isn't used by any recipe or grocery
+// @Tags item
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Item ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Item not found"
+// @Failure 409 {string} string "Conflict: item still in use"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item/{id} [delete]
+func (h *ItemHandler) deleteItem(w http.ResponseWriter, r *http.Request) {
+	item, err := h.itemService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.itemService.Delete(item.ID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// itemGroup returns the ID of the group owning an item, for middleware.GroupFromResource.
+func (h *ItemHandler) itemGroup(id int64) (*int64, error) {
+	item, err := h.itemService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &item.GroupID, nil
+}
@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// ItemCategoryHandler handles HTTP requests related to the item categories of a group.
+type ItemCategoryHandler struct {
+	itemCategoryService service.ItemCategoryServiceInterface
+	groupService        service.GroupServiceInterface
+}
+
+// NewItemCategoryHandler constructs a new ItemCategoryHandler with the provided services.
+func NewItemCategoryHandler(itemCategoryService service.ItemCategoryServiceInterface, groupService service.GroupServiceInterface) *ItemCategoryHandler {
+	return &ItemCategoryHandler{
+		itemCategoryService: itemCategoryService,
+		groupService:        groupService,
+	}
+}
+
+// RegisterRoutes registers the item category-related routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; every route is restricted to the members of the group.
+func (h *ItemCategoryHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+	categoryMember := middleware.GroupMember(h.groupService, middleware.GroupFromResource("item_categories", "id", h.categoryGroup))
+	categoryScoped := middleware.Stack(middleware.IntPathValues("groupId", "id"), member, categoryMember)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.getItemCategories)))
+	mux.Handle("GET "+prefix+"/{id}", categoryScoped(http.HandlerFunc(h.getItemCategoryByID)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.createItemCategory)))
+	mux.Handle("PUT "+prefix+"/order", groupScoped(http.HandlerFunc(h.reorderItemCategories)))
+	mux.Handle("PUT "+prefix+"/{id}", categoryScoped(http.HandlerFunc(h.updateItemCategory)))
+	mux.Handle("DELETE "+prefix+"/{id}", categoryScoped(http.HandlerFunc(h.deleteItemCategory)))
+}
+
+// GetItemCategories godoc
+// @Summary Get item categories
+// @Description Get all the item categories of a group, sorted by their position
+// @Tags item-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 200 {array} dto.ItemCategoryDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item-category [get]
+func (h *ItemCategoryHandler) getItemCategories(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	categories, err := h.itemCategoryService.GetByGroupID(groupID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(categories, mapper.ToItemCategoryDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_ITEM_CATEGORY_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetItemCategoryByID godoc
+// @Summary Get item category by ID
+// @Description Get an item category of a group by its ID
+// @Tags item-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Item category ID"
+// @Success 200 {object} dto.ItemCategoryDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Item category not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item-category/{id} [get]
+func (h *ItemCategoryHandler) getItemCategoryByID(w http.ResponseWriter, r *http.Request) {
+	category, err := h.itemCategoryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToItemCategoryDto(category)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_ITEM_CATEGORY_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateItemCategory godoc
+// @Summary Create a new item category
+// @Description Create a new item category at the end of a group's list; its name must be unique in the group, whatever its case
+// @Tags item-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param category body dto.NewItemCategoryDto true "New Item Category Data"
+// @Success 201 {object} map[string]int "Returns the new item category ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: name already used in the group"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item-category [post]
+func (h *ItemCategoryHandler) createItemCategory(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newCategoryDto dto.NewItemCategoryDto
+	if err := json.NewDecoder(r.Body).Decode(&newCategoryDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.itemCategoryService.Create(mapper.FromNewItemCategoryDtoToItemCategory(&newCategoryDto, groupID))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// UpdateItemCategory godoc
+// @Summary Rename an item category
+// @Description Rename an existing item category of a group; the "Uncategorized" category can't be renamed
+// @Tags item-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Item category ID"
+// @Param category body dto.NewItemCategoryDto true "Item Category Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Item category not found"
+// @Failure 409 {string} string "Conflict: name already used in the group or uncategorized category"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item-category/{id} [put]
+func (h *ItemCategoryHandler) updateItemCategory(w http.ResponseWriter, r *http.Request) {
+	currentCategory, err := h.itemCategoryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var categoryDto dto.NewItemCategoryDto
+	if err := json.NewDecoder(r.Body).Decode(&categoryDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	category := mapper.FromNewItemCategoryDtoToItemCategory(&categoryDto, currentCategory.GroupID)
+	category.ID = currentCategory.ID
+
+	if err := h.itemCategoryService.Update(category); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderItemCategories godoc
+// @Summary Reorder item categories
+// @Description Set the order of the item categories of a group; the body must list every category of the group exactly once
+// @Tags item-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param order body dto.ItemCategoryOrderDto true "Item category IDs in their new order"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item-category/order [put]
+func (h *ItemCategoryHandler) reorderItemCategories(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var orderDto dto.ItemCategoryOrderDto
+	if err := json.NewDecoder(r.Body).Decode(&orderDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.itemCategoryService.Reorder(r.Context(), groupID, orderDto.IDs); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteItemCategory godoc
+// @Summary Delete an item category
+// @Description Delete the item category with the specified ID, moving its items to the "Uncategorized" category of the group.
+// @Description The "Uncategorized" category itself can't be deleted.
+// @Tags item-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Item category ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Item category not found"
+// @Failure 409 {string} string "Conflict: uncategorized category"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/item-category/{id} [delete]
+func (h *ItemCategoryHandler) deleteItemCategory(w http.ResponseWriter, r *http.Request) {
+	category, err := h.itemCategoryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.itemCategoryService.Delete(r.Context(), category.ID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// categoryGroup returns the ID of the group owning an item category, for middleware.GroupFromResource.
+func (h *ItemCategoryHandler) categoryGroup(id int64) (*int64, error) {
+	category, err := h.itemCategoryService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &category.GroupID, nil
+}
@@ -0,0 +1,427 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockItemCategoryService is a mock implementation of ItemCategoryServiceInterface for testing handler
+type mockItemCategoryService struct {
+	categories []model.ItemCategory
+	nextID     int64
+	createErr  error
+	updateErr  error
+	reorderErr error
+	deleteErr  error
+	reordered  []int64
+}
+
+func (m *mockItemCategoryService) GetByID(id int64) (*model.ItemCategory, error) {
+	for i := range m.categories {
+		if m.categories[i].ID == id {
+			return &m.categories[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("item_categories", "id", nil)
+}
+
+func (m *mockItemCategoryService) GetByGroupID(groupID int64) ([]model.ItemCategory, error) {
+	result := []model.ItemCategory{}
+	for _, category := range m.categories {
+		if category.GroupID == groupID {
+			result = append(result, category)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockItemCategoryService) GetByNameAndGroupID(name string, groupID int64) (*model.ItemCategory, error) {
+	for i := range m.categories {
+		if m.categories[i].Name == name && m.categories[i].GroupID == groupID {
+			return &m.categories[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("item_categories", "name, group_id", nil)
+}
+
+func (m *mockItemCategoryService) Create(category *model.ItemCategory) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+	category.ID = m.nextID
+	m.nextID++
+	m.categories = append(m.categories, *category)
+	return category.ID, nil
+}
+
+func (m *mockItemCategoryService) Update(category *model.ItemCategory) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for i := range m.categories {
+		if m.categories[i].ID == category.ID {
+			m.categories[i] = *category
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("item_categories", "id", nil)
+}
+
+func (m *mockItemCategoryService) Reorder(_ context.Context, _ int64, ids []int64) error {
+	if m.reorderErr != nil {
+		return m.reorderErr
+	}
+	m.reordered = ids
+	return nil
+}
+
+func (m *mockItemCategoryService) Delete(_ context.Context, id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+	for i := range m.categories {
+		if m.categories[i].ID == id {
+			m.categories = append(m.categories[:i], m.categories[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("item_categories", "id", nil)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupItemCategoryTestData() (*mockItemCategoryService, *mockGroupService) {
+	itemCategoryService := &mockItemCategoryService{
+		categories: []model.ItemCategory{
+			{ID: 1, Name: "PANTRY", GroupID: 1, Position: 0},
+			{ID: 2, Name: "Uncategorized", GroupID: 1, Position: 1},
+			{ID: 3, Name: "PLANTS", GroupID: 2, Position: 0},
+		},
+		nextID: 4,
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return itemCategoryService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewItemCategoryHandler(t *testing.T) {
+	itemCategoryService, groupService := setupItemCategoryTestData()
+	handler := NewItemCategoryHandler(itemCategoryService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.itemCategoryService != itemCategoryService {
+		t.Error("handler itemCategoryService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetItemCategories(t *testing.T) {
+	itemCategoryService, groupService := setupItemCategoryTestData()
+	handler := NewItemCategoryHandler(itemCategoryService, groupService)
+
+	r := newItemRequest(http.MethodGet, "/item-category", nil, memberUser, map[string]int64{"groupId": 1})
+	w := httptest.NewRecorder()
+
+	handler.getItemCategories(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d instead of %d", http.StatusOK, w.Code)
+	}
+
+	contentType := w.Header().Get(constant.CONTENT_TYPE_HEADER)
+	if contentType != constant.CONTENT_TYPE_VALUE {
+		t.Errorf("expected content type %s instead of %s", constant.CONTENT_TYPE_VALUE, contentType)
+	}
+
+	var actual []dto.ItemCategoryDto
+	if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	expected := []dto.ItemCategoryDto{{ID: 1, Name: "PANTRY", Position: 0}, {ID: 2, Name: "Uncategorized", Position: 1}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected categories %+v instead of %+v", expected, actual)
+	}
+}
+
+func TestGetItemCategoryByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		categoryID     int64
+		expectedStatus int
+	}{
+		{"Existing category of the group", 1, http.StatusOK},
+		{"Unknown category", -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemCategoryService, groupService := setupItemCategoryTestData()
+			handler := NewItemCategoryHandler(itemCategoryService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/item-category", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.categoryID})
+			w := httptest.NewRecorder()
+
+			handler.getItemCategoryByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.ItemCategoryDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != 1 || actual.Name != "PANTRY" {
+				t.Errorf("unexpected category %+v", actual)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateItemCategory(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewItemCategoryDto{Name: "FROZEN"})
+
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid category", validBody, nil, http.StatusCreated},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Invalid name", validBody, customErrors.NewValidationError("name", customErrors.ITEM_CATEGORY_NAME_FIELD_ERROR, nil), http.StatusBadRequest},
+		{"Name already used", validBody, customErrors.NewConflictError("ItemCategory", "an item category with this name already exists in the group", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemCategoryService, groupService := setupItemCategoryTestData()
+			itemCategoryService.createErr = tt.createErr
+			handler := NewItemCategoryHandler(itemCategoryService, groupService)
+			categoriesNb := len(itemCategoryService.categories)
+
+			r := newItemRequest(http.MethodPost, "/item-category", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.createItemCategory(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				if len(itemCategoryService.categories) != categoriesNb {
+					t.Errorf("expected %d categories instead of %d", categoriesNb, len(itemCategoryService.categories))
+				}
+				return
+			}
+
+			var result map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			created, err := itemCategoryService.GetByID(result["id"])
+			if err != nil {
+				t.Fatalf("failed to retrieve created category: %v", err)
+			}
+
+			if created.Name != "FROZEN" || created.GroupID != 1 {
+				t.Errorf("unexpected created category %+v", created)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdateItemCategory(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewItemCategoryDto{Name: "DRY GOODS"})
+
+	tests := []struct {
+		name           string
+		categoryID     int64
+		body           []byte
+		updateErr      error
+		expectedStatus int
+	}{
+		{"Valid update", 1, validBody, nil, http.StatusNoContent},
+		{"Invalid body", 1, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Uncategorized category", 2, validBody, customErrors.NewConflictError("ItemCategory", "the uncategorized item category can't be renamed", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemCategoryService, groupService := setupItemCategoryTestData()
+			itemCategoryService.updateErr = tt.updateErr
+			handler := NewItemCategoryHandler(itemCategoryService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/item-category", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.categoryID})
+			w := httptest.NewRecorder()
+
+			handler.updateItemCategory(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, _ := itemCategoryService.GetByID(tt.categoryID)
+			if updated.Name != "DRY GOODS" || updated.GroupID != 1 {
+				t.Errorf("unexpected updated category %+v", updated)
+			}
+		})
+	}
+}
+
+func TestReorderItemCategories(t *testing.T) {
+	validBody, _ := json.Marshal(dto.ItemCategoryOrderDto{IDs: []int64{2, 1}})
+
+	tests := []struct {
+		name           string
+		body           []byte
+		reorderErr     error
+		expectedStatus int
+	}{
+		{"Valid order", validBody, nil, http.StatusNoContent},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Incomplete order", validBody, customErrors.NewValidationError("item_category_ids", customErrors.ITEM_CATEGORY_ORDER_FIELD_ERROR, nil), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemCategoryService, groupService := setupItemCategoryTestData()
+			itemCategoryService.reorderErr = tt.reorderErr
+			handler := NewItemCategoryHandler(itemCategoryService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/item-category/order", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.reorderItemCategories(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusNoContent && !reflect.DeepEqual(itemCategoryService.reordered, []int64{2, 1}) {
+				t.Errorf("expected categories reordered as [2 1] instead of %v", itemCategoryService.reordered)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeleteItemCategory(t *testing.T) {
+	tests := []struct {
+		name           string
+		categoryID     int64
+		deleteErr      error
+		expectedStatus int
+	}{
+		{"Existing category", 1, nil, http.StatusNoContent},
+		{"Uncategorized category", 2, customErrors.NewConflictError("ItemCategory", "the uncategorized item category can't be deleted", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemCategoryService, groupService := setupItemCategoryTestData()
+			itemCategoryService.deleteErr = tt.deleteErr
+			handler := NewItemCategoryHandler(itemCategoryService, groupService)
+			categoriesNb := len(itemCategoryService.categories)
+
+			r := newItemRequest(http.MethodDelete, "/item-category", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.categoryID})
+			w := httptest.NewRecorder()
+
+			handler.deleteItemCategory(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			expectedNb := categoriesNb
+			if tt.expectedStatus == http.StatusNoContent {
+				expectedNb--
+			}
+			if len(itemCategoryService.categories) != expectedNb {
+				t.Errorf("expected %d categories instead of %d", expectedNb, len(itemCategoryService.categories))
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestItemCategoryRegisterRoutes(t *testing.T) {
+	itemCategoryService, groupService := setupItemCategoryTestData()
+	handler := NewItemCategoryHandler(itemCategoryService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/item-category")
+
+	body, _ := json.Marshal(dto.ItemCategoryOrderDto{IDs: []int64{2, 1}})
+	r := httptest.NewRequest(http.MethodPut, "/api/group/1/item-category/order", bytes.NewReader(body))
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d for PUT /api/group/1/item-category/order instead of %d", http.StatusNoContent, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/item-category", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/item-category as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodDelete, "/api/group/1/item-category/abc", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for DELETE /api/group/1/item-category/abc instead of %d", http.StatusBadRequest, w.Code)
+	}
+
+	// Categories of another group are reported as not found on every route.
+	assertRoutesStatus(t, mux, memberUser, []string{
+		"GET /api/group/1/item-category/3",
+		"PUT /api/group/1/item-category/3",
+		"DELETE /api/group/1/item-category/3",
+	}, http.StatusNotFound)
+}
@@ -0,0 +1,672 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+var (
+	memberUser    = &model.User{ID: 1, Username: "member"}
+	nonMemberUser = &model.User{ID: 99, Username: "stranger"}
+
+	itemGroup1 = model.Group{
+		ID:   1,
+		Name: "Family",
+		Members: []model.GroupMember{
+			{UserID: memberUser.ID, GroupId: 1, Admin: true},
+		},
+	}
+	itemGroup2 = model.Group{
+		ID:   2,
+		Name: "Friends",
+		Members: []model.GroupMember{
+			{UserID: memberUser.ID, GroupId: 2, Admin: false},
+		},
+	}
+)
+
+// mockItemService is a mock implementation of ItemServiceInterface for testing handler
+type mockItemService struct {
+	items         []model.Item
+	recipes       []model.Recipe
+	nextID        int64
+	getByGroupErr error
+	getByNameErr  error
+	getRecipesErr error
+	createErr     error
+	updateErr     error
+	deleteErr     error
+	lastSort      string
+	lastDesc      bool
+}
+
+func (m *mockItemService) GetByGroupID(groupID int64, sort string, descending bool) ([]model.Item, error) {
+	m.lastSort = sort
+	m.lastDesc = descending
+	if m.getByGroupErr != nil {
+		return nil, m.getByGroupErr
+	}
+
+	result := []model.Item{}
+	for _, item := range m.items {
+		if item.GroupID == groupID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockItemService) GetByID(id int64) (*model.Item, error) {
+	for i := range m.items {
+		if m.items[i].ID == id {
+			return &m.items[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("items", "id", nil)
+}
+
+func (m *mockItemService) GetByName(name string, descending bool) ([]model.Item, error) {
+	m.lastDesc = descending
+	if m.getByNameErr != nil {
+		return nil, m.getByNameErr
+	}
+
+	result := []model.Item{}
+	for _, item := range m.items {
+		if strings.Contains(item.Name, name) {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockItemService) SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.Item, error) {
+	m.lastDesc = descending
+	if m.getByNameErr != nil {
+		return nil, m.getByNameErr
+	}
+
+	result := []model.Item{}
+	for _, item := range m.items {
+		if item.GroupID == groupID && strings.Contains(item.Name, name) {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockItemService) GetRecipesByID(_ int64, descending bool) ([]model.Recipe, error) {
+	m.lastDesc = descending
+	if m.getRecipesErr != nil {
+		return nil, m.getRecipesErr
+	}
+	return m.recipes, nil
+}
+
+func (m *mockItemService) Create(item *model.Item) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+	item.ID = m.nextID
+	m.nextID++
+	m.items = append(m.items, *item)
+	return item.ID, nil
+}
+
+func (m *mockItemService) Update(item *model.Item) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for i := range m.items {
+		if m.items[i].ID == item.ID {
+			m.items[i] = *item
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("items", "id", nil)
+}
+
+func (m *mockItemService) Delete(id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+	for i := range m.items {
+		if m.items[i].ID == id {
+			m.items = append(m.items[:i], m.items[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("items", "id", nil)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupItemTestData() (*mockItemService, *mockGroupService) {
+	itemService := &mockItemService{
+		items: []model.Item{
+			{ID: 1, Name: "Flour", UnitType: enum.Weight, GroupID: 1, ItemCategory: model.ItemCategory{ID: 1, Name: "PANTRY"}},
+			{ID: 2, Name: "Sugar", UnitType: enum.Weight, GroupID: 1, ItemCategory: model.ItemCategory{ID: 1, Name: "PANTRY"}},
+			{ID: 3, Name: "Sugar cane", UnitType: enum.Weight, GroupID: 2, ItemCategory: model.ItemCategory{ID: 2, Name: "PLANTS"}},
+		},
+		recipes: []model.Recipe{
+			{ID: 1, Name: "Cookies"},
+		},
+		nextID: 4,
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return itemService, groupService
+}
+
+// newItemRequest builds a request carrying the authenticated user and the parsed path values in its context.
+func newItemRequest(method, target string, body []byte, user *model.User, pathValues map[string]int64) *http.Request {
+	r := httptest.NewRequest(method, target, bytes.NewReader(body))
+	c := context.WithValue(r.Context(), ctx.UserCtxKey{}, user)
+	for k, v := range pathValues {
+		c = context.WithValue(c, k, v)
+	}
+	return r.WithContext(c)
+}
+
+// assertRoutesStatus serves each request, given as "METHOD target", through mux as user and checks its status.
+func assertRoutesStatus(t *testing.T, mux *http.ServeMux, user *model.User, requests []string, expectedStatus int) {
+	t.Helper()
+
+	for _, request := range requests {
+		method, target, _ := strings.Cut(request, " ")
+		r := httptest.NewRequest(method, target, nil)
+		r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, user))
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+
+		if w.Code != expectedStatus {
+			t.Errorf("expected status %d for %s instead of %d", expectedStatus, request, w.Code)
+		}
+	}
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewItemHandler(t *testing.T) {
+	itemService, groupService := setupItemTestData()
+	handler := NewItemHandler(itemService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.itemService != itemService {
+		t.Error("handler itemService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetItems(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		user           *model.User
+		groupID        int64
+		getByGroupErr  error
+		expectedStatus int
+		expectedIDs    []int64
+		expectedSort   string
+		expectedDesc   bool
+	}{
+		{
+			name:           "All items of the group",
+			target:         "/item?sort=category&desc=true",
+			user:           memberUser,
+			groupID:        1,
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []int64{1, 2},
+			expectedSort:   "category",
+			expectedDesc:   true,
+		},
+		{
+			name:           "Search by name in the group",
+			target:         "/item?name=Sugar",
+			user:           memberUser,
+			groupID:        1,
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []int64{2},
+		},
+		{
+			name:           "Invalid desc parameter",
+			target:         "/item?desc=maybe",
+			user:           memberUser,
+			groupID:        1,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Service error",
+			target:         "/item?sort=unknown",
+			user:           memberUser,
+			groupID:        1,
+			getByGroupErr:  customErrors.NewInvalidParamsError([]string{"unknown"}, nil),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemService, groupService := setupItemTestData()
+			itemService.getByGroupErr = tt.getByGroupErr
+			handler := NewItemHandler(itemService, groupService)
+
+			r := newItemRequest(http.MethodGet, tt.target, nil, tt.user, map[string]int64{"groupId": tt.groupID})
+			w := httptest.NewRecorder()
+
+			handler.getItems(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			contentType := w.Header().Get(constant.CONTENT_TYPE_HEADER)
+			if contentType != constant.CONTENT_TYPE_VALUE {
+				t.Errorf("expected content type %s instead of %s", constant.CONTENT_TYPE_VALUE, contentType)
+			}
+
+			var actual []dto.ItemDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d items instead of %d", len(tt.expectedIDs), len(actual))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected item %d instead of %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+
+			if itemService.lastSort != tt.expectedSort {
+				t.Errorf("expected sort %q instead of %q", tt.expectedSort, itemService.lastSort)
+			}
+
+			if itemService.lastDesc != tt.expectedDesc {
+				t.Errorf("expected desc %v instead of %v", tt.expectedDesc, itemService.lastDesc)
+			}
+		})
+	}
+}
+
+func TestGetItemByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		itemID         int64
+		expectedStatus int
+	}{
+		{"Existing item of the group", memberUser, 1, 1, http.StatusOK},
+		{"Unknown item", memberUser, 1, -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemService, groupService := setupItemTestData()
+			handler := NewItemHandler(itemService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/item", nil, tt.user, map[string]int64{"groupId": tt.groupID, "id": tt.itemID})
+			w := httptest.NewRecorder()
+
+			handler.getItemByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.ItemDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != tt.itemID {
+				t.Errorf("expected item %d instead of %d", tt.itemID, actual.ID)
+			}
+		})
+	}
+}
+
+func TestGetItemRecipes(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		itemID         int64
+		getRecipesErr  error
+		expectedStatus int
+	}{
+		{"Recipes using the item", memberUser, 1, nil, http.StatusOK},
+		{"Service error", memberUser, 1, customErrors.NewInternalError(customErrors.FETCH_RECIPES_ERROR, nil), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemService, groupService := setupItemTestData()
+			itemService.getRecipesErr = tt.getRecipesErr
+			handler := NewItemHandler(itemService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/item/1/recipes?desc=true", nil, tt.user, map[string]int64{"groupId": 1, "id": tt.itemID})
+			w := httptest.NewRecorder()
+
+			handler.getItemRecipes(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual []dto.RecipeSummaryDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(itemService.recipes) {
+				t.Errorf("expected %d recipes instead of %d", len(itemService.recipes), len(actual))
+			}
+
+			if !itemService.lastDesc {
+				t.Error("expected recipes to be requested in descending order")
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateItem(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewItemDto{Name: "Eggs", UnitType: enum.Piece, ItemCategoryID: 1})
+
+	tests := []struct {
+		name           string
+		user           *model.User
+		body           []byte
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid item", memberUser, validBody, nil, http.StatusCreated},
+		{"Invalid body", memberUser, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Service conflict", memberUser, validBody, customErrors.NewConflictError("ItemCategory", "item category must exists", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemService, groupService := setupItemTestData()
+			itemService.createErr = tt.createErr
+			handler := NewItemHandler(itemService, groupService)
+			itemsNb := len(itemService.items)
+
+			r := newItemRequest(http.MethodPost, "/item", tt.body, tt.user, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.createItem(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				if len(itemService.items) != itemsNb {
+					t.Errorf("expected %d items instead of %d", itemsNb, len(itemService.items))
+				}
+				return
+			}
+
+			var result map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			created, err := itemService.GetByID(result["id"])
+			if err != nil {
+				t.Fatalf("failed to retrieve created item: %v", err)
+			}
+
+			if created.GroupID != 1 {
+				t.Errorf("expected created item to belong to group 1 instead of %d", created.GroupID)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdateItem(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewItemDto{Name: "Whole wheat flour", UnitType: enum.Weight, ItemCategoryID: 1})
+
+	tests := []struct {
+		name           string
+		user           *model.User
+		itemID         int64
+		body           []byte
+		updateErr      error
+		expectedStatus int
+	}{
+		{"Valid update", memberUser, 1, validBody, nil, http.StatusNoContent},
+		{"Invalid body", memberUser, 1, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Service validation error", memberUser, 1, validBody, customErrors.NewInvalidParamsError([]string{"name"}, nil), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemService, groupService := setupItemTestData()
+			itemService.updateErr = tt.updateErr
+			handler := NewItemHandler(itemService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/item/1", tt.body, tt.user, map[string]int64{"groupId": 1, "id": tt.itemID})
+			w := httptest.NewRecorder()
+
+			handler.updateItem(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, _ := itemService.GetByID(tt.itemID)
+			if updated.Name != "Whole wheat flour" {
+				t.Errorf("expected item name to be updated, got %q", updated.Name)
+			}
+			if updated.GroupID != 1 {
+				t.Errorf("expected item to stay in group 1 instead of %d", updated.GroupID)
+			}
+		})
+	}
+}
+
+// TestCreateAndUpdateItem_ItemCategory runs the item routes against the real ItemService and database,
+// the requests referencing the item category by its ID only.
+func TestCreateAndUpdateItem_ItemCategory(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	groupService := service.NewGroupService(repository.NewGroupRepository(db))
+	itemCategoryService := service.NewItemCategoryService(repository.NewItemCategoryRepository(db))
+	itemService := service.NewItemService(repository.NewItemRepository(db), nil, nil, groupService, itemCategoryService)
+	handler := NewItemHandler(itemService, groupService)
+
+	ownCategory, err := itemCategoryService.GetByNameAndGroupID("DAIRY", 1)
+	if err != nil {
+		t.Fatalf("failed to retrieve the item category of group 1: %v", err)
+	}
+	otherCategory, err := itemCategoryService.GetByNameAndGroupID("MEAT", 2)
+	if err != nil {
+		t.Fatalf("failed to retrieve the item category of group 2: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		itemCategoryID int64
+		expectedStatus int
+	}{
+		{"Category of the group", ownCategory.ID, http.StatusCreated},
+		{"Category of another group", otherCategory.ID, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(dto.NewItemDto{Name: "Cream " + tt.name, UnitType: enum.Volume, ItemCategoryID: tt.itemCategoryID})
+
+			w := httptest.NewRecorder()
+			handler.createItem(w, newItemRequest(http.MethodPost, "/item", body, memberUser, map[string]int64{"groupId": 1}))
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("create: expected status %d instead of %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			expectedUpdateStatus := http.StatusNoContent
+			if tt.expectedStatus != http.StatusCreated {
+				expectedUpdateStatus = tt.expectedStatus
+			}
+
+			// Flour, of group 1
+			w = httptest.NewRecorder()
+			handler.updateItem(w, newItemRequest(http.MethodPut, "/item/1", body, memberUser, map[string]int64{"groupId": 1, "id": 1}))
+
+			if w.Code != expectedUpdateStatus {
+				t.Fatalf("update: expected status %d instead of %d: %s", expectedUpdateStatus, w.Code, w.Body.String())
+			}
+			if expectedUpdateStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, err := itemService.GetByID(1)
+			if err != nil {
+				t.Fatalf("failed to retrieve updated item: %v", err)
+			}
+			if updated.ItemCategory.ID != ownCategory.ID || updated.ItemCategory.Name != "DAIRY" {
+				t.Errorf("expected item category %d DAIRY, got %+v", ownCategory.ID, updated.ItemCategory)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeleteItem(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		itemID         int64
+		deleteErr      error
+		expectedStatus int
+	}{
+		{"Existing item", memberUser, 1, nil, http.StatusNoContent},
+		{"Item still in use", memberUser, 1, customErrors.NewConflictError("Item", "can't delete item used by recipes", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemService, groupService := setupItemTestData()
+			itemService.deleteErr = tt.deleteErr
+			handler := NewItemHandler(itemService, groupService)
+			itemsNb := len(itemService.items)
+
+			r := newItemRequest(http.MethodDelete, "/item/1", nil, tt.user, map[string]int64{"groupId": 1, "id": tt.itemID})
+			w := httptest.NewRecorder()
+
+			handler.deleteItem(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			expectedNb := itemsNb
+			if tt.expectedStatus == http.StatusNoContent {
+				expectedNb--
+			}
+			if len(itemService.items) != expectedNb {
+				t.Errorf("expected %d items instead of %d", expectedNb, len(itemService.items))
+			}
+		})
+	}
+}
+
+func TestItemRegisterRoutes(t *testing.T) {
+	itemService, groupService := setupItemTestData()
+	handler := NewItemHandler(itemService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/item")
+
+	r := httptest.NewRequest(http.MethodGet, "/api/group/1/item/1", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/item/1 instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/item", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/item as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodDelete, "/api/group/99/item/1", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for DELETE /api/group/99/item/1 instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/abc/item", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for GET /api/group/abc/item instead of %d", http.StatusBadRequest, w.Code)
+	}
+
+	// Items of another group are reported as not found on every route.
+	assertRoutesStatus(t, mux, memberUser, []string{
+		"GET /api/group/1/item/3",
+		"GET /api/group/1/item/3/recipes",
+		"PUT /api/group/1/item/3",
+		"DELETE /api/group/1/item/3",
+	}, http.StatusNotFound)
+}
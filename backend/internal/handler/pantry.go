@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// PantryHandler handles HTTP requests related to the pantry of a group.
+type PantryHandler struct {
+	pantryService service.PantryServiceInterface
+	groupService  service.GroupServiceInterface
+}
+
+// NewPantryHandler constructs a new PantryHandler with the provided services.
+func NewPantryHandler(pantryService service.PantryServiceInterface, groupService service.GroupServiceInterface) *PantryHandler {
+	return &PantryHandler{
+		pantryService: pantryService,
+		groupService:  groupService,
+	}
+}
+
+// RegisterRoutes registers the pantry-related routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; every route is restricted to the members of the group.
+func (h *PantryHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+	pantryMember := middleware.GroupMember(h.groupService, middleware.GroupFromResource("pantry", "id", h.pantryItemGroup))
+	pantryScoped := middleware.Stack(middleware.IntPathValues("groupId", "id"), member, pantryMember)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.getPantryItems)))
+	mux.Handle("GET "+prefix+"/{id}", pantryScoped(http.HandlerFunc(h.getPantryItemByID)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.createPantryItem)))
+	mux.Handle("PUT "+prefix+"/{id}", pantryScoped(http.HandlerFunc(h.updatePantryItem)))
+	mux.Handle("DELETE "+prefix+"/{id}", pantryScoped(http.HandlerFunc(h.deletePantryItem)))
+}
+
+// GetPantryItems godoc
+// @Summary Get the pantry
+// @Description Get the pantry of a group, sorted by the position of the item categories, then by item name
+// @Tags pantry
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 200 {array} dto.PantryItemDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/pantry [get]
+func (h *PantryHandler) getPantryItems(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	pantryItems, err := h.pantryService.GetByGroupID(groupID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(pantryItems, mapper.ToPantryItemDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_PANTRY_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetPantryItemByID godoc
+// @Summary Get a pantry line by ID
+// @Description Get a line of the pantry of a group by its ID
+// @Tags pantry
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Pantry line ID"
+// @Success 200 {object} dto.PantryItemDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Pantry line not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/pantry/{id} [get]
+func (h *PantryHandler) getPantryItemByID(w http.ResponseWriter, r *http.Request) {
+	pantryItem, err := h.pantryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToPantryItemDto(pantryItem)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_PANTRY_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreatePantryItem godoc
+// @Summary Stock an item in the pantry
+// @Description Add a line to the pantry of a group; its item must belong to the group and its unit to the catalogue or to the group.
+// @Description An item can only be stocked once in a given unit.
+// @Tags pantry
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param pantryItem body dto.NewPantryItemDto true "New Pantry Line Data"
+// @Success 201 {object} map[string]int "Returns the new pantry line ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: invalid item or unit, or item already stocked in this unit"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/pantry [post]
+func (h *PantryHandler) createPantryItem(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newPantryItemDto dto.NewPantryItemDto
+	if err := json.NewDecoder(r.Body).Decode(&newPantryItemDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.pantryService.Create(mapper.FromNewPantryItemDtoToPantryItem(&newPantryItemDto, groupID))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// UpdatePantryItem godoc
+// @Summary Update a pantry line
+// @Description Replace the item, unit and quantity of a line of the pantry of a group
+// @Tags pantry
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Pantry line ID"
+// @Param pantryItem body dto.NewPantryItemDto true "Pantry Line Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Pantry line not found"
+// @Failure 409 {string} string "Conflict: invalid item or unit, or item already stocked in this unit"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/pantry/{id} [put]
+func (h *PantryHandler) updatePantryItem(w http.ResponseWriter, r *http.Request) {
+	currentPantryItem, err := h.pantryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var pantryItemDto dto.NewPantryItemDto
+	if err := json.NewDecoder(r.Body).Decode(&pantryItemDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pantryItem := mapper.FromNewPantryItemDtoToPantryItem(&pantryItemDto, currentPantryItem.GroupID)
+	pantryItem.ID = currentPantryItem.ID
+
+	if err := h.pantryService.Update(pantryItem); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeletePantryItem godoc
+// @Summary Remove a pantry line
+// @Description Remove a line from the pantry of a group
+// @Tags pantry
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Pantry line ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Pantry line not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/pantry/{id} [delete]
+func (h *PantryHandler) deletePantryItem(w http.ResponseWriter, r *http.Request) {
+	pantryItem, err := h.pantryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.pantryService.Delete(pantryItem.ID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// pantryItemGroup returns the ID of the group owning a pantry line, for middleware.GroupFromResource.
+func (h *PantryHandler) pantryItemGroup(id int64) (*int64, error) {
+	pantryItem, err := h.pantryService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &pantryItem.GroupID, nil
+}
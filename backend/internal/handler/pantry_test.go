@@ -0,0 +1,344 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockPantryService is a mock implementation of PantryServiceInterface for testing handler
+type mockPantryService struct {
+	pantryItems []model.PantryItem
+	nextID      int64
+	createErr   error
+	updateErr   error
+}
+
+func (m *mockPantryService) GetByID(id int64) (*model.PantryItem, error) {
+	for i := range m.pantryItems {
+		if m.pantryItems[i].ID == id {
+			return &m.pantryItems[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("pantry", "id", nil)
+}
+
+func (m *mockPantryService) GetByGroupID(groupID int64) ([]model.PantryItem, error) {
+	result := []model.PantryItem{}
+	for _, pantryItem := range m.pantryItems {
+		if pantryItem.GroupID == groupID {
+			result = append(result, pantryItem)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockPantryService) Create(pantryItem *model.PantryItem) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+	pantryItem.ID = m.nextID
+	m.nextID++
+	m.pantryItems = append(m.pantryItems, *pantryItem)
+	return pantryItem.ID, nil
+}
+
+func (m *mockPantryService) Update(pantryItem *model.PantryItem) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for i := range m.pantryItems {
+		if m.pantryItems[i].ID == pantryItem.ID {
+			m.pantryItems[i] = *pantryItem
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("pantry", "id", nil)
+}
+
+func (m *mockPantryService) Delete(id int64) error {
+	for i := range m.pantryItems {
+		if m.pantryItems[i].ID == id {
+			m.pantryItems = append(m.pantryItems[:i], m.pantryItems[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("pantry", "id", nil)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupPantryTestData() (*mockPantryService, *mockGroupService) {
+	pantryService := &mockPantryService{
+		pantryItems: []model.PantryItem{
+			{ID: 1, Quantity: 500, Item: model.Item{ID: 1, Name: "Flour"}, Unit: model.Unit{ID: 2, Name: "Gram"}, GroupID: 1},
+			{ID: 2, Quantity: 6, Item: model.Item{ID: 4, Name: "Eggs"}, Unit: model.Unit{ID: 8, Name: "Piece"}, GroupID: 1},
+			{ID: 4, Quantity: 3, Item: model.Item{ID: 8, Name: "Tomatoes"}, Unit: model.Unit{ID: 8, Name: "Piece"}, GroupID: 2},
+		},
+		nextID: 5,
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return pantryService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewPantryHandler(t *testing.T) {
+	pantryService, groupService := setupPantryTestData()
+	handler := NewPantryHandler(pantryService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.pantryService != pantryService {
+		t.Error("handler pantryService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetPantryItems(t *testing.T) {
+	pantryService, groupService := setupPantryTestData()
+	handler := NewPantryHandler(pantryService, groupService)
+
+	r := newItemRequest(http.MethodGet, "/pantry", nil, memberUser, map[string]int64{"groupId": 1})
+	w := httptest.NewRecorder()
+
+	handler.getPantryItems(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d instead of %d", http.StatusOK, w.Code)
+	}
+
+	var actual []dto.PantryItemDto
+	if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(actual) != 2 || actual[0].Item.Name != "Flour" || actual[1].Quantity != 6 {
+		t.Errorf("expected the flour and eggs of the group, got %+v", actual)
+	}
+}
+
+func TestGetPantryItemByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		pantryItemID   int64
+		expectedStatus int
+	}{
+		{"Pantry line of the group", 2, http.StatusOK},
+		{"Unknown pantry line", -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pantryService, groupService := setupPantryTestData()
+			handler := NewPantryHandler(pantryService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/pantry", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.pantryItemID})
+			w := httptest.NewRecorder()
+
+			handler.getPantryItemByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.PantryItemDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != tt.pantryItemID || actual.Item.Name != "Eggs" || actual.Unit.Name != "Piece" {
+				t.Errorf("unexpected pantry line %+v", actual)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreatePantryItem(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewPantryItemDto{Quantity: 2, ItemID: 5, UnitID: 3})
+
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid pantry line", validBody, nil, http.StatusCreated},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Negative quantity", validBody, customErrors.NewInvalidParamsError([]string{"quantity"}, nil), http.StatusBadRequest},
+		{"Already stocked", validBody, customErrors.NewConflictError("PantryItem", "this item is already stocked in this unit", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pantryService, groupService := setupPantryTestData()
+			pantryService.createErr = tt.createErr
+			handler := NewPantryHandler(pantryService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/pantry", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.createPantryItem(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var result map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			created, err := pantryService.GetByID(result["id"])
+			if err != nil {
+				t.Fatalf("failed to retrieve created pantry line: %v", err)
+			}
+
+			if created.Quantity != 2 || created.Item.ID != 5 || created.Unit.ID != 3 || created.GroupID != 1 {
+				t.Errorf("unexpected created pantry line %+v", created)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdatePantryItem(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewPantryItemDto{Quantity: 1, ItemID: 1, UnitID: 1})
+
+	tests := []struct {
+		name           string
+		pantryItemID   int64
+		body           []byte
+		updateErr      error
+		expectedStatus int
+	}{
+		{"Valid update", 1, validBody, nil, http.StatusNoContent},
+		{"Invalid body", 1, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Unknown unit", 1, validBody, customErrors.NewConflictError("Unit", "unit must exists", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pantryService, groupService := setupPantryTestData()
+			pantryService.updateErr = tt.updateErr
+			handler := NewPantryHandler(pantryService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/pantry", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.pantryItemID})
+			w := httptest.NewRecorder()
+
+			handler.updatePantryItem(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, _ := pantryService.GetByID(tt.pantryItemID)
+			if updated.Quantity != 1 || updated.Unit.ID != 1 || updated.GroupID != 1 {
+				t.Errorf("unexpected updated pantry line %+v", updated)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeletePantryItem(t *testing.T) {
+	tests := []struct {
+		name           string
+		pantryItemID   int64
+		expectedStatus int
+	}{
+		{"Existing pantry line", 1, http.StatusNoContent},
+		{"Unknown pantry line", -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pantryService, groupService := setupPantryTestData()
+			handler := NewPantryHandler(pantryService, groupService)
+			pantryItemsNb := len(pantryService.pantryItems)
+
+			r := newItemRequest(http.MethodDelete, "/pantry", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.pantryItemID})
+			w := httptest.NewRecorder()
+
+			handler.deletePantryItem(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			expectedNb := pantryItemsNb
+			if tt.expectedStatus == http.StatusNoContent {
+				expectedNb--
+			}
+			if len(pantryService.pantryItems) != expectedNb {
+				t.Errorf("expected %d pantry lines instead of %d", expectedNb, len(pantryService.pantryItems))
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestPantryRegisterRoutes(t *testing.T) {
+	pantryService, groupService := setupPantryTestData()
+	handler := NewPantryHandler(pantryService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/pantry")
+
+	r := httptest.NewRequest(http.MethodGet, "/api/group/1/pantry", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/pantry instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/pantry/1", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/pantry/1 as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	// Pantry lines of another group are reported as not found on every route.
+	assertRoutesStatus(t, mux, memberUser, []string{
+		"GET /api/group/1/pantry/4",
+		"PUT /api/group/1/pantry/4",
+		"DELETE /api/group/1/pantry/4",
+	}, http.StatusNotFound)
+}
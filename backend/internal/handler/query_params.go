@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+)
+
+// descendingQueryParam parses the optional "desc" query parameter of the request.
+// It defaults to false when the parameter is omitted.
+func descendingQueryParam(r *http.Request) (bool, error) {
+	return boolQueryParam(r, "desc")
+}
+
+// boolQueryParam parses the optional boolean query parameter name of the request.
+// It defaults to false when the parameter is omitted.
+func boolQueryParam(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, customErrors.NewInvalidParamsError([]string{name}, err)
+	}
+
+	return b, nil
+}
+
+// optionalIntQueryParam parses the optional integer query parameter name of the request.
+// It returns nil when the parameter is omitted.
+func optionalIntQueryParam(r *http.Request, name string) (*int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, customErrors.NewInvalidParamsError([]string{name}, err)
+	}
+
+	return &i, nil
+}
+
+// optionalInt64QueryParam parses the optional integer query parameter name of the request, such as an ID.
+// It returns nil when the parameter is omitted.
+func optionalInt64QueryParam(r *http.Request, name string) (*int64, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, customErrors.NewInvalidParamsError([]string{name}, err)
+	}
+
+	return &i, nil
+}
+
+// requiredInt64QueryParam parses the mandatory integer query parameter name of the request.
+func requiredInt64QueryParam(r *http.Request, name string) (int64, error) {
+	value, err := strconv.ParseInt(r.URL.Query().Get(name), 10, 64)
+	if err != nil {
+		return 0, customErrors.NewInvalidParamsError([]string{name}, err)
+	}
+
+	return value, nil
+}
+
+// int64ListQueryParam parses the optional query parameter name of the request as a comma-separated list of integers.
+// It returns an empty list when the parameter is omitted.
+func int64ListQueryParam(r *http.Request, name string) ([]int64, error) {
+	values := []int64{}
+	for value := range strings.SplitSeq(r.URL.Query().Get(name), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, customErrors.NewInvalidParamsError([]string{name}, err)
+		}
+		values = append(values, i)
+	}
+
+	return values, nil
+}
+
+// requiredFloatQueryParam parses the mandatory decimal query parameter name of the request.
+func requiredFloatQueryParam(r *http.Request, name string) (float64, error) {
+	value, err := strconv.ParseFloat(r.URL.Query().Get(name), 64)
+	if err != nil {
+		return 0, customErrors.NewInvalidParamsError([]string{name}, err)
+	}
+
+	return value, nil
+}
+
+// requiredTimeQueryParam parses the mandatory date query parameter name of the request,
+// either as an RFC 3339 date and time or as a day (YYYY-MM-DD) starting at midnight UTC.
+func requiredTimeQueryParam(r *http.Request, name string) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, customErrors.NewInvalidParamsError([]string{name}, err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,381 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// RecipeHandler handles HTTP requests related to the recipes of a group.
+type RecipeHandler struct {
+	recipeService service.RecipeServiceInterface
+	groupService  service.GroupServiceInterface
+}
+
+// NewRecipeHandler constructs a new RecipeHandler with the provided services.
+func NewRecipeHandler(recipeService service.RecipeServiceInterface, groupService service.GroupServiceInterface) *RecipeHandler {
+	return &RecipeHandler{
+		recipeService: recipeService,
+		groupService:  groupService,
+	}
+}
+
+// RegisterRoutes registers the recipe-related routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; every route is restricted to the members of the group.
+func (h *RecipeHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+	recipeMember := middleware.GroupMember(h.groupService, middleware.GroupFromResource("recipes", "id", h.recipeGroup))
+	recipeScoped := middleware.Stack(middleware.IntPathValues("groupId", "id"), member, recipeMember)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.getRecipes)))
+	mux.Handle("GET "+prefix+"/cookable", groupScoped(http.HandlerFunc(h.getCookableRecipes)))
+	mux.Handle("GET "+prefix+"/{id}", recipeScoped(http.HandlerFunc(h.getRecipeByID)))
+	mux.Handle("GET "+prefix+"/{id}/cost", recipeScoped(http.HandlerFunc(h.getRecipeCost)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.createRecipe)))
+	mux.Handle("PUT "+prefix+"/{id}", recipeScoped(http.HandlerFunc(h.updateRecipe)))
+	mux.Handle("DELETE "+prefix+"/{id}", recipeScoped(http.HandlerFunc(h.deleteRecipe)))
+}
+
+// GetRecipes godoc
+// @Summary Get recipes
+// @Description Get all the recipes of a group, or the ones whose name contains the given one, sorted by name
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param name query string false "Name to search for"
+// @Param desc query bool false "Sort by name in descending order"
+// @Success 200 {array} dto.RecipeSummaryDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe [get]
+func (h *RecipeHandler) getRecipes(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	descending, err := descendingQueryParam(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var recipes []model.Recipe
+	if name := r.URL.Query().Get("name"); name != "" {
+		recipes, err = h.recipeService.SearchByNameAndGroupID(name, groupID, descending)
+	} else {
+		recipes, err = h.recipeService.GetByGroupID(groupID, descending)
+	}
+
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(recipes, mapper.ToRecipeSummaryDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetCookableRecipes godoc
+// @Summary Get the recipes which can be cooked with the available items
+// @Description Get the recipes of a group using at least one of the available items, with their missing ingredients.
+// @Description They are ranked by decreasing share of ingredients available, then by number of missing ingredients and by name.
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param item_ids query []int true "IDs of the available items" collectionFormat(csv)
+// @Param max_missing query int false "Maximum number of missing ingredients of the recipes"
+// @Success 200 {array} dto.RecipeMatchDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/cookable [get]
+func (h *RecipeHandler) getCookableRecipes(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	itemIDs, err := int64ListQueryParam(r, "item_ids")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	maxMissing, err := optionalIntQueryParam(r, "max_missing")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	matches, err := h.recipeService.GetByAvailableItems(groupID, itemIDs, maxMissing)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(matches, mapper.ToRecipeMatchDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetRecipeByID godoc
+// @Summary Get recipe by ID
+// @Description Get a recipe of a group by its ID, with its categories and ingredients.
+// @Description The quantities of the ingredients can be rescaled to a number of servings, and expressed in their most readable unit.
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Recipe ID"
+// @Param servings query int false "Number of servings to rescale the ingredients to"
+// @Param normalize query bool false "Express each quantity in its most readable unit, e.g. 1500 g as 1.5 kg"
+// @Success 200 {object} dto.RecipeDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/{id} [get]
+func (h *RecipeHandler) getRecipeByID(w http.ResponseWriter, r *http.Request) {
+	servings, err := optionalIntQueryParam(r, "servings")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	normalize, err := boolQueryParam(r, "normalize")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	recipe, err := h.recipeService.GetByID(r.Context().Value("id").(int64))
+	if err == nil && servings != nil {
+		err = h.recipeService.Scale(recipe, *servings)
+	}
+	if err == nil && normalize {
+		err = h.recipeService.NormalizeUnits(recipe)
+	}
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToRecipeDto(recipe)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetRecipeCost godoc
+// @Summary Estimate the cost of a recipe
+// @Description Estimate the cost of a recipe of a group, in total and per serving, from the average market price of its items.
+// @Description The ingredients which can't be priced are listed; the recipe can be rescaled to a number of servings first.
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Recipe ID"
+// @Param servings query int false "Number of servings to rescale the recipe to"
+// @Success 200 {object} dto.CostEstimateDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/{id}/cost [get]
+func (h *RecipeHandler) getRecipeCost(w http.ResponseWriter, r *http.Request) {
+	servings, err := optionalIntQueryParam(r, "servings")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var estimate *model.CostEstimate
+	recipe, err := h.recipeService.GetByID(r.Context().Value("id").(int64))
+	if err == nil && servings != nil {
+		err = h.recipeService.Scale(recipe, *servings)
+	}
+	if err == nil {
+		estimate, err = h.recipeService.EstimateCost(recipe)
+	}
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToCostEstimateDto(estimate)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_COST_ESTIMATE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateRecipe godoc
+// @Summary Create a new recipe
+// @Description Create a new recipe in a group; its categories, items and units must belong to the group
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param recipe body dto.NewRecipeDto true "New Recipe Data"
+// @Success 201 {object} map[string]int "Returns the new recipe ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: invalid category, item or unit"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe [post]
+func (h *RecipeHandler) createRecipe(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newRecipeDto dto.NewRecipeDto
+	if err := json.NewDecoder(r.Body).Decode(&newRecipeDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.recipeService.Create(r.Context(), mapper.FromNewRecipeDtoToRecipe(&newRecipeDto, groupID))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// UpdateRecipe godoc
+// @Summary Update a recipe
+// @Description Replace the details, categories and ingredients of an existing recipe of a group.
+// @Description Ingredients without ID are added, and the ones missing from the body are removed.
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Recipe ID"
+// @Param recipe body dto.NewRecipeDto true "Recipe Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe not found"
+// @Failure 409 {string} string "Conflict: invalid category, item, unit or ingredient"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/{id} [put]
+func (h *RecipeHandler) updateRecipe(w http.ResponseWriter, r *http.Request) {
+	currentRecipe, err := h.recipeService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var recipeDto dto.NewRecipeDto
+	if err := json.NewDecoder(r.Body).Decode(&recipeDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	recipe := mapper.FromNewRecipeDtoToRecipe(&recipeDto, currentRecipe.GroupID)
+	recipe.ID = currentRecipe.ID
+
+	if err := h.recipeService.Update(r.Context(), recipe); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteRecipe godoc
+// @Summary Delete a recipe
+// @Description Delete the recipe with the specified ID, with its ingredients, and remove it from the dishes it belongs to
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Recipe ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/{id} [delete]
+func (h *RecipeHandler) deleteRecipe(w http.ResponseWriter, r *http.Request) {
+	recipe, err := h.recipeService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.recipeService.Delete(r.Context(), recipe.ID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// recipeGroup returns the ID of the group owning a recipe, for middleware.GroupFromResource.
+func (h *RecipeHandler) recipeGroup(id int64) (*int64, error) {
+	recipe, err := h.recipeService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &recipe.GroupID, nil
+}
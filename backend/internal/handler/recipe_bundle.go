@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+	"go.yaml.in/yaml/v3"
+)
+
+// yamlContentTypes are the content types of the recipe bundles imported in YAML; other bundles are read in JSON.
+var yamlContentTypes = []string{constant.CONTENT_TYPE_YAML_VALUE, "application/x-yaml", "text/yaml", "text/x-yaml"}
+
+// RecipeBundleHandler handles the requests exporting the recipes of a group to bundles and importing bundles into a group.
+type RecipeBundleHandler struct {
+	recipeBundleService service.RecipeBundleServiceInterface
+	groupService        service.GroupServiceInterface
+}
+
+// NewRecipeBundleHandler constructs a new RecipeBundleHandler with the provided services.
+func NewRecipeBundleHandler(recipeBundleService service.RecipeBundleServiceInterface, groupService service.GroupServiceInterface) *RecipeBundleHandler {
+	return &RecipeBundleHandler{
+		recipeBundleService: recipeBundleService,
+		groupService:        groupService,
+	}
+}
+
+// RegisterRoutes registers the export and import routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; the routes are restricted to the members of the group.
+func (h *RecipeBundleHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.exportRecipes)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.importRecipes)))
+}
+
+// ExportRecipes godoc
+// @Summary Export recipes to a bundle
+// @Description Export a recipe, the recipes of a category or every recipe of a group to a versioned bundle, in JSON or YAML.
+// @Description The bundle also holds the units, items, item categories and recipe categories used by the recipes,
+// @Description which reference each other by name so that the bundle can be imported into another group or Yumsday instance.
+// @Tags recipe
+// @Produce json
+// @Produce application/yaml
+// @Param groupId path int true "Group ID"
+// @Param recipe_id query int false "ID of the recipe to export"
+// @Param category_id query int false "ID of the recipe category whose recipes are exported"
+// @Param format query string false "Format of the bundle, json (default) or yaml"
+// @Success 200 {object} dto.RecipeBundleDto
+// @Failure 400 {string} string "Bad request: invalid format, or both a recipe and a category"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe or recipe category not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/bundle [get]
+func (h *RecipeBundleHandler) exportRecipes(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	recipeID, err := optionalInt64QueryParam(r, "recipe_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	categoryID, err := optionalInt64QueryParam(r, "category_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "yaml" {
+		http.Error(w, customErrors.NewInvalidParamsError([]string{"format"}, nil).Error(), http.StatusBadRequest)
+		return
+	}
+
+	bundle, err := h.recipeBundleService.Export(groupID, recipeID, categoryID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	bundleDto := mapper.ToRecipeBundleDto(bundle)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": "recipes." + format}))
+
+	if format == "yaml" {
+		content, err := yaml.Marshal(bundleDto)
+		if err != nil {
+			http.Error(w, customErrors.SERIALIZE_RECIPE_BUNDLE_ERROR, http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_YAML_VALUE)
+		w.Write(content)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(bundleDto); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_BUNDLE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// ImportRecipes godoc
+// @Summary Import recipes from a bundle
+// @Description Create the recipes of a bundle in a group, in a single transaction. The bundle is read in YAML when sent with a YAML
+// @Description content type, and in JSON otherwise. Its units, items, item categories and recipe categories are matched by name,
+// @Description regardless of their case, to the ones of the group, units possibly coming from the catalogue; the missing ones are created.
+// @Tags recipe
+// @Accept json
+// @Accept application/yaml
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param bundle body dto.RecipeBundleDto true "Recipe bundle"
+// @Success 201 {array} dto.RecipeSummaryDto
+// @Failure 400 {string} string "Bad request: invalid bundle, unsupported version or undefined reference"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 413 {string} string "Bundle too large"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/bundle [post]
+func (h *RecipeBundleHandler) importRecipes(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	content, err := io.ReadAll(http.MaxBytesReader(w, r.Body, constant.MAX_RECIPE_BUNDLE_SIZE))
+	if err != nil {
+		if _, ok := errors.AsType[*http.MaxBytesError](err); ok {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var bundleDto dto.RecipeBundleDto
+	if isYAMLRequest(r) {
+		err = yaml.Unmarshal(content, &bundleDto)
+	} else {
+		err = json.Unmarshal(content, &bundleDto)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	recipes, err := h.recipeBundleService.Import(r.Context(), groupID, mapper.FromRecipeBundleDtoToRecipeBundle(&bundleDto))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(recipes, mapper.ToRecipeSummaryDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// isYAMLRequest tells whether the body of the request is sent with a YAML content type.
+func isYAMLRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(constant.CONTENT_TYPE_HEADER))
+	if err != nil {
+		return false
+	}
+
+	for _, contentType := range yamlContentTypes {
+		if mediaType == contentType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"go.yaml.in/yaml/v3"
+)
+
+// mockRecipeBundleService is a mock implementation of RecipeBundleServiceInterface for testing handler
+type mockRecipeBundleService struct {
+	exportErr      error
+	importErr      error
+	lastGroup      int64
+	lastRecipeID   *int64
+	lastCategoryID *int64
+	lastBundle     *model.RecipeBundle
+}
+
+func (m *mockRecipeBundleService) Export(groupID int64, recipeID, categoryID *int64) (*model.RecipeBundle, error) {
+	m.lastGroup = groupID
+	m.lastRecipeID = recipeID
+	m.lastCategoryID = categoryID
+	if m.exportErr != nil {
+		return nil, m.exportErr
+	}
+	return &model.RecipeBundle{
+		Version:          1,
+		Units:            []model.Unit{{Name: "Gram", Factor: 1, UnitType: enum.Weight}},
+		ItemCategories:   []model.ItemCategory{{Name: "PANTRY"}},
+		Items:            []model.Item{{Name: "Flour", UnitType: enum.Weight, ItemCategory: model.ItemCategory{Name: "PANTRY"}}},
+		RecipeCategories: []model.RecipeCategory{},
+		Recipes: []model.Recipe{
+			{
+				Name:        "Pancakes",
+				Categories:  []model.RecipeCategory{},
+				Ingredients: []model.Ingredient{{Quantity: new(250.0), Item: model.Item{Name: "Flour"}, Unit: model.Unit{Name: "Gram"}}},
+			},
+		},
+	}, nil
+}
+
+func (m *mockRecipeBundleService) Import(_ context.Context, groupID int64, bundle *model.RecipeBundle) ([]model.Recipe, error) {
+	m.lastGroup = groupID
+	m.lastBundle = bundle
+	if m.importErr != nil {
+		return nil, m.importErr
+	}
+	recipes := make([]model.Recipe, len(bundle.Recipes))
+	for i, recipe := range bundle.Recipes {
+		recipes[i] = model.Recipe{ID: int64(i + 1), Name: recipe.Name, GroupID: groupID}
+	}
+	return recipes, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupRecipeBundleTestData() (*mockRecipeBundleService, *mockGroupService) {
+	recipeBundleService := &mockRecipeBundleService{}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return recipeBundleService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewRecipeBundleHandler(t *testing.T) {
+	recipeBundleService, groupService := setupRecipeBundleTestData()
+	handler := NewRecipeBundleHandler(recipeBundleService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.recipeBundleService != recipeBundleService {
+		t.Error("handler recipeBundleService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestExportRecipes(t *testing.T) {
+	tests := []struct {
+		name                string
+		target              string
+		exportErr           error
+		expectedStatus      int
+		expectedContentType string
+	}{
+		{"Whole group in JSON", "/recipe/bundle", nil, http.StatusOK, constant.CONTENT_TYPE_VALUE},
+		{"Recipe in YAML", "/recipe/bundle?recipe_id=1&format=yaml", nil, http.StatusOK, constant.CONTENT_TYPE_YAML_VALUE},
+		{"Category", "/recipe/bundle?category_id=2", nil, http.StatusOK, constant.CONTENT_TYPE_VALUE},
+		{"Invalid recipe ID", "/recipe/bundle?recipe_id=abc", nil, http.StatusBadRequest, ""},
+		{"Invalid format", "/recipe/bundle?format=xml", nil, http.StatusBadRequest, ""},
+		{"Recipe not found", "/recipe/bundle?recipe_id=99", customErrors.NewNotFoundError("recipes", "id", nil), http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeBundleService, groupService := setupRecipeBundleTestData()
+			recipeBundleService.exportErr = tt.exportErr
+			handler := NewRecipeBundleHandler(recipeBundleService, groupService)
+
+			r := newItemRequest(http.MethodGet, tt.target, nil, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.exportRecipes(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if contentType := w.Header().Get(constant.CONTENT_TYPE_HEADER); contentType != tt.expectedContentType {
+				t.Errorf("expected content type %s, got %s", tt.expectedContentType, contentType)
+			}
+			if !strings.HasPrefix(w.Header().Get("Content-Disposition"), "attachment") {
+				t.Errorf("expected an attachment, got %q", w.Header().Get("Content-Disposition"))
+			}
+
+			var actual dto.RecipeBundleDto
+			var err error
+			if tt.expectedContentType == constant.CONTENT_TYPE_YAML_VALUE {
+				err = yaml.Unmarshal(w.Body.Bytes(), &actual)
+			} else {
+				err = json.Unmarshal(w.Body.Bytes(), &actual)
+			}
+			if err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if recipeBundleService.lastGroup != 1 || actual.Version != 1 || len(actual.Recipes) != 1 || actual.Recipes[0].Ingredients[0].Item != "Flour" {
+				t.Errorf("unexpected bundle %+v", actual)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestImportRecipes(t *testing.T) {
+	jsonBundle := `{"version": 1, "units": [{"name": "Gram", "factor": 1, "unit_type": "WEIGHT"}],
+		"items": [{"name": "Flour", "unit_type": "WEIGHT"}],
+		"recipes": [{"name": "Pancakes", "ingredients": [{"quantity": 250, "item": "Flour", "unit": "Gram"}]}]}`
+	yamlBundle := `version: 1
+units:
+  - name: Gram
+    factor: 1
+    unit_type: WEIGHT
+items:
+  - name: Flour
+    unit_type: WEIGHT
+recipes:
+  - name: Pancakes
+    ingredients:
+      - quantity: 250
+        item: Flour
+        unit: Gram
+`
+
+	tests := []struct {
+		name           string
+		contentType    string
+		body           string
+		importErr      error
+		expectedStatus int
+	}{
+		{"JSON bundle", constant.CONTENT_TYPE_VALUE, jsonBundle, nil, http.StatusCreated},
+		{"YAML bundle", "application/x-yaml; charset=utf-8", yamlBundle, nil, http.StatusCreated},
+		{"YAML bundle sent as JSON", constant.CONTENT_TYPE_VALUE, yamlBundle, nil, http.StatusBadRequest},
+		{"Invalid unit type", constant.CONTENT_TYPE_YAML_VALUE, strings.Replace(yamlBundle, "WEIGHT", "MASS", 1), nil, http.StatusBadRequest},
+		{"Invalid bundle", constant.CONTENT_TYPE_VALUE, jsonBundle, customErrors.NewInvalidParamsError([]string{"version"}, nil), http.StatusBadRequest},
+		{"Too large", constant.CONTENT_TYPE_VALUE, strings.Repeat(" ", constant.MAX_RECIPE_BUNDLE_SIZE+1), nil, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeBundleService, groupService := setupRecipeBundleTestData()
+			recipeBundleService.importErr = tt.importErr
+			handler := NewRecipeBundleHandler(recipeBundleService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/recipe/bundle", []byte(tt.body), memberUser, map[string]int64{"groupId": 1})
+			r.Header.Set(constant.CONTENT_TYPE_HEADER, tt.contentType)
+			w := httptest.NewRecorder()
+
+			handler.importRecipes(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var actual []dto.RecipeSummaryDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != 1 || actual[0].Name != "Pancakes" {
+				t.Errorf("unexpected recipes %+v", actual)
+			}
+
+			bundle := recipeBundleService.lastBundle
+			if recipeBundleService.lastGroup != 1 || bundle.Version != 1 || bundle.Units[0].UnitType != enum.Weight ||
+				bundle.Recipes[0].Ingredients[0].Item.Name != "Flour" || *bundle.Recipes[0].Ingredients[0].Quantity != 250 {
+				t.Errorf("unexpected imported bundle %+v", *bundle)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// RecipeCategoryHandler handles HTTP requests related to the recipe categories of a group.
+type RecipeCategoryHandler struct {
+	recipeCategoryService service.RecipeCategoryServiceInterface
+	groupService          service.GroupServiceInterface
+}
+
+// NewRecipeCategoryHandler constructs a new RecipeCategoryHandler with the provided services.
+func NewRecipeCategoryHandler(recipeCategoryService service.RecipeCategoryServiceInterface, groupService service.GroupServiceInterface) *RecipeCategoryHandler {
+	return &RecipeCategoryHandler{
+		recipeCategoryService: recipeCategoryService,
+		groupService:          groupService,
+	}
+}
+
+// RegisterRoutes registers the recipe category-related routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; every route is restricted to the members of the group.
+func (h *RecipeCategoryHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+	categoryMember := middleware.GroupMember(h.groupService, middleware.GroupFromResource("recipe_categories", "id", h.categoryGroup))
+	categoryScoped := middleware.Stack(middleware.IntPathValues("groupId", "id"), member, categoryMember)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.getRecipeCategories)))
+	mux.Handle("GET "+prefix+"/{id}", categoryScoped(http.HandlerFunc(h.getRecipeCategoryByID)))
+	mux.Handle("GET "+prefix+"/{id}/recipes", categoryScoped(http.HandlerFunc(h.getRecipeCategoryRecipes)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.createRecipeCategory)))
+	mux.Handle("PUT "+prefix+"/{id}", categoryScoped(http.HandlerFunc(h.updateRecipeCategory)))
+	mux.Handle("DELETE "+prefix+"/{id}", categoryScoped(http.HandlerFunc(h.deleteRecipeCategory)))
+}
+
+// GetRecipeCategories godoc
+// @Summary Get recipe categories
+// @Description Get all the recipe categories of a group, sorted by name
+// @Tags recipe-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param desc query bool false "Sort by name in descending order"
+// @Success 200 {array} dto.RecipeCategoryDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe-category [get]
+func (h *RecipeCategoryHandler) getRecipeCategories(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	descending, err := descendingQueryParam(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	categories, err := h.recipeCategoryService.GetByGroupID(groupID, descending)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(categories, mapper.ToRecipeCategoryDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_CATEGORY_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetRecipeCategoryByID godoc
+// @Summary Get recipe category by ID
+// @Description Get a recipe category of a group by its ID
+// @Tags recipe-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Recipe category ID"
+// @Success 200 {object} dto.RecipeCategoryDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe category not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe-category/{id} [get]
+func (h *RecipeCategoryHandler) getRecipeCategoryByID(w http.ResponseWriter, r *http.Request) {
+	category, err := h.recipeCategoryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToRecipeCategoryDto(category)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_CATEGORY_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetRecipeCategoryRecipes godoc
+// @Summary Get the recipes of a recipe category
+// @Description Get all the recipes belonging to a recipe category of a group, sorted by name
+// @Tags recipe-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Recipe category ID"
+// @Param desc query bool false "Sort by name in descending order"
+// @Success 200 {array} dto.RecipeSummaryDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe category not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe-category/{id}/recipes [get]
+func (h *RecipeCategoryHandler) getRecipeCategoryRecipes(w http.ResponseWriter, r *http.Request) {
+	descending, err := descendingQueryParam(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	category, err := h.recipeCategoryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	recipes, err := h.recipeCategoryService.GetRecipes(category.ID, descending)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(recipes, mapper.ToRecipeSummaryDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateRecipeCategory godoc
+// @Summary Create a new recipe category
+// @Description Create a new recipe category in a group; its name must be unique in the group, whatever its case
+// @Tags recipe-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param category body dto.NewRecipeCategoryDto true "New Recipe Category Data"
+// @Success 201 {object} map[string]int "Returns the new recipe category ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: name already used in the group"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe-category [post]
+func (h *RecipeCategoryHandler) createRecipeCategory(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newCategoryDto dto.NewRecipeCategoryDto
+	if err := json.NewDecoder(r.Body).Decode(&newCategoryDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.recipeCategoryService.Create(mapper.FromNewRecipeCategoryDtoToRecipeCategory(&newCategoryDto, groupID))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// UpdateRecipeCategory godoc
+// @Summary Rename a recipe category
+// @Description Rename an existing recipe category of a group; its new name must be unique in the group, whatever its case
+// @Tags recipe-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Recipe category ID"
+// @Param category body dto.NewRecipeCategoryDto true "Recipe Category Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe category not found"
+// @Failure 409 {string} string "Conflict: name already used in the group"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe-category/{id} [put]
+func (h *RecipeCategoryHandler) updateRecipeCategory(w http.ResponseWriter, r *http.Request) {
+	currentCategory, err := h.recipeCategoryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var categoryDto dto.NewRecipeCategoryDto
+	if err := json.NewDecoder(r.Body).Decode(&categoryDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	category := mapper.FromNewRecipeCategoryDtoToRecipeCategory(&categoryDto, currentCategory.GroupID)
+	category.ID = currentCategory.ID
+
+	if err := h.recipeCategoryService.Update(category); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteRecipeCategory godoc
+// @Summary Delete a recipe category
+// @Description Delete the recipe category with the specified ID.
+// @Description A category used by recipes is only deleted when detach is true, the recipes being kept without it.
+// @Tags recipe-category
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Recipe category ID"
+// @Param detach query bool false "Detach the recipes from the category instead of refusing the deletion"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe category not found"
+// @Failure 409 {string} string "Conflict: recipe category used by recipes"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe-category/{id} [delete]
+func (h *RecipeCategoryHandler) deleteRecipeCategory(w http.ResponseWriter, r *http.Request) {
+	detach := false
+	if param := r.URL.Query().Get("detach"); param != "" {
+		var err error
+		if detach, err = strconv.ParseBool(param); err != nil {
+			http.Error(w, customErrors.NewInvalidParamsError([]string{"detach"}, err).Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	category, err := h.recipeCategoryService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.recipeCategoryService.Delete(r.Context(), category.ID, detach); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// categoryGroup returns the ID of the group owning a recipe category, for middleware.GroupFromResource.
+func (h *RecipeCategoryHandler) categoryGroup(id int64) (*int64, error) {
+	category, err := h.recipeCategoryService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &category.GroupID, nil
+}
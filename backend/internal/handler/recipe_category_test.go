@@ -0,0 +1,490 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockRecipeCategoryService is a mock implementation of RecipeCategoryServiceInterface for testing handler
+type mockRecipeCategoryService struct {
+	categories []model.RecipeCategory
+	recipes    []model.Recipe
+	nextID     int64
+	createErr  error
+	updateErr  error
+	deleteErr  error
+	lastDesc   bool
+	lastDetach bool
+}
+
+func (m *mockRecipeCategoryService) GetByID(id int64) (*model.RecipeCategory, error) {
+	for i := range m.categories {
+		if m.categories[i].ID == id {
+			return &m.categories[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("recipe_categories", "id", nil)
+}
+
+func (m *mockRecipeCategoryService) GetByGroupID(groupID int64, descending bool) ([]model.RecipeCategory, error) {
+	m.lastDesc = descending
+
+	result := []model.RecipeCategory{}
+	for _, category := range m.categories {
+		if category.GroupID == groupID {
+			result = append(result, category)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRecipeCategoryService) GetRecipes(id int64, descending bool) ([]model.Recipe, error) {
+	m.lastDesc = descending
+
+	result := []model.Recipe{}
+	for _, recipe := range m.recipes {
+		for _, category := range recipe.Categories {
+			if category.ID == id {
+				result = append(result, recipe)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRecipeCategoryService) Create(category *model.RecipeCategory) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+	category.ID = m.nextID
+	m.nextID++
+	m.categories = append(m.categories, *category)
+	return category.ID, nil
+}
+
+func (m *mockRecipeCategoryService) Update(category *model.RecipeCategory) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for i := range m.categories {
+		if m.categories[i].ID == category.ID {
+			m.categories[i] = *category
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("recipe_categories", "id", nil)
+}
+
+func (m *mockRecipeCategoryService) Delete(_ context.Context, id int64, detach bool) error {
+	m.lastDetach = detach
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+	for i := range m.categories {
+		if m.categories[i].ID == id {
+			m.categories = append(m.categories[:i], m.categories[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("recipe_categories", "id", nil)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupRecipeCategoryTestData() (*mockRecipeCategoryService, *mockGroupService) {
+	dessert := model.RecipeCategory{ID: 1, Name: "DESSERT", GroupID: 1}
+	recipeCategoryService := &mockRecipeCategoryService{
+		categories: []model.RecipeCategory{
+			dessert,
+			{ID: 2, Name: "MAIN COURSE", GroupID: 1},
+			{ID: 3, Name: "SOUP", GroupID: 2},
+		},
+		recipes: []model.Recipe{
+			{ID: 1, Name: "Cookies", GroupID: 1, Categories: []model.RecipeCategory{dessert}},
+			{ID: 2, Name: "Chocolate cake", GroupID: 1, Categories: []model.RecipeCategory{dessert}},
+			{ID: 3, Name: "Roast beef", GroupID: 1},
+		},
+		nextID: 4,
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return recipeCategoryService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewRecipeCategoryHandler(t *testing.T) {
+	recipeCategoryService, groupService := setupRecipeCategoryTestData()
+	handler := NewRecipeCategoryHandler(recipeCategoryService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.recipeCategoryService != recipeCategoryService {
+		t.Error("handler recipeCategoryService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetRecipeCategories(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		groupID        int64
+		expectedStatus int
+		expectedIDs    []int64
+		expectedDesc   bool
+	}{
+		{"Categories of the group", "/recipe-category?desc=true", 1, http.StatusOK, []int64{1, 2}, true},
+		{"Categories of another group", "/recipe-category", 2, http.StatusOK, []int64{3}, false},
+		{"Invalid desc parameter", "/recipe-category?desc=maybe", 1, http.StatusBadRequest, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeCategoryService, groupService := setupRecipeCategoryTestData()
+			handler := NewRecipeCategoryHandler(recipeCategoryService, groupService)
+
+			r := newItemRequest(http.MethodGet, tt.target, nil, memberUser, map[string]int64{"groupId": tt.groupID})
+			w := httptest.NewRecorder()
+
+			handler.getRecipeCategories(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			contentType := w.Header().Get(constant.CONTENT_TYPE_HEADER)
+			if contentType != constant.CONTENT_TYPE_VALUE {
+				t.Errorf("expected content type %s instead of %s", constant.CONTENT_TYPE_VALUE, contentType)
+			}
+
+			var actual []dto.RecipeCategoryDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d categories instead of %d", len(tt.expectedIDs), len(actual))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected category %d instead of %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+
+			if recipeCategoryService.lastDesc != tt.expectedDesc {
+				t.Errorf("expected desc %v instead of %v", tt.expectedDesc, recipeCategoryService.lastDesc)
+			}
+		})
+	}
+}
+
+func TestGetRecipeCategoryByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		categoryID     int64
+		expectedStatus int
+	}{
+		{"Existing category of the group", 1, http.StatusOK},
+		{"Unknown category", -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeCategoryService, groupService := setupRecipeCategoryTestData()
+			handler := NewRecipeCategoryHandler(recipeCategoryService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/recipe-category", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.categoryID})
+			w := httptest.NewRecorder()
+
+			handler.getRecipeCategoryByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.RecipeCategoryDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != 1 || actual.Name != "DESSERT" {
+				t.Errorf("unexpected category %+v", actual)
+			}
+		})
+	}
+}
+
+func TestGetRecipeCategoryRecipes(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		categoryID     int64
+		expectedStatus int
+		expectedIDs    []int64
+	}{
+		{"Category with recipes", "/recipe-category/1/recipes?desc=true", 1, http.StatusOK, []int64{1, 2}},
+		{"Category without recipe", "/recipe-category/2/recipes", 2, http.StatusOK, []int64{}},
+		{"Invalid desc parameter", "/recipe-category/1/recipes?desc=maybe", 1, http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeCategoryService, groupService := setupRecipeCategoryTestData()
+			handler := NewRecipeCategoryHandler(recipeCategoryService, groupService)
+
+			r := newItemRequest(http.MethodGet, tt.target, nil, memberUser, map[string]int64{"groupId": 1, "id": tt.categoryID})
+			w := httptest.NewRecorder()
+
+			handler.getRecipeCategoryRecipes(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual []dto.RecipeSummaryDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d recipes instead of %d", len(tt.expectedIDs), len(actual))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected recipe %d instead of %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateRecipeCategory(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewRecipeCategoryDto{Name: "BREAKFAST"})
+
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid category", validBody, nil, http.StatusCreated},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Invalid name", validBody, customErrors.NewValidationError("name", customErrors.RECIPE_CATEGORY_NAME_FIELD_ERROR, nil), http.StatusBadRequest},
+		{"Name already used", validBody, customErrors.NewConflictError("RecipeCategory", "a recipe category with this name already exists in the group", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeCategoryService, groupService := setupRecipeCategoryTestData()
+			recipeCategoryService.createErr = tt.createErr
+			handler := NewRecipeCategoryHandler(recipeCategoryService, groupService)
+			categoriesNb := len(recipeCategoryService.categories)
+
+			r := newItemRequest(http.MethodPost, "/recipe-category", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.createRecipeCategory(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				if len(recipeCategoryService.categories) != categoriesNb {
+					t.Errorf("expected %d categories instead of %d", categoriesNb, len(recipeCategoryService.categories))
+				}
+				return
+			}
+
+			var result map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			created, err := recipeCategoryService.GetByID(result["id"])
+			if err != nil {
+				t.Fatalf("failed to retrieve created category: %v", err)
+			}
+
+			if created.Name != "BREAKFAST" || created.GroupID != 1 {
+				t.Errorf("unexpected created category %+v", created)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdateRecipeCategory(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewRecipeCategoryDto{Name: "SWEETS"})
+
+	tests := []struct {
+		name           string
+		categoryID     int64
+		body           []byte
+		updateErr      error
+		expectedStatus int
+	}{
+		{"Valid update", 1, validBody, nil, http.StatusNoContent},
+		{"Invalid body", 1, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Name already used", 1, validBody, customErrors.NewConflictError("RecipeCategory", "a recipe category with this name already exists in the group", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeCategoryService, groupService := setupRecipeCategoryTestData()
+			recipeCategoryService.updateErr = tt.updateErr
+			handler := NewRecipeCategoryHandler(recipeCategoryService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/recipe-category", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.categoryID})
+			w := httptest.NewRecorder()
+
+			handler.updateRecipeCategory(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, _ := recipeCategoryService.GetByID(tt.categoryID)
+			if updated.Name != "SWEETS" || updated.GroupID != 1 {
+				t.Errorf("unexpected updated category %+v", updated)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeleteRecipeCategory(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		categoryID     int64
+		deleteErr      error
+		expectedStatus int
+		expectedDetach bool
+	}{
+		{"Unused category", "/recipe-category/2", 2, nil, http.StatusNoContent, false},
+		{"Detach the recipes", "/recipe-category/1?detach=true", 1, nil, http.StatusNoContent, true},
+		{
+			"Category used by recipes", "/recipe-category/1", 1,
+			customErrors.NewConflictError("RecipeCategory", "can't delete recipe category used by recipes", nil),
+			http.StatusConflict, false,
+		},
+		{"Invalid detach parameter", "/recipe-category/1?detach=maybe", 1, nil, http.StatusBadRequest, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeCategoryService, groupService := setupRecipeCategoryTestData()
+			recipeCategoryService.deleteErr = tt.deleteErr
+			handler := NewRecipeCategoryHandler(recipeCategoryService, groupService)
+			categoriesNb := len(recipeCategoryService.categories)
+
+			r := newItemRequest(http.MethodDelete, tt.target, nil, memberUser, map[string]int64{"groupId": 1, "id": tt.categoryID})
+			w := httptest.NewRecorder()
+
+			handler.deleteRecipeCategory(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				if len(recipeCategoryService.categories) != categoriesNb {
+					t.Errorf("expected %d categories instead of %d", categoriesNb, len(recipeCategoryService.categories))
+				}
+				return
+			}
+
+			if len(recipeCategoryService.categories) != categoriesNb-1 {
+				t.Errorf("expected %d categories instead of %d", categoriesNb-1, len(recipeCategoryService.categories))
+			}
+
+			if recipeCategoryService.lastDetach != tt.expectedDetach {
+				t.Errorf("expected detach %v instead of %v", tt.expectedDetach, recipeCategoryService.lastDetach)
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestRecipeCategoryRegisterRoutes(t *testing.T) {
+	recipeCategoryService, groupService := setupRecipeCategoryTestData()
+	handler := NewRecipeCategoryHandler(recipeCategoryService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/recipe-category")
+
+	r := httptest.NewRequest(http.MethodGet, "/api/group/1/recipe-category/1/recipes", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/recipe-category/1/recipes instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/recipe-category", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/recipe-category as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodDelete, "/api/group/1/recipe-category/abc", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for DELETE /api/group/1/recipe-category/abc instead of %d", http.StatusBadRequest, w.Code)
+	}
+
+	// Categories of another group are reported as not found on every route.
+	assertRoutesStatus(t, mux, memberUser, []string{
+		"GET /api/group/1/recipe-category/3",
+		"GET /api/group/1/recipe-category/3/recipes",
+		"PUT /api/group/1/recipe-category/3",
+		"DELETE /api/group/1/recipe-category/3",
+	}, http.StatusNotFound)
+}
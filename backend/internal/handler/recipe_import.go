@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// RecipeImportHandler handles the requests importing recipes from web pages into a group.
+type RecipeImportHandler struct {
+	recipeImportService service.RecipeImportServiceInterface
+	groupService        service.GroupServiceInterface
+}
+
+// NewRecipeImportHandler constructs a new RecipeImportHandler with the provided services.
+func NewRecipeImportHandler(recipeImportService service.RecipeImportServiceInterface, groupService service.GroupServiceInterface) *RecipeImportHandler {
+	return &RecipeImportHandler{
+		recipeImportService: recipeImportService,
+		groupService:        groupService,
+	}
+}
+
+// RegisterRoutes registers the import routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; the routes are restricted to the members of the group.
+func (h *RecipeImportHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.importRecipe)))
+	mux.Handle("POST "+prefix+"/ingredients", groupScoped(http.HandlerFunc(h.parseIngredients)))
+}
+
+// ImportRecipe godoc
+// @Summary Import a recipe from a web page
+// @Description Draft a recipe of a group from the schema.org Recipe JSON-LD of a web page, either fetched from its URL or pasted in HTML.
+// @Description The draft isn't saved: it is meant to be reviewed, then created as any other recipe. Its ingredients are the lines of the page
+// @Description matched to the items and units of the group; the other lines are returned apart. They are read in English or French,
+// @Description the inLanguage of the recipe, or English, by default.
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param page body dto.RecipeImportDto true "URL or HTML of the page"
+// @Success 200 {object} dto.RecipeDraftDto
+// @Failure 400 {string} string "Bad request: invalid URL, HTML or language, page not fetched or without recipe"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/import [post]
+func (h *RecipeImportHandler) importRecipe(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var importDto dto.RecipeImportDto
+	if err := json.NewDecoder(r.Body).Decode(&importDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.recipeImportService.Import(r.Context(), groupID, importDto.URL, importDto.HTML, importDto.Language)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToRecipeDraftDto(draft)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_DRAFT_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// ParseIngredients godoc
+// @Summary Parse ingredient lines
+// @Description Split ingredient lines of free text, such as "2 1/2 cups flour, sifted" or "200 g de beurre", into their quantity, unit, item and note.
+// @Description Quantities may be fractions, unicode vulgar fractions or ranges, whose lower bound is returned apart. Units are read in English or French,
+// @Description the language of the user by default, and resolved against the units of the group. Items unknown to the group are offered as new items.
+// @Tags recipe
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param lines body dto.IngredientLinesDto true "Ingredient lines and their language"
+// @Success 200 {array} dto.ParsedIngredientDto
+// @Failure 400 {string} string "Bad request: no lines, too many lines or invalid language"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/import/ingredients [post]
+func (h *RecipeImportHandler) parseIngredients(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var linesDto dto.IngredientLinesDto
+	if err := json.NewDecoder(r.Body).Decode(&linesDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	language := u.Language
+	if linesDto.Language != nil {
+		language = *linesDto.Language
+	}
+
+	parsed, err := h.recipeImportService.ParseIngredients(groupID, linesDto.Lines, language)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(parsed, mapper.ToParsedIngredientDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_INGREDIENT_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+// mockRecipeImportService is a mock implementation of RecipeImportServiceInterface for testing handler
+type mockRecipeImportService struct {
+	importErr    error
+	parseErr     error
+	lastGroup    int64
+	lastLink     *string
+	lastPage     *string
+	lastLines    []string
+	lastLanguage enum.Language
+	// Language requested for the last import, nil for the default one.
+	lastImportLanguage *enum.Language
+}
+
+func (m *mockRecipeImportService) Import(_ context.Context, groupID int64, link, page *string, language *enum.Language) (*model.RecipeDraft, error) {
+	m.lastGroup = groupID
+	m.lastLink = link
+	m.lastPage = page
+	m.lastImportLanguage = language
+	if m.importErr != nil {
+		return nil, m.importErr
+	}
+	return &model.RecipeDraft{
+		Recipe: model.Recipe{
+			Name:         "Fluffy Pancakes",
+			OriginalLink: link,
+			GroupID:      groupID,
+			Categories:   []model.RecipeCategory{},
+			Ingredients: []model.Ingredient{
+				{Quantity: new(250.0), Item: model.Item{ID: 1, Name: "Flour"}, Unit: model.Unit{ID: 2, Name: "Gram"}},
+			},
+		},
+		UnmatchedIngredients: []string{"a pinch of salt"},
+	}, nil
+}
+
+func (m *mockRecipeImportService) ParseIngredients(groupID int64, lines []string, language enum.Language) ([]model.ParsedIngredient, error) {
+	m.lastGroup = groupID
+	m.lastLines = lines
+	m.lastLanguage = language
+	if m.parseErr != nil {
+		return nil, m.parseErr
+	}
+	return []model.ParsedIngredient{
+		{Line: "250 g flour", Quantity: new(250.0), Unit: &model.Unit{ID: 2, Name: "Gram"}, Item: &model.Item{ID: 1, Name: "Flour"}},
+		{Line: "200 g de beurre", Quantity: new(200.0), Unit: &model.Unit{ID: 2, Name: "Gram"},
+			NewItem: &model.Item{Name: "Beurre", UnitType: enum.Weight, GroupID: groupID}},
+	}, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupRecipeImportTestData() (*mockRecipeImportService, *mockGroupService) {
+	recipeImportService := &mockRecipeImportService{}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return recipeImportService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewRecipeImportHandler(t *testing.T) {
+	recipeImportService, groupService := setupRecipeImportTestData()
+	handler := NewRecipeImportHandler(recipeImportService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.recipeImportService != recipeImportService {
+		t.Error("handler recipeImportService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestImportRecipe(t *testing.T) {
+	link := "https://cooking.example.com/fluffy-pancakes/"
+	linkBody, _ := json.Marshal(dto.RecipeImportDto{URL: &link})
+	pageBody, _ := json.Marshal(dto.RecipeImportDto{HTML: new("<html></html>")})
+	frenchBody, _ := json.Marshal(dto.RecipeImportDto{URL: &link, Language: &enum.French})
+
+	tests := []struct {
+		name             string
+		body             []byte
+		importErr        error
+		expectedStatus   int
+		expectedLanguage *enum.Language
+	}{
+		{"Fetched page", linkBody, nil, http.StatusOK, nil},
+		{"Pasted page", pageBody, nil, http.StatusOK, nil},
+		{"Page in a given language", frenchBody, nil, http.StatusOK, &enum.French},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest, nil},
+		{"Invalid language", []byte(`{"url": "` + link + `", "language": "DE"}`), nil, http.StatusBadRequest, nil},
+		{"Neither link nor page", []byte("{}"), customErrors.NewInvalidParamsError([]string{"url", "html"}, nil), http.StatusBadRequest, nil},
+		{"Page without recipe", linkBody, customErrors.NewValidationError("url", customErrors.RECIPE_IMPORT_NOT_FOUND_ERROR, nil), http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeImportService, groupService := setupRecipeImportTestData()
+			recipeImportService.importErr = tt.importErr
+			handler := NewRecipeImportHandler(recipeImportService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/recipe/import", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.importRecipe(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.RecipeDraftDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if recipeImportService.lastGroup != 1 || actual.Recipe.Name != "Fluffy Pancakes" || actual.Recipe.GroupID != 1 {
+				t.Errorf("unexpected draft %+v", actual)
+			}
+			if len(actual.Recipe.Ingredients) != 1 || actual.Recipe.Ingredients[0].Item.Name != "Flour" {
+				t.Errorf("expected the flour ingredient, got %+v", actual.Recipe.Ingredients)
+			}
+			if len(actual.UnmatchedIngredients) != 1 || actual.UnmatchedIngredients[0] != "a pinch of salt" {
+				t.Errorf("expected the salt to be unmatched, got %v", actual.UnmatchedIngredients)
+			}
+			if (recipeImportService.lastLink != nil) != (actual.Recipe.OriginalLink != nil) {
+				t.Errorf("expected the original link to be the fetched URL, got %v", actual.Recipe.OriginalLink)
+			}
+			if !reflect.DeepEqual(recipeImportService.lastImportLanguage, tt.expectedLanguage) {
+				t.Errorf("expected language %v instead of %v", tt.expectedLanguage, recipeImportService.lastImportLanguage)
+			}
+		})
+	}
+}
+
+func TestParseIngredients(t *testing.T) {
+	frenchUser := &model.User{ID: memberUser.ID, Username: memberUser.Username, Language: enum.French}
+
+	tests := []struct {
+		name             string
+		body             []byte
+		parseErr         error
+		expectedStatus   int
+		expectedLanguage enum.Language
+	}{
+		{"Language of the user", []byte(`{"lines": ["250 g flour", "200 g de beurre"]}`), nil, http.StatusOK, enum.French},
+		{"Language of the lines", []byte(`{"lines": ["250 g flour"], "language": "EN"}`), nil, http.StatusOK, enum.English},
+		{"Invalid language", []byte(`{"lines": ["250 g flour"], "language": "DE"}`), nil, http.StatusBadRequest, enum.Language{}},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest, enum.Language{}},
+		{"No lines", []byte(`{"lines": []}`), customErrors.NewInvalidParamsError([]string{"lines"}, nil), http.StatusBadRequest, enum.French},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeImportService, groupService := setupRecipeImportTestData()
+			recipeImportService.parseErr = tt.parseErr
+			handler := NewRecipeImportHandler(recipeImportService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/recipe/import/ingredients", tt.body, frenchUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.parseIngredients(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+			if recipeImportService.lastLanguage != tt.expectedLanguage {
+				t.Errorf("expected the lines to be parsed in %v instead of %v", tt.expectedLanguage, recipeImportService.lastLanguage)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual []dto.ParsedIngredientDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if recipeImportService.lastGroup != 1 || len(actual) != 2 {
+				t.Fatalf("unexpected parsed ingredients %+v", actual)
+			}
+			if actual[0].Item == nil || actual[0].Item.Name != "Flour" || actual[0].NewItem != nil {
+				t.Errorf("expected the flour to be found, got %+v", actual[0])
+			}
+			if actual[1].Item != nil || actual[1].NewItem == nil || actual[1].NewItem.Name != "Beurre" {
+				t.Errorf("expected the butter to be offered as a new item, got %+v", actual[1])
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestRecipeImportRegisterRoutes(t *testing.T) {
+	recipeImportService, groupService := setupRecipeImportTestData()
+	handler := NewRecipeImportHandler(recipeImportService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/recipe/import")
+
+	body := []byte(`{"html": "<html></html>"}`)
+
+	r := httptest.NewRequest(http.MethodPost, "/api/group/1/recipe/import", bytes.NewReader(body))
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for POST /api/group/1/recipe/import instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/api/group/1/recipe/import", bytes.NewReader(body))
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for POST /api/group/1/recipe/import as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/api/group/1/recipe/import/ingredients", bytes.NewReader([]byte(`{"lines": ["2 eggs"]}`)))
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for POST /api/group/1/recipe/import/ingredients instead of %d", http.StatusOK, w.Code)
+	}
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// RecipeShareHandler handles HTTP requests related to public recipes, shared outside of their group.
+type RecipeShareHandler struct {
+	recipeShareService service.RecipeShareServiceInterface
+	groupService       service.GroupServiceInterface
+}
+
+// NewRecipeShareHandler constructs a new RecipeShareHandler with the provided services.
+func NewRecipeShareHandler(recipeShareService service.RecipeShareServiceInterface, groupService service.GroupServiceInterface) *RecipeShareHandler {
+	return &RecipeShareHandler{
+		recipeShareService: recipeShareService,
+		groupService:       groupService,
+	}
+}
+
+// RegisterRoutes registers the authenticated route copying a public recipe on the provided ServeMux with the given prefix.
+// Public recipes are copied under <prefix>/group/{groupId}/recipe/copy/{id}, restricted to the members of the group.
+func (h *RecipeShareHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+
+	mux.Handle("POST "+prefix+"/group/{groupId}/recipe/copy/{id}",
+		middleware.Stack(middleware.IntPathValues("groupId", "id"), member)(http.HandlerFunc(h.copyRecipe)))
+}
+
+// RegisterPublicRoutes registers the read-only routes of public recipes on the provided ServeMux with the given prefix.
+// They don't require any authentication.
+func (h *RecipeShareHandler) RegisterPublicRoutes(mux *http.ServeMux, prefix string) {
+	mux.Handle("GET "+prefix+"/{id}", middleware.IntPathValues("id")(http.HandlerFunc(h.getPublicRecipe)))
+}
+
+// GetPublicRecipe godoc
+// @Summary Get a public recipe
+// @Description Get a recipe marked as public by its ID, without authentication. Its private fields, such as its comment, are left out.
+// @Tags recipe
+// @Produce json
+// @Param id path int true "Recipe ID"
+// @Success 200 {object} dto.PublicRecipeDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 404 {string} string "Recipe not found or not public"
+// @Failure 500 {string} string "Internal server error"
+// @Router /public/recipe/{id} [get]
+func (h *RecipeShareHandler) getPublicRecipe(w http.ResponseWriter, r *http.Request) {
+	id := r.Context().Value("id").(int64)
+
+	recipe, err := h.recipeShareService.GetPublicByID(id)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToPublicRecipeDto(recipe)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CopyRecipe godoc
+// @Summary Copy a public recipe
+// @Description Copy a public recipe, possibly from another group, into a group as a private recipe.
+// @Description Its items and units are matched by name to the ones of the group, the missing ones being created;
+// @Description its comment and recipe categories aren't copied.
+// @Tags recipe
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "ID of the public recipe to copy"
+// @Success 201 {object} dto.RecipeDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Recipe not found or not public"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/recipe/copy/{id} [post]
+func (h *RecipeShareHandler) copyRecipe(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+	id := r.Context().Value("id").(int64)
+
+	recipe, err := h.recipeShareService.Copy(r.Context(), id, groupID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(mapper.ToRecipeDto(recipe)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_RECIPE_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockRecipeShareService is a mock implementation of RecipeShareServiceInterface for testing handler
+type mockRecipeShareService struct {
+	recipes   []model.Recipe
+	lastGroup int64
+}
+
+func (m *mockRecipeShareService) GetPublicByID(id int64) (*model.Recipe, error) {
+	for i := range m.recipes {
+		if m.recipes[i].ID == id && m.recipes[i].Public {
+			return &m.recipes[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("recipes", "id", nil)
+}
+
+func (m *mockRecipeShareService) Copy(_ context.Context, id int64, groupID int64) (*model.Recipe, error) {
+	m.lastGroup = groupID
+	recipe, err := m.GetPublicByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Recipe{ID: 42, Name: recipe.Name, GroupID: groupID}, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupRecipeShareTestData() (*mockRecipeShareService, *mockGroupService) {
+	recipeShareService := &mockRecipeShareService{
+		recipes: []model.Recipe{
+			{ID: 1, Name: "Pancakes", Public: true, Comment: new("Family favorite!"), GroupID: 2},
+			{ID: 2, Name: "Secret Sauce", Comment: new("Don't tell anyone"), GroupID: 2},
+		},
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return recipeShareService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewRecipeShareHandler(t *testing.T) {
+	recipeShareService, groupService := setupRecipeShareTestData()
+	handler := NewRecipeShareHandler(recipeShareService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.recipeShareService != recipeShareService {
+		t.Error("handler recipeShareService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetPublicRecipe(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		expectedStatus int
+	}{
+		{"Public recipe", "/public/recipe/1", http.StatusOK},
+		{"Private recipe", "/public/recipe/2", http.StatusNotFound},
+		{"Invalid ID", "/public/recipe/abc", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeShareService, groupService := setupRecipeShareTestData()
+			mux := http.NewServeMux()
+			NewRecipeShareHandler(recipeShareService, groupService).RegisterPublicRoutes(mux, "/public/recipe")
+
+			// No user in the context: the route is public
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if strings.Contains(w.Body.String(), "comment") || strings.Contains(w.Body.String(), "group_id") {
+				t.Errorf("expected the private fields to be left out, got %s", w.Body.String())
+			}
+
+			var actual dto.PublicRecipeDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if actual.ID != 1 || actual.Name != "Pancakes" {
+				t.Errorf("unexpected recipe %+v", actual)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCopyRecipe(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		user           *model.User
+		expectedStatus int
+	}{
+		{"Public recipe", "/api/group/1/recipe/copy/1", memberUser, http.StatusCreated},
+		{"Private recipe", "/api/group/1/recipe/copy/2", memberUser, http.StatusNotFound},
+		{"Not a member of the group", "/api/group/1/recipe/copy/1", nonMemberUser, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeShareService, groupService := setupRecipeShareTestData()
+			mux := http.NewServeMux()
+			NewRecipeShareHandler(recipeShareService, groupService).RegisterRoutes(mux, "/api")
+
+			r := newItemRequest(http.MethodPost, tt.target, nil, tt.user, nil)
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var actual dto.RecipeDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if actual.ID != 42 || actual.GroupID != 1 || recipeShareService.lastGroup != 1 {
+				t.Errorf("unexpected copied recipe %+v", actual)
+			}
+		})
+	}
+}
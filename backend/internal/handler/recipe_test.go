@@ -0,0 +1,705 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockRecipeService is a mock implementation of RecipeServiceInterface for testing handler
+type mockRecipeService struct {
+	recipes       []model.Recipe
+	nextID        int64
+	getByGroupErr error
+	createErr     error
+	updateErr     error
+	deleteErr     error
+	lastSearch    string
+	lastDesc      bool
+	lastItemIDs   []int64
+	lastMaxMiss   *int
+}
+
+func (m *mockRecipeService) GetByID(id int64) (*model.Recipe, error) {
+	for i := range m.recipes {
+		if m.recipes[i].ID == id {
+			return &m.recipes[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("recipes", "id", nil)
+}
+
+func (m *mockRecipeService) GetByGroupID(groupID int64, descending bool) ([]model.Recipe, error) {
+	m.lastDesc = descending
+	if m.getByGroupErr != nil {
+		return nil, m.getByGroupErr
+	}
+
+	result := []model.Recipe{}
+	for _, recipe := range m.recipes {
+		if recipe.GroupID == groupID {
+			result = append(result, recipe)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRecipeService) SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.Recipe, error) {
+	m.lastSearch = name
+	m.lastDesc = descending
+
+	result := []model.Recipe{}
+	for _, recipe := range m.recipes {
+		if recipe.GroupID == groupID && strings.Contains(recipe.Name, name) {
+			result = append(result, recipe)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRecipeService) GetByItemID(_ int64, _ bool) ([]model.Recipe, error) {
+	return m.recipes, nil
+}
+
+func (m *mockRecipeService) GetByAvailableItems(groupID int64, itemIDs []int64, maxMissing *int) ([]model.RecipeMatch, error) {
+	m.lastItemIDs = itemIDs
+	m.lastMaxMiss = maxMissing
+	if len(itemIDs) == 0 {
+		return nil, customErrors.NewInvalidParamsError([]string{"item_ids"}, nil)
+	}
+
+	result := []model.RecipeMatch{}
+	for _, recipe := range m.recipes {
+		if recipe.GroupID != groupID {
+			continue
+		}
+		match := model.RecipeMatch{Recipe: recipe, MissingIngredients: []model.Ingredient{}}
+		for _, ingredient := range recipe.Ingredients {
+			if slices.Contains(itemIDs, ingredient.Item.ID) {
+				match.Coverage += 1 / float64(len(recipe.Ingredients))
+			} else {
+				match.MissingIngredients = append(match.MissingIngredients, ingredient)
+			}
+		}
+		if match.Coverage > 0 {
+			result = append(result, match)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRecipeService) Scale(recipe *model.Recipe, servings int) error {
+	if servings <= 0 {
+		return customErrors.NewInvalidParamsError([]string{"servings"}, nil)
+	}
+	if recipe.Servings == nil {
+		return customErrors.NewValidationError("servings", customErrors.RECIPE_SCALE_ERROR, nil)
+	}
+	for i := range recipe.Ingredients {
+		recipe.Ingredients[i].Quantity = new(*recipe.Ingredients[i].Quantity * float64(servings) / float64(*recipe.Servings))
+	}
+	recipe.Servings = &servings
+	return nil
+}
+
+func (m *mockRecipeService) NormalizeUnits(recipe *model.Recipe) error {
+	for i := range recipe.Ingredients {
+		if recipe.Ingredients[i].Unit.Name == "Gram" && *recipe.Ingredients[i].Quantity >= 1000 {
+			recipe.Ingredients[i].Quantity = new(*recipe.Ingredients[i].Quantity / 1000)
+			recipe.Ingredients[i].Unit = model.Unit{ID: 1, Name: "Kilogram"}
+		}
+	}
+	return nil
+}
+
+func (m *mockRecipeService) EstimateCost(recipe *model.Recipe) (*model.CostEstimate, error) {
+	estimate := &model.CostEstimate{Servings: recipe.Servings, UnpricedIngredients: []model.Ingredient{}}
+	for _, ingredient := range recipe.Ingredients {
+		estimate.Cost += *ingredient.Quantity / 100
+	}
+	return estimate, nil
+}
+
+func (m *mockRecipeService) Create(_ context.Context, recipe *model.Recipe) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+	recipe.ID = m.nextID
+	m.nextID++
+	m.recipes = append(m.recipes, *recipe)
+	return recipe.ID, nil
+}
+
+func (m *mockRecipeService) Update(_ context.Context, recipe *model.Recipe) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for i := range m.recipes {
+		if m.recipes[i].ID == recipe.ID {
+			m.recipes[i] = *recipe
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("recipes", "id", nil)
+}
+
+func (m *mockRecipeService) Delete(_ context.Context, id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+	for i := range m.recipes {
+		if m.recipes[i].ID == id {
+			m.recipes = append(m.recipes[:i], m.recipes[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("recipes", "id", nil)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupRecipeTestData() (*mockRecipeService, *mockGroupService) {
+	recipeService := &mockRecipeService{
+		recipes: []model.Recipe{
+			{
+				ID:         1,
+				Name:       "Cookies",
+				Servings:   new(4),
+				GroupID:    1,
+				Categories: []model.RecipeCategory{{ID: 1, Name: "DESSERT"}},
+				Ingredients: []model.Ingredient{
+					{ID: 1, Quantity: new(250.0), Item: model.Item{ID: 1, Name: "Flour"}, Unit: model.Unit{ID: 2, Name: "Gram"}},
+				},
+			},
+			{ID: 2, Name: "Chocolate cake", GroupID: 1},
+			{ID: 3, Name: "Tomato soup", GroupID: 2},
+		},
+		nextID: 4,
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return recipeService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewRecipeHandler(t *testing.T) {
+	recipeService, groupService := setupRecipeTestData()
+	handler := NewRecipeHandler(recipeService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.recipeService != recipeService {
+		t.Error("handler recipeService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetRecipes(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		groupID        int64
+		getByGroupErr  error
+		expectedStatus int
+		expectedIDs    []int64
+		expectedSearch string
+		expectedDesc   bool
+	}{
+		{
+			name:           "All recipes of the group",
+			target:         "/recipe?desc=true",
+			groupID:        1,
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []int64{1, 2},
+			expectedDesc:   true,
+		},
+		{
+			name:           "Search by name within the group",
+			target:         "/recipe?name=o",
+			groupID:        2,
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []int64{3},
+			expectedSearch: "o",
+		},
+		{
+			name:           "Invalid desc parameter",
+			target:         "/recipe?desc=maybe",
+			groupID:        1,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Service error",
+			target:         "/recipe",
+			groupID:        1,
+			getByGroupErr:  customErrors.NewInternalError(customErrors.FETCH_RECIPES_ERROR, nil),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeService, groupService := setupRecipeTestData()
+			recipeService.getByGroupErr = tt.getByGroupErr
+			handler := NewRecipeHandler(recipeService, groupService)
+
+			r := newItemRequest(http.MethodGet, tt.target, nil, memberUser, map[string]int64{"groupId": tt.groupID})
+			w := httptest.NewRecorder()
+
+			handler.getRecipes(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			contentType := w.Header().Get(constant.CONTENT_TYPE_HEADER)
+			if contentType != constant.CONTENT_TYPE_VALUE {
+				t.Errorf("expected content type %s instead of %s", constant.CONTENT_TYPE_VALUE, contentType)
+			}
+
+			var actual []dto.RecipeSummaryDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d recipes instead of %d", len(tt.expectedIDs), len(actual))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected recipe %d instead of %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+
+			if recipeService.lastSearch != tt.expectedSearch || recipeService.lastDesc != tt.expectedDesc {
+				t.Errorf("expected search %q and desc %v instead of %q and %v",
+					tt.expectedSearch, tt.expectedDesc, recipeService.lastSearch, recipeService.lastDesc)
+			}
+		})
+	}
+}
+
+func TestGetCookableRecipes(t *testing.T) {
+	tests := []struct {
+		name               string
+		target             string
+		expectedStatus     int
+		expectedItemIDs    []int64
+		expectedMaxMissing *int
+		expectedIDs        []int64
+	}{
+		{"Available items", "/recipe/cookable?item_ids=1,%205", http.StatusOK, []int64{1, 5}, nil, []int64{1}},
+		{"Maximum missing ingredients", "/recipe/cookable?item_ids=5&max_missing=2", http.StatusOK, []int64{5}, new(2), []int64{}},
+		{"Invalid item IDs", "/recipe/cookable?item_ids=1,flour", http.StatusBadRequest, nil, nil, nil},
+		{"Invalid max missing", "/recipe/cookable?item_ids=1&max_missing=many", http.StatusBadRequest, nil, nil, nil},
+		{"No item", "/recipe/cookable", http.StatusBadRequest, []int64{}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeService, groupService := setupRecipeTestData()
+			handler := NewRecipeHandler(recipeService, groupService)
+
+			r := newItemRequest(http.MethodGet, tt.target, nil, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.getCookableRecipes(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if !reflect.DeepEqual(recipeService.lastItemIDs, tt.expectedItemIDs) || !reflect.DeepEqual(recipeService.lastMaxMiss, tt.expectedMaxMissing) {
+				t.Errorf("expected items %v and max missing %v instead of %v and %v",
+					tt.expectedItemIDs, tt.expectedMaxMissing, recipeService.lastItemIDs, recipeService.lastMaxMiss)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual []dto.RecipeMatchDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d recipes instead of %d", len(tt.expectedIDs), len(actual))
+			}
+			for i := range actual {
+				if actual[i].Recipe.ID != tt.expectedIDs[i] || actual[i].Coverage != 1 || len(actual[i].MissingIngredients) != 0 {
+					t.Errorf("unexpected recipe match %+v", actual[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetRecipeByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		groupID        int64
+		recipeID       int64
+		expectedStatus int
+	}{
+		{"Existing recipe of the group", 1, 1, http.StatusOK},
+		{"Unknown recipe", 1, -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeService, groupService := setupRecipeTestData()
+			handler := NewRecipeHandler(recipeService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/recipe", nil, memberUser, map[string]int64{"groupId": tt.groupID, "id": tt.recipeID})
+			w := httptest.NewRecorder()
+
+			handler.getRecipeByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.RecipeDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != tt.recipeID || len(actual.Categories) != 1 || len(actual.Ingredients) != 1 {
+				t.Errorf("unexpected recipe %+v", actual)
+			}
+
+			if actual.Ingredients[0].Item.Name != "Flour" || actual.Ingredients[0].Unit.Name != "Gram" {
+				t.Errorf("unexpected ingredient %+v", actual.Ingredients[0])
+			}
+		})
+	}
+}
+
+func TestGetScaledRecipe(t *testing.T) {
+	tests := []struct {
+		name             string
+		recipeID         int64
+		query            string
+		expectedStatus   int
+		expectedServings int
+		expectedQuantity float64
+		expectedUnit     string
+	}{
+		{"Doubled servings", 1, "?servings=8", http.StatusOK, 8, 500, "Gram"},
+		{"Normalized quantities", 1, "?servings=16&normalize=true", http.StatusOK, 16, 1, "Kilogram"},
+		{"Normalized without scaling", 1, "?normalize=1", http.StatusOK, 4, 250, "Gram"},
+		{"Invalid servings", 1, "?servings=many", http.StatusBadRequest, 0, 0, ""},
+		{"Zero servings", 1, "?servings=0", http.StatusBadRequest, 0, 0, ""},
+		{"Invalid normalize", 1, "?normalize=maybe", http.StatusBadRequest, 0, 0, ""},
+		{"Recipe without servings", 2, "?servings=2", http.StatusBadRequest, 0, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeService, groupService := setupRecipeTestData()
+			handler := NewRecipeHandler(recipeService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/recipe"+tt.query, nil, memberUser, map[string]int64{"groupId": 1, "id": tt.recipeID})
+			w := httptest.NewRecorder()
+
+			handler.getRecipeByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.RecipeDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.Servings == nil || *actual.Servings != tt.expectedServings {
+				t.Errorf("expected %d servings, got %v", tt.expectedServings, actual.Servings)
+			}
+
+			ingredient := actual.Ingredients[0]
+			if *ingredient.Quantity != tt.expectedQuantity || ingredient.Unit.Name != tt.expectedUnit {
+				t.Errorf("expected %v %s, got %v %s", tt.expectedQuantity, tt.expectedUnit, *ingredient.Quantity, ingredient.Unit.Name)
+			}
+		})
+	}
+}
+
+func TestGetRecipeCost(t *testing.T) {
+	tests := []struct {
+		name                   string
+		recipeID               int64
+		query                  string
+		expectedStatus         int
+		expectedCost           float64
+		expectedCostPerServing *float64
+	}{
+		{"Recipe servings", 1, "", http.StatusOK, 2.5, new(0.625)},
+		{"Doubled servings", 1, "?servings=8", http.StatusOK, 5, new(0.625)},
+		{"Recipe without servings", 2, "", http.StatusOK, 0, nil},
+		{"Invalid servings", 1, "?servings=many", http.StatusBadRequest, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeService, groupService := setupRecipeTestData()
+			handler := NewRecipeHandler(recipeService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/recipe/cost"+tt.query, nil, memberUser, map[string]int64{"groupId": 1, "id": tt.recipeID})
+			w := httptest.NewRecorder()
+
+			handler.getRecipeCost(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.CostEstimateDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.Cost != tt.expectedCost || !reflect.DeepEqual(actual.CostPerServing, tt.expectedCostPerServing) {
+				t.Errorf("expected a cost of %v and %v per serving, got %+v", tt.expectedCost, tt.expectedCostPerServing, actual)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateRecipe(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewRecipeDto{
+		Name:        "Pancakes",
+		Servings:    new(4),
+		CategoryIDs: []int64{1},
+		Ingredients: []dto.NewIngredientDto{{Quantity: new(250.0), ItemID: 1, UnitID: 2}},
+	})
+
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid recipe", validBody, nil, http.StatusCreated},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Validation error", validBody, customErrors.NewInvalidParamsError([]string{"name"}, nil), http.StatusBadRequest},
+		{"Item of another group", validBody, customErrors.NewConflictError("Item", "item must belongs to the same group as the recipe", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeService, groupService := setupRecipeTestData()
+			recipeService.createErr = tt.createErr
+			handler := NewRecipeHandler(recipeService, groupService)
+			recipesNb := len(recipeService.recipes)
+
+			r := newItemRequest(http.MethodPost, "/recipe", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.createRecipe(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				if len(recipeService.recipes) != recipesNb {
+					t.Errorf("expected %d recipes instead of %d", recipesNb, len(recipeService.recipes))
+				}
+				return
+			}
+
+			var result map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			created, err := recipeService.GetByID(result["id"])
+			if err != nil {
+				t.Fatalf("failed to retrieve created recipe: %v", err)
+			}
+
+			if created.GroupID != 1 {
+				t.Errorf("expected created recipe to belong to group 1 instead of %d", created.GroupID)
+			}
+
+			if len(created.Ingredients) != 1 || created.Ingredients[0].Item.ID != 1 || created.Ingredients[0].Unit.ID != 2 {
+				t.Errorf("unexpected ingredients %+v", created.Ingredients)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdateRecipe(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewRecipeDto{
+		Name:        "Cookies with chocolate",
+		Ingredients: []dto.NewIngredientDto{{ID: 1, Quantity: new(300.0), ItemID: 1, UnitID: 2}},
+	})
+
+	tests := []struct {
+		name           string
+		recipeID       int64
+		body           []byte
+		updateErr      error
+		expectedStatus int
+	}{
+		{"Valid update", 1, validBody, nil, http.StatusNoContent},
+		{"Invalid body", 1, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Ingredient of another recipe", 1, validBody, customErrors.NewConflictError("Ingredient", "ingredient must belongs to the recipe", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeService, groupService := setupRecipeTestData()
+			recipeService.updateErr = tt.updateErr
+			handler := NewRecipeHandler(recipeService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/recipe", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.recipeID})
+			w := httptest.NewRecorder()
+
+			handler.updateRecipe(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, _ := recipeService.GetByID(tt.recipeID)
+			if updated.Name != "Cookies with chocolate" || updated.GroupID != 1 {
+				t.Errorf("unexpected updated recipe %+v", updated)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeleteRecipe(t *testing.T) {
+	tests := []struct {
+		name           string
+		recipeID       int64
+		deleteErr      error
+		expectedStatus int
+	}{
+		{"Existing recipe", 1, nil, http.StatusNoContent},
+		{"Service error", 1, customErrors.NewInternalError("failed to delete recipe", nil), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipeService, groupService := setupRecipeTestData()
+			recipeService.deleteErr = tt.deleteErr
+			handler := NewRecipeHandler(recipeService, groupService)
+			recipesNb := len(recipeService.recipes)
+
+			r := newItemRequest(http.MethodDelete, "/recipe", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.recipeID})
+			w := httptest.NewRecorder()
+
+			handler.deleteRecipe(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusNoContent && len(recipeService.recipes) != recipesNb-1 {
+				t.Errorf("expected %d recipes instead of %d", recipesNb-1, len(recipeService.recipes))
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestRecipeRegisterRoutes(t *testing.T) {
+	recipeService, groupService := setupRecipeTestData()
+	handler := NewRecipeHandler(recipeService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/recipe")
+
+	r := httptest.NewRequest(http.MethodGet, "/api/group/1/recipe/1", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/recipe/1 instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/recipe/cookable?item_ids=1", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/recipe/cookable instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/recipe", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/recipe as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodDelete, "/api/group/1/recipe/abc", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for DELETE /api/group/1/recipe/abc instead of %d", http.StatusBadRequest, w.Code)
+	}
+
+	// Recipes of another group are reported as not found on every route.
+	assertRoutesStatus(t, mux, memberUser, []string{
+		"GET /api/group/1/recipe/3",
+		"GET /api/group/1/recipe/3/cost",
+		"PUT /api/group/1/recipe/3",
+		"DELETE /api/group/1/recipe/3",
+	}, http.StatusNotFound)
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// SearchHandler handles the full-text search requests of a group.
+type SearchHandler struct {
+	searchService service.SearchServiceInterface
+	groupService  service.GroupServiceInterface
+}
+
+// NewSearchHandler constructs a new SearchHandler with the provided services.
+func NewSearchHandler(searchService service.SearchServiceInterface, groupService service.GroupServiceInterface) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+		groupService:  groupService,
+	}
+}
+
+// RegisterRoutes registers the search route on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; the route is restricted to the members of the group.
+func (h *SearchHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.search)))
+}
+
+// Search godoc
+// @Summary Search recipes and items
+// @Description Search the recipes of a group by name, description, instructions and comment, and its items by name and description.
+// @Description Every word of the query must match, as a prefix and regardless of accents and case. Hits are sorted from the most to the least relevant,
+// @Description names weighing more than the other texts. Their name and snippet are HTML escaped, the matching terms being wrapped in <mark> elements.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param q query string true "Searched words"
+// @Param limit query int false "Maximum number of hits, between 1 and 100, 20 by default"
+// @Success 200 {array} dto.SearchHitDto
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/search [get]
+func (h *SearchHandler) search(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	limit, err := optionalIntQueryParam(r, "limit")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if limit == nil {
+		limit = new(constant.DEFAULT_SEARCH_LIMIT)
+	}
+
+	hits, err := h.searchService.Search(groupID, r.URL.Query().Get("q"), *limit)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(hits, mapper.ToSearchHitDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_SEARCH_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
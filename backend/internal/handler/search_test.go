@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// mockSearchService is a mock implementation of SearchServiceInterface for testing handler
+type mockSearchService struct {
+	hits      []model.SearchHit
+	searchErr error
+	lastGroup int64
+	lastText  string
+	lastLimit int
+}
+
+func (m *mockSearchService) Search(groupID int64, text string, limit int) ([]model.SearchHit, error) {
+	m.lastGroup = groupID
+	m.lastText = text
+	m.lastLimit = limit
+	if m.searchErr != nil {
+		return nil, m.searchErr
+	}
+	return m.hits, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupSearchTestData() (*mockSearchService, *mockGroupService) {
+	searchService := &mockSearchService{
+		hits: []model.SearchHit{
+			{Kind: model.SearchHitItem, ID: 8, Name: "\x02Tomatoes\x03", Snippet: "\x02Tomatoes\x03", Rank: -4.6},
+			{Kind: model.SearchHitRecipe, ID: 3, Name: "\x02Tomato\x03 Soup", Snippet: "<b>\x02Tomato\x03</b> Soup", Rank: -1.7},
+		},
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return searchService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewSearchHandler(t *testing.T) {
+	searchService, groupService := setupSearchTestData()
+	handler := NewSearchHandler(searchService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.searchService != searchService {
+		t.Error("handler searchService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		searchErr      error
+		expectedStatus int
+		expectedText   string
+		expectedLimit  int
+	}{
+		{"Default limit", "/search?q=tomato", nil, http.StatusOK, "tomato", constant.DEFAULT_SEARCH_LIMIT},
+		{"Provided limit", "/search?q=tomato+soup&limit=5", nil, http.StatusOK, "tomato soup", 5},
+		{"Invalid limit", "/search?q=tomato&limit=many", nil, http.StatusBadRequest, "", 0},
+		{"Invalid query", "/search?q=", customErrors.NewInvalidParamsError([]string{"q"}, nil), http.StatusBadRequest, "", 0},
+		{"Service error", "/search?q=tomato", customErrors.NewInternalError("failed to search recipes and items", nil), http.StatusInternalServerError, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			searchService, groupService := setupSearchTestData()
+			searchService.searchErr = tt.searchErr
+			handler := NewSearchHandler(searchService, groupService)
+
+			r := newItemRequest(http.MethodGet, tt.target, nil, memberUser, map[string]int64{"groupId": 2})
+			w := httptest.NewRecorder()
+
+			handler.search(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			contentType := w.Header().Get(constant.CONTENT_TYPE_HEADER)
+			if contentType != constant.CONTENT_TYPE_VALUE {
+				t.Errorf("expected content type %s instead of %s", constant.CONTENT_TYPE_VALUE, contentType)
+			}
+
+			if searchService.lastGroup != 2 || searchService.lastText != tt.expectedText || searchService.lastLimit != tt.expectedLimit {
+				t.Errorf("expected search of %q limited to %d in group 2 instead of %q limited to %d in group %d",
+					tt.expectedText, tt.expectedLimit, searchService.lastText, searchService.lastLimit, searchService.lastGroup)
+			}
+
+			var actual []dto.SearchHitDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(actual) != 2 || actual[0].Kind != "item" || actual[1].Kind != "recipe" {
+				t.Fatalf("unexpected hits %+v", actual)
+			}
+
+			if actual[1].Name != "<mark>Tomato</mark> Soup" || actual[1].Snippet != "&lt;b&gt;<mark>Tomato</mark>&lt;/b&gt; Soup" {
+				t.Errorf("unexpected highlighted hit %+v", actual[1])
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestSearchRegisterRoutes(t *testing.T) {
+	searchService, groupService := setupSearchTestData()
+	handler := NewSearchHandler(searchService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/search")
+
+	r := httptest.NewRequest(http.MethodGet, "/api/group/1/search?q=tomato", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/search instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/search?q=tomato", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/search as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/abc/search?q=tomato", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for GET /api/group/abc/search instead of %d", http.StatusBadRequest, w.Code)
+	}
+}
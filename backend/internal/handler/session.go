@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// SessionHandler handles HTTP requests managing the sessions of the users.
+type SessionHandler struct {
+	sessionService service.SessionServiceInterface
+}
+
+// NewSessionHandler constructs a new SessionHandler with the provided SessionService.
+func NewSessionHandler(sessionService service.SessionServiceInterface) *SessionHandler {
+	return &SessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+// RegisterRoutes registers the session-related routes on the provided ServeMux with the given prefix.
+// Forcing the logout of another user is restricted to the admins of the application.
+// The sessions can only be managed from a session, not with an API token.
+func (h *SessionHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	mux.Handle("GET "+prefix+"/me/sessions", middleware.NoAPIToken(http.HandlerFunc(h.getSessions)))
+	mux.Handle("DELETE "+prefix+"/me/sessions", middleware.NoAPIToken(http.HandlerFunc(h.revokeOtherSessions)))
+	mux.Handle("DELETE "+prefix+"/me/sessions/{id}", middleware.NoAPIToken(http.HandlerFunc(h.revokeSession)))
+	mux.Handle("DELETE "+prefix+"/{id}/sessions", middleware.Stack(middleware.NoAPIToken, middleware.AppAdmin, middleware.IntPathValues("id"))(http.HandlerFunc(h.logoutUser)))
+}
+
+// GetSessions godoc
+// @Summary Get the sessions of the authenticated user
+// @Description Get the active sessions of the authenticated user, the most recently active first, with the current one marked
+// @Tags session
+// @Produce json
+// @Success 200 {array} dto.SessionDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/user/me/sessions [get]
+func (h *SessionHandler) getSessions(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	sessions, err := h.sessionService.GetByUserID(u.ID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	currentID := ""
+	if current := requestSession(r); current != nil {
+		currentID = current.ID
+	}
+
+	sessionDtos := make([]*dto.SessionDto, len(sessions))
+	for i := range sessions {
+		sessionDtos[i] = mapper.ToSessionDto(&sessions[i], currentID)
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err := json.NewEncoder(w).Encode(sessionDtos); err != nil {
+		http.Error(w, customErrors.SERIALIZE_SESSION_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// RevokeSession godoc
+// @Summary Revoke a session of the authenticated user
+// @Description Log the authenticated user out of one of their sessions; revoking the current session logs the user out
+// @Tags session
+// @Param id path string true "Session ID, as listed by GET /api/user/me/sessions"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Session not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/user/me/sessions/{id} [delete]
+func (h *SessionHandler) revokeSession(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.sessionService.Revoke(u.ID, r.PathValue("id"), requestSession(r)); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RevokeOtherSessions godoc
+// @Summary Revoke the other sessions of the authenticated user
+// @Description Log the authenticated user out of all their sessions but the current one
+// @Tags session
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/user/me/sessions [delete]
+func (h *SessionHandler) revokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	u, err := sessionUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.sessionService.RevokeOthers(u.ID, requestSession(r)); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// LogoutUser godoc
+// @Summary Force the logout of a user
+// @Description Log a user out of all their sessions; only an admin of the application can do so
+// @Tags session
+// @Param id path int true "User ID"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/user/{id}/sessions [delete]
+func (h *SessionHandler) logoutUser(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("id").(int64)
+
+	// An admin logging themselves out keeps the current session, under a fresh ID.
+	if err := h.sessionService.Invalidate(userID, requestSession(r)); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// requestSession returns the session of the request, as injected by the middleware.SessionInjector middleware, or nil.
+func requestSession(r *http.Request) *model.Session {
+	session, _ := r.Context().Value(ctx.SessionCtxKey{}).(*model.Session)
+	return session
+}
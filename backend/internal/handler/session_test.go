@@ -0,0 +1,390 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// MockSessionService is a mock implementation of SessionService for testing handlers.
+type MockSessionService struct {
+	sessions      []model.Session
+	getErr        error
+	invalidated   []int64
+	invalidateErr error
+	revoked       []string
+	revokedOthers []int64
+	revokeErr     error
+}
+
+func (m *MockSessionService) GetSession(_ *http.Request) *model.Session {
+	return model.NewSession("", "")
+}
+
+func (m *MockSessionService) CookieName() string {
+	return "session_id"
+}
+
+func (m *MockSessionService) Expiration() time.Duration {
+	return time.Hour
+}
+
+func (m *MockSessionService) Save(_ *model.Session) error {
+	return nil
+}
+
+func (m *MockSessionService) Remove(_ *model.Session) error {
+	return nil
+}
+
+func (m *MockSessionService) Rotate(_ *model.Session) error {
+	return nil
+}
+
+func (m *MockSessionService) Invalidate(userID int64, _ *model.Session) error {
+	if m.invalidateErr != nil {
+		return m.invalidateErr
+	}
+	m.invalidated = append(m.invalidated, userID)
+	return nil
+}
+
+func (m *MockSessionService) GetByUserID(userID int64) ([]model.Session, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+
+	sessions := []model.Session{}
+	for _, s := range m.sessions {
+		if s.UserID != nil && *s.UserID == userID {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions, nil
+}
+
+func (m *MockSessionService) Revoke(userID int64, id string, _ *model.Session) error {
+	if m.revokeErr != nil {
+		return m.revokeErr
+	}
+
+	sessions, _ := m.GetByUserID(userID)
+	for _, s := range sessions {
+		if utils.HashToken(s.ID) == id {
+			m.revoked = append(m.revoked, s.ID)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("sessions", id, nil)
+}
+
+func (m *MockSessionService) RevokeOthers(userID int64, _ *model.Session) error {
+	if m.revokeErr != nil {
+		return m.revokeErr
+	}
+	m.revokedOthers = append(m.revokedOthers, userID)
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// setupSessionTestData returns a mock service holding two sessions of memberUser and one of another user.
+func setupSessionTestData() *MockSessionService {
+	return &MockSessionService{
+		sessions: []model.Session{
+			{ID: "current", IPAddress: "192.0.2.1:1234", UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0", UserID: new(memberUser.ID)},
+			{ID: "other", IPAddress: "192.0.2.2:1234", UserAgent: "curl/8.5.0", UserID: new(memberUser.ID)},
+			{ID: "another-user", IPAddress: "192.0.2.3:1234", UserID: new(int64(2))},
+		},
+	}
+}
+
+// serveSessionRequest routes a request of the user, within the given session, through the routes of a SessionHandler.
+func serveSessionRequest(sessionService service.SessionServiceInterface, method, target string, user *model.User, session *model.Session) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	NewSessionHandler(sessionService).RegisterRoutes(mux, "/api/user")
+
+	r := httptest.NewRequest(method, target, nil)
+	c := context.WithValue(r.Context(), ctx.UserCtxKey{}, user)
+	c = context.WithValue(c, ctx.SessionCtxKey{}, session)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, r.WithContext(c))
+	return w
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewSessionHandler(t *testing.T) {
+	service := &MockSessionService{}
+	handler := NewSessionHandler(service)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.sessionService != service {
+		t.Error("handler sessionService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetSessions_Success(t *testing.T) {
+	service := setupSessionTestData()
+
+	w := serveSessionRequest(service, http.MethodGet, "/api/user/me/sessions", memberUser, &service.sessions[0])
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d instead of %d", http.StatusOK, w.Code)
+	}
+
+	var actual []dto.SessionDto
+	if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 sessions instead of %d", len(actual))
+	}
+	if actual[0].ID != utils.HashToken("current") || !actual[0].Current {
+		t.Errorf("expected the first session to be the current one, got %+v", actual[0])
+	}
+	if actual[0].Label != "Firefox on Linux" || actual[0].IPAddress != "192.0.2.1" {
+		t.Errorf("unexpected label or IP address of the current session: %+v", actual[0])
+	}
+	if actual[1].Current {
+		t.Error("expected the second session not to be the current one")
+	}
+}
+
+func TestGetSessions_Unauthorized(t *testing.T) {
+	w := serveSessionRequest(setupSessionTestData(), http.MethodGet, "/api/user/me/sessions", nil, nil)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d instead of %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestGetSessions_ServiceError(t *testing.T) {
+	service := setupSessionTestData()
+	service.getErr = customErrors.NewInternalError("failed to fetch sessions", nil)
+
+	w := serveSessionRequest(service, http.MethodGet, "/api/user/me/sessions", memberUser, &service.sessions[0])
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d instead of %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestRevokeSession(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		expectedStatus int
+	}{
+		{"Own session", utils.HashToken("other"), http.StatusNoContent},
+		{"Session of another user", utils.HashToken("another-user"), http.StatusNotFound},
+		{"Unknown session", "unknown", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setupSessionTestData()
+
+			w := serveSessionRequest(service, http.MethodDelete, "/api/user/me/sessions/"+tt.id, memberUser, &service.sessions[0])
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+			if tt.expectedStatus == http.StatusNoContent && (len(service.revoked) != 1 || service.revoked[0] != "other") {
+				t.Errorf("expected session %q to be revoked, got %v", "other", service.revoked)
+			}
+		})
+	}
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	service := setupSessionTestData()
+
+	w := serveSessionRequest(service, http.MethodDelete, "/api/user/me/sessions", memberUser, &service.sessions[0])
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d instead of %d", http.StatusNoContent, w.Code)
+	}
+	if len(service.revokedOthers) != 1 || service.revokedOthers[0] != memberUser.ID {
+		t.Errorf("expected the other sessions of user %d to be revoked, got %v", memberUser.ID, service.revokedOthers)
+	}
+}
+
+func TestRevokeOtherSessions_ServiceError(t *testing.T) {
+	service := setupSessionTestData()
+	service.revokeErr = customErrors.NewInternalError("failed to delete sessions", nil)
+
+	w := serveSessionRequest(service, http.MethodDelete, "/api/user/me/sessions", memberUser, &service.sessions[0])
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d instead of %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestLogoutUser(t *testing.T) {
+	admin := &model.User{ID: 3, Username: "admin", AppAdmin: true}
+
+	tests := []struct {
+		name           string
+		user           *model.User
+		target         string
+		invalidateErr  error
+		expectedStatus int
+	}{
+		{"Admin", admin, "/api/user/2/sessions", nil, http.StatusNoContent},
+		{"Not an admin", memberUser, "/api/user/2/sessions", nil, http.StatusForbidden},
+		{"Invalid ID", admin, "/api/user/abc/sessions", nil, http.StatusBadRequest},
+		{"Service error", admin, "/api/user/2/sessions", customErrors.NewInternalError("failed to delete sessions", nil), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setupSessionTestData()
+			service.invalidateErr = tt.invalidateErr
+
+			w := serveSessionRequest(service, http.MethodDelete, tt.target, tt.user, nil)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+			if tt.expectedStatus == http.StatusNoContent && (len(service.invalidated) != 1 || service.invalidated[0] != 2) {
+				t.Errorf("expected the sessions of user 2 to be invalidated, got %v", service.invalidated)
+			}
+		})
+	}
+}
+
+func TestSessionRoutes_APIToken(t *testing.T) {
+	admin := &model.User{ID: 3, Username: "admin", AppAdmin: true}
+
+	tests := []struct {
+		method string
+		target string
+	}{
+		{http.MethodGet, "/api/user/me/sessions"},
+		{http.MethodDelete, "/api/user/me/sessions"},
+		{http.MethodDelete, "/api/user/me/sessions/" + utils.HashToken("other")},
+		{http.MethodDelete, "/api/user/2/sessions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.target, func(t *testing.T) {
+			service := setupSessionTestData()
+			mux := http.NewServeMux()
+			NewSessionHandler(service).RegisterRoutes(mux, "/api/user")
+
+			r := httptest.NewRequest(tt.method, tt.target, nil)
+			c := context.WithValue(r.Context(), ctx.UserCtxKey{}, admin)
+			c = context.WithValue(c, ctx.APITokenCtxKey{}, &model.APIToken{ID: 2, UserID: admin.ID})
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, r.WithContext(c))
+
+			if w.Code != http.StatusForbidden {
+				t.Fatalf("expected status %d instead of %d", http.StatusForbidden, w.Code)
+			}
+			if len(service.revoked) != 0 || len(service.revokedOthers) != 0 || len(service.invalidated) != 0 {
+				t.Error("expected no session to be revoked")
+			}
+		})
+	}
+}
+
+// TestRevokeSession_RequestInFlight tests that a session revoked while one of its requests is still being served
+// isn't saved back once the request ends.
+func TestRevokeSession_RequestInFlight(t *testing.T) {
+	admin := &model.User{ID: 2, Username: "testuser2", AppAdmin: true}
+	user := &model.User{ID: 1, Username: "testuser1"}
+
+	tests := []struct {
+		name   string
+		revoke func(sessionService service.SessionServiceInterface, revoked, current *model.Session) *httptest.ResponseRecorder
+	}{
+		{"Revoke the session", func(sessionService service.SessionServiceInterface, revoked, current *model.Session) *httptest.ResponseRecorder {
+			return serveSessionRequest(sessionService, http.MethodDelete, "/api/user/me/sessions/"+utils.HashToken(revoked.ID), user, current)
+		}},
+		{"Revoke the other sessions", func(sessionService service.SessionServiceInterface, _, current *model.Session) *httptest.ResponseRecorder {
+			return serveSessionRequest(sessionService, http.MethodDelete, "/api/user/me/sessions", user, current)
+		}},
+		{"Log the user out", func(sessionService service.SessionServiceInterface, _, _ *model.Session) *httptest.ResponseRecorder {
+			return serveSessionRequest(sessionService, http.MethodDelete, "/api/user/1/sessions", admin, nil)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			// Each connection would open its own in-memory database
+			db.SetMaxOpenConns(1)
+
+			sessionService := service.NewSessionService(repository.NewSessionRepository(db), repository.NewAPITokenRepository(db), "session_id", time.Hour)
+
+			// Two sessions of the user, stored as on login
+			revoked, current := model.NewSession("", ""), model.NewSession("", "")
+			for _, session := range []*model.Session{revoked, current} {
+				session.UserID = new(user.ID)
+				if err := sessionService.Rotate(session); err != nil {
+					t.Fatalf("failed to store session: %v", err)
+				}
+			}
+
+			// A request of the revoked session is held until the session is revoked
+			started, release := make(chan struct{}), make(chan struct{})
+			var saves sync.WaitGroup
+			inFlight := middleware.SessionInjector(sessionService, &saves)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				close(started)
+				<-release
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/api/recipe", nil)
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: revoked.ID})
+			served := make(chan struct{})
+			go func() {
+				inFlight.ServeHTTP(httptest.NewRecorder(), r)
+				close(served)
+			}()
+			<-started
+
+			if w := tt.revoke(sessionService, revoked, current); w.Code != http.StatusNoContent {
+				t.Fatalf("expected status %d instead of %d", http.StatusNoContent, w.Code)
+			}
+
+			close(release)
+			<-served
+			saves.Wait()
+
+			sessions, err := sessionService.GetByUserID(user.ID)
+			if err != nil {
+				t.Fatalf("failed to fetch the sessions of the user: %v", err)
+			}
+			for _, session := range sessions {
+				if session.ID == revoked.ID {
+					t.Error("expected the revoked session to stay revoked once its request ended")
+				}
+			}
+		})
+	}
+}
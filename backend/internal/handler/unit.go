@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// UnitHandler handles HTTP requests related to the units available to a group:
+// the shared catalogue and the custom units of the group.
+type UnitHandler struct {
+	unitService  service.UnitServiceInterface
+	groupService service.GroupServiceInterface
+}
+
+// NewUnitHandler constructs a new UnitHandler with the provided services.
+func NewUnitHandler(unitService service.UnitServiceInterface, groupService service.GroupServiceInterface) *UnitHandler {
+	return &UnitHandler{
+		unitService:  unitService,
+		groupService: groupService,
+	}
+}
+
+// RegisterRoutes registers the unit-related routes on the provided ServeMux with the given prefix.
+// The prefix must contain the {groupId} path value; every route is restricted to the members of the group.
+func (h *UnitHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
+	member := middleware.GroupMember(h.groupService, middleware.GroupFromPath("groupId"))
+	groupScoped := middleware.Stack(middleware.IntPathValues("groupId"), member)
+	unitMember := middleware.GroupMember(h.groupService, middleware.GroupFromResource("units", "id", h.unitGroup))
+	unitScoped := middleware.Stack(middleware.IntPathValues("groupId", "id"), member, unitMember)
+
+	mux.Handle("GET "+prefix, groupScoped(http.HandlerFunc(h.getUnits)))
+	mux.Handle("GET "+prefix+"/convert", groupScoped(http.HandlerFunc(h.convertQuantity)))
+	mux.Handle("GET "+prefix+"/{id}", unitScoped(http.HandlerFunc(h.getUnitByID)))
+	mux.Handle("POST "+prefix, groupScoped(http.HandlerFunc(h.createUnit)))
+	mux.Handle("PUT "+prefix+"/{id}", unitScoped(http.HandlerFunc(h.updateUnit)))
+	mux.Handle("DELETE "+prefix+"/{id}", unitScoped(http.HandlerFunc(h.deleteUnit)))
+}
+
+// GetUnits godoc
+// @Summary Get units
+// @Description Get the units of the catalogue and the custom units of a group, sorted by unit type and size
+// @Tags unit
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Success 200 {array} dto.UnitDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/unit [get]
+func (h *UnitHandler) getUnits(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	units, err := h.unitService.GetByGroupID(groupID)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.MapList(units, mapper.ToUnitDto)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_UNIT_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetUnitByID godoc
+// @Summary Get unit by ID
+// @Description Get a unit of the catalogue or a custom unit of a group by its ID
+// @Tags unit
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Unit ID"
+// @Success 200 {object} dto.UnitDto
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Unit not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/unit/{id} [get]
+func (h *UnitHandler) getUnitByID(w http.ResponseWriter, r *http.Request) {
+	unit, err := h.unitService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(mapper.ToUnitDto(unit)); err != nil {
+		http.Error(w, customErrors.SERIALIZE_UNIT_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// ConvertQuantity godoc
+// @Summary Convert a quantity
+// @Description Convert a quantity from a unit to another one of the same unit type
+// @Tags unit
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param quantity query number true "Quantity to convert"
+// @Param from query int true "ID of the unit of the quantity"
+// @Param to query int true "ID of the unit to convert the quantity to"
+// @Success 200 {object} dto.UnitConversionDto
+// @Failure 400 {string} string "Bad request: invalid parameters or units of different types"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Unit not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/unit/convert [get]
+func (h *UnitHandler) convertQuantity(w http.ResponseWriter, r *http.Request) {
+	conversion, err := h.convert(r)
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	if err = json.NewEncoder(w).Encode(conversion); err != nil {
+		http.Error(w, customErrors.SERIALIZE_UNIT_ERROR, http.StatusInternalServerError)
+		return
+	}
+}
+
+// CreateUnit godoc
+// @Summary Create a new custom unit
+// @Description Create a new custom unit in a group; its name can't be used by the catalogue or another unit of the group, whatever its case
+// @Tags unit
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param unit body dto.NewUnitDto true "New Unit Data"
+// @Success 201 {object} map[string]int "Returns the new unit ID"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 409 {string} string "Conflict: name already used"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/unit [post]
+func (h *UnitHandler) createUnit(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	var newUnitDto dto.NewUnitDto
+	if err := json.NewDecoder(r.Body).Decode(&newUnitDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.unitService.Create(mapper.FromNewUnitDtoToUnit(&newUnitDto, groupID))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"id": %d}`, id)
+}
+
+// UpdateUnit godoc
+// @Summary Update a custom unit
+// @Description Replace the name, factor and unit type of a custom unit of a group; the units of the catalogue can't be updated
+// @Tags unit
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Unit ID"
+// @Param unit body dto.NewUnitDto true "Unit Data to Update"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden: unit of the catalogue"
+// @Failure 404 {string} string "Unit not found"
+// @Failure 409 {string} string "Conflict: name already used"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/unit/{id} [put]
+func (h *UnitHandler) updateUnit(w http.ResponseWriter, r *http.Request) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	currentUnit, err := h.unitService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var unitDto dto.NewUnitDto
+	if err := json.NewDecoder(r.Body).Decode(&unitDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	unit := mapper.FromNewUnitDtoToUnit(&unitDto, groupID)
+	unit.ID = currentUnit.ID
+
+	if err := h.unitService.Update(unit); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteUnit godoc
+// @Summary Delete a custom unit
+// @Description Delete a custom unit of a group, as long as no ingredient, grocery or pantry item uses it; the units of the catalogue can't be deleted
+// @Tags unit
+// @Accept json
+// @Produce json
+// @Param groupId path int true "Group ID"
+// @Param id path int true "Unit ID"
+// @Success 204 {string} string "No Content"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden: unit of the catalogue"
+// @Failure 404 {string} string "Unit not found"
+// @Failure 409 {string} string "Conflict: unit in use"
+// @Failure 500 {string} string "Internal server error"
+// @Router /api/group/{groupId}/unit/{id} [delete]
+func (h *UnitHandler) deleteUnit(w http.ResponseWriter, r *http.Request) {
+	unit, err := h.unitService.GetByID(r.Context().Value("id").(int64))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.unitService.Delete(unit.ID); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/*** NON-HANDLER PRIVATE METHODS ***/
+
+// unitGroup returns the ID of the group owning a custom unit, or nil for a unit of the catalogue,
+// for middleware.GroupFromResource.
+func (h *UnitHandler) unitGroup(id int64) (*int64, error) {
+	unit, err := h.unitService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return unit.GroupID, nil
+}
+
+// getGroupUnit retrieves the unit identified by id, ensuring it belongs to the catalogue or to the group from the request path.
+// Units given as query parameters aren't checked by the middlewares, unlike the {id} path value.
+func (h *UnitHandler) getGroupUnit(r *http.Request, id int64) (*model.Unit, error) {
+	groupID := r.Context().Value("groupId").(int64)
+
+	unit, err := h.unitService.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Custom units of other groups are reported as not found to avoid leaking their existence.
+	if unit.GroupID != nil && *unit.GroupID != groupID {
+		return nil, customErrors.NewNotFoundError("units", "id", nil)
+	}
+
+	return unit, nil
+}
+
+// convert parses the quantity and units of a conversion request and converts the quantity.
+func (h *UnitHandler) convert(r *http.Request) (*dto.UnitConversionDto, error) {
+	quantity, err := requiredFloatQueryParam(r, "quantity")
+	if err != nil {
+		return nil, err
+	}
+	fromID, err := requiredInt64QueryParam(r, "from")
+	if err != nil {
+		return nil, err
+	}
+	toID, err := requiredInt64QueryParam(r, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := h.getGroupUnit(r, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := h.getGroupUnit(r, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.unitService.Convert(quantity, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UnitConversionDto{
+		Quantity: quantity,
+		From:     dto.UnitSummaryDto{ID: from.ID, Name: from.Name},
+		To:       dto.UnitSummaryDto{ID: to.ID, Name: to.Name},
+		Result:   result,
+	}, nil
+}
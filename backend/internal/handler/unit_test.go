@@ -0,0 +1,411 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+// mockUnitService is a mock implementation of UnitServiceInterface for testing handler
+type mockUnitService struct {
+	units     []model.Unit
+	nextID    int64
+	createErr error
+	updateErr error
+	deleteErr error
+}
+
+func (m *mockUnitService) GetByID(id int64) (*model.Unit, error) {
+	for i := range m.units {
+		if m.units[i].ID == id {
+			return &m.units[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("units", "id", nil)
+}
+
+func (m *mockUnitService) GetByGroupID(groupID int64) ([]model.Unit, error) {
+	result := []model.Unit{}
+	for _, unit := range m.units {
+		if unit.GroupID == nil || *unit.GroupID == groupID {
+			result = append(result, unit)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockUnitService) Create(unit *model.Unit) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+	unit.ID = m.nextID
+	m.nextID++
+	m.units = append(m.units, *unit)
+	return unit.ID, nil
+}
+
+func (m *mockUnitService) Update(unit *model.Unit) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	for i := range m.units {
+		if m.units[i].ID == unit.ID {
+			m.units[i] = *unit
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("units", "id", nil)
+}
+
+func (m *mockUnitService) Delete(id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+	for i := range m.units {
+		if m.units[i].ID == id {
+			m.units = append(m.units[:i], m.units[i+1:]...)
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("units", "id", nil)
+}
+
+func (m *mockUnitService) Convert(quantity float64, from, to *model.Unit) (float64, error) {
+	if from.UnitType != to.UnitType {
+		return 0, customErrors.NewValidationError("unit_type", customErrors.UNIT_CONVERSION_ERROR, nil)
+	}
+	return quantity * from.Factor / to.Factor, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func setupUnitTestData() (*mockUnitService, *mockGroupService) {
+	unitService := &mockUnitService{
+		units: []model.Unit{
+			{ID: 1, Name: "Kilogram", Factor: 1000, UnitType: enum.Weight},
+			{ID: 2, Name: "Gram", Factor: 1, UnitType: enum.Weight},
+			{ID: 8, Name: "Piece", Factor: 1, UnitType: enum.Piece},
+			{ID: 22, Name: "Bowl", Factor: 250, UnitType: enum.Volume, GroupID: new(int64(1))},
+			{ID: 23, Name: "Handful", Factor: 30, UnitType: enum.Weight, GroupID: new(int64(2))},
+		},
+		nextID: 24,
+	}
+	groupService := &mockGroupService{groups: []model.Group{itemGroup1, itemGroup2}}
+
+	return unitService, groupService
+}
+
+/*** TEST CONSTRUCTOR ***/
+
+func TestNewUnitHandler(t *testing.T) {
+	unitService, groupService := setupUnitTestData()
+	handler := NewUnitHandler(unitService, groupService)
+
+	if handler == nil {
+		t.Fatal("expected non-nil handler")
+	}
+
+	if handler.unitService != unitService {
+		t.Error("handler unitService does not match the provided service")
+	}
+
+	if handler.groupService != groupService {
+		t.Error("handler groupService does not match the provided service")
+	}
+}
+
+/*** READ OPERATIONS TESTS ***/
+
+func TestGetUnits(t *testing.T) {
+	unitService, groupService := setupUnitTestData()
+	handler := NewUnitHandler(unitService, groupService)
+
+	r := newItemRequest(http.MethodGet, "/unit", nil, memberUser, map[string]int64{"groupId": 1})
+	w := httptest.NewRecorder()
+
+	handler.getUnits(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d instead of %d", http.StatusOK, w.Code)
+	}
+
+	var actual []dto.UnitDto
+	if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(actual) != 4 {
+		t.Fatalf("expected 4 units instead of %d", len(actual))
+	}
+	if actual[0].Custom || !actual[3].Custom || actual[3].Name != "Bowl" {
+		t.Errorf("expected the catalogue followed by the custom unit Bowl, got %+v", actual)
+	}
+}
+
+func TestGetUnitByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		unitID         int64
+		expectedStatus int
+	}{
+		{"Catalogue unit", 1, http.StatusOK},
+		{"Custom unit of the group", 22, http.StatusOK},
+		{"Unknown unit", -1, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unitService, groupService := setupUnitTestData()
+			handler := NewUnitHandler(unitService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/unit", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.unitID})
+			w := httptest.NewRecorder()
+
+			handler.getUnitByID(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.UnitDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.ID != tt.unitID {
+				t.Errorf("expected unit %d instead of %d", tt.unitID, actual.ID)
+			}
+		})
+	}
+}
+
+func TestConvertQuantity(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expected       float64
+	}{
+		{"Catalogue units", "?quantity=1.5&from=1&to=2", http.StatusOK, 1500},
+		{"Custom unit", "?quantity=2&from=23&to=2", http.StatusNotFound, 0},
+		{"Units of different types", "?quantity=2&from=1&to=8", http.StatusBadRequest, 0},
+		{"Missing quantity", "?from=1&to=2", http.StatusBadRequest, 0},
+		{"Invalid unit", "?quantity=2&from=kg&to=2", http.StatusBadRequest, 0},
+		{"Unknown unit", "?quantity=2&from=1&to=-1", http.StatusNotFound, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unitService, groupService := setupUnitTestData()
+			handler := NewUnitHandler(unitService, groupService)
+
+			r := newItemRequest(http.MethodGet, "/unit/convert"+tt.query, nil, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.convertQuantity(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var actual dto.UnitConversionDto
+			if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if actual.Result != tt.expected || actual.From.Name != "Kilogram" || actual.To.Name != "Gram" {
+				t.Errorf("unexpected conversion %+v", actual)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS TESTS ***/
+
+func TestCreateUnit(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewUnitDto{Name: "Mug", Factor: 300, UnitType: enum.Volume})
+
+	tests := []struct {
+		name           string
+		body           []byte
+		createErr      error
+		expectedStatus int
+	}{
+		{"Valid unit", validBody, nil, http.StatusCreated},
+		{"Invalid body", []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Invalid unit type", []byte(`{"name": "Mug", "factor": 300, "unit_type": "SPOON"}`), nil, http.StatusBadRequest},
+		{"Invalid fields", validBody, customErrors.NewInvalidParamsError([]string{"factor"}, nil), http.StatusBadRequest},
+		{"Name already used", validBody, customErrors.NewConflictError("Unit", "a unit with this name already exists", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unitService, groupService := setupUnitTestData()
+			unitService.createErr = tt.createErr
+			handler := NewUnitHandler(unitService, groupService)
+
+			r := newItemRequest(http.MethodPost, "/unit", tt.body, memberUser, map[string]int64{"groupId": 1})
+			w := httptest.NewRecorder()
+
+			handler.createUnit(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var result map[string]int64
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			created, err := unitService.GetByID(result["id"])
+			if err != nil {
+				t.Fatalf("failed to retrieve created unit: %v", err)
+			}
+
+			if created.Name != "Mug" || created.GroupID == nil || *created.GroupID != 1 {
+				t.Errorf("unexpected created unit %+v", created)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS TESTS ***/
+
+func TestUpdateUnit(t *testing.T) {
+	validBody, _ := json.Marshal(dto.NewUnitDto{Name: "Big bowl", Factor: 500, UnitType: enum.Volume})
+
+	tests := []struct {
+		name           string
+		unitID         int64
+		body           []byte
+		updateErr      error
+		expectedStatus int
+	}{
+		{"Valid update", 22, validBody, nil, http.StatusNoContent},
+		{"Invalid body", 22, []byte("{invalid"), nil, http.StatusBadRequest},
+		{"Catalogue unit", 1, validBody, customErrors.NewForbiddenError(nil), http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unitService, groupService := setupUnitTestData()
+			unitService.updateErr = tt.updateErr
+			handler := NewUnitHandler(unitService, groupService)
+
+			r := newItemRequest(http.MethodPut, "/unit", tt.body, memberUser, map[string]int64{"groupId": 1, "id": tt.unitID})
+			w := httptest.NewRecorder()
+
+			handler.updateUnit(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			updated, _ := unitService.GetByID(tt.unitID)
+			if updated.Name != "Big bowl" || updated.Factor != 500 {
+				t.Errorf("unexpected updated unit %+v", updated)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS TESTS ***/
+
+func TestDeleteUnit(t *testing.T) {
+	tests := []struct {
+		name           string
+		unitID         int64
+		deleteErr      error
+		expectedStatus int
+	}{
+		{"Custom unit", 22, nil, http.StatusNoContent},
+		{"Catalogue unit", 1, customErrors.NewForbiddenError(nil), http.StatusForbidden},
+		{"Unit in use", 22, customErrors.NewConflictError("Unit", "can't delete unit used by ingredients, groceries or the pantry", nil), http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unitService, groupService := setupUnitTestData()
+			unitService.deleteErr = tt.deleteErr
+			handler := NewUnitHandler(unitService, groupService)
+			unitsNb := len(unitService.units)
+
+			r := newItemRequest(http.MethodDelete, "/unit", nil, memberUser, map[string]int64{"groupId": 1, "id": tt.unitID})
+			w := httptest.NewRecorder()
+
+			handler.deleteUnit(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+
+			expectedNb := unitsNb
+			if tt.expectedStatus == http.StatusNoContent {
+				expectedNb--
+			}
+			if len(unitService.units) != expectedNb {
+				t.Errorf("expected %d units instead of %d", expectedNb, len(unitService.units))
+			}
+		})
+	}
+}
+
+/*** ROUTES TESTS ***/
+
+func TestUnitRegisterRoutes(t *testing.T) {
+	unitService, groupService := setupUnitTestData()
+	handler := NewUnitHandler(unitService, groupService)
+	mux := http.NewServeMux()
+
+	handler.RegisterRoutes(mux, "/api/group/{groupId}/unit")
+
+	r := httptest.NewRequest(http.MethodGet, "/api/group/1/unit/convert?quantity=1&from=1&to=2", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, memberUser))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d for GET /api/group/1/unit/convert instead of %d", http.StatusOK, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/group/1/unit", nil)
+	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, nonMemberUser))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d for GET /api/group/1/unit as a non-member instead of %d", http.StatusForbidden, w.Code)
+	}
+
+	// Custom units of another group are reported as not found on every route.
+	assertRoutesStatus(t, mux, memberUser, []string{
+		"GET /api/group/1/unit/23",
+		"PUT /api/group/1/unit/23",
+		"DELETE /api/group/1/unit/23",
+	}, http.StatusNotFound)
+}
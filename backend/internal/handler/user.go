@@ -19,26 +19,29 @@ import (
 
 // UserHandler handles HTTP requests related to user operations.
 type UserHandler struct {
-	userService service.UserServiceInterface
+	userService    service.UserServiceInterface
+	sessionService service.SessionServiceInterface
 }
 
-// NewUserHandler constructs a new UserHandler with the provided UserService.
-func NewUserHandler(userService service.UserServiceInterface) *UserHandler {
+// NewUserHandler constructs a new UserHandler with the provided UserService and SessionService.
+func NewUserHandler(userService service.UserServiceInterface, sessionService service.SessionServiceInterface) *UserHandler {
 	return &UserHandler{
-		userService: userService,
+		userService:    userService,
+		sessionService: sessionService,
 	}
 }
 
 // RegisterRoutes registers the user-related routes on the provided ServeMux with the given prefix.
+// The accounts can only be modified from a session, not with an API token.
 func (h *UserHandler) RegisterRoutes(mux *http.ServeMux, prefix string) {
 	mux.HandleFunc("GET "+prefix, h.getUsers)
 	mux.HandleFunc("GET "+prefix+"/me", h.authMe)
 	mux.Handle("GET "+prefix+"/{id}", middleware.IntPathValues("id")(http.HandlerFunc(h.getUserByID)))
-	mux.HandleFunc("POST "+prefix, h.createUser)
-	mux.HandleFunc("PUT "+prefix, h.updateUser)
-	mux.Handle("PATCH "+prefix+"/{id}/admin", middleware.IntPathValues("id")(http.HandlerFunc(h.updateUserAdminRole)))
-	mux.Handle("PATCH "+prefix+"/{id}/password", middleware.IntPathValues("id")(http.HandlerFunc(h.updateUserPassword)))
-	mux.Handle("DELETE "+prefix+"/{id}", middleware.IntPathValues("id")(http.HandlerFunc(h.deleteUser)))
+	mux.Handle("POST "+prefix, middleware.NoAPIToken(http.HandlerFunc(h.createUser)))
+	mux.Handle("PUT "+prefix, middleware.NoAPIToken(http.HandlerFunc(h.updateUser)))
+	mux.Handle("PATCH "+prefix+"/{id}/admin", middleware.Stack(middleware.NoAPIToken, middleware.IntPathValues("id"))(http.HandlerFunc(h.updateUserAdminRole)))
+	mux.Handle("PATCH "+prefix+"/{id}/password", middleware.Stack(middleware.NoAPIToken, middleware.IntPathValues("id"))(http.HandlerFunc(h.updateUserPassword)))
+	mux.Handle("DELETE "+prefix+"/{id}", middleware.Stack(middleware.NoAPIToken, middleware.IntPathValues("id"))(http.HandlerFunc(h.deleteUser)))
 }
 
 // GetUsers godoc
@@ -225,6 +228,10 @@ func (h *UserHandler) updateUserAdminRole(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if !h.invalidateSessions(w, r, userID) {
+		return
+	}
+
 	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -260,6 +267,10 @@ func (h *UserHandler) updateUserPassword(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if !h.invalidateSessions(w, r, userID) {
+		return
+	}
+
 	w.Header().Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -294,6 +305,21 @@ func (h *UserHandler) deleteUser(w http.ResponseWriter, r *http.Request) {
 
 /*** NON-HANDLER PRIVATE METHODS ***/
 
+// invalidateSessions logs the user out of all their sessions once their credentials or privileges changed.
+// The session of the request is rotated instead if it belongs to the user, so they stay logged in.
+// It writes the error response and returns false if the sessions couldn't be invalidated.
+func (h *UserHandler) invalidateSessions(w http.ResponseWriter, r *http.Request, userID int64) bool {
+	if err := h.sessionService.Invalidate(userID, requestSession(r)); err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+			http.Error(w, err.Error(), appErr.HTTPStatus())
+			return false
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
 // getAllUsers retrieves all users and writes them to the response.
 func (h *UserHandler) getAllUsers(w http.ResponseWriter) {
 	users, err := h.userService.GetAll()
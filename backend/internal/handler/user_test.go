@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,8 +21,12 @@ import (
 
 	"github.com/zouipo/yumsday/backend/internal/dto"
 	"github.com/zouipo/yumsday/backend/internal/mapper"
+	"github.com/zouipo/yumsday/backend/internal/middleware"
 	"github.com/zouipo/yumsday/backend/internal/model"
 	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+	"github.com/zouipo/yumsday/backend/internal/service"
 )
 
 var (
@@ -264,7 +269,7 @@ func compareUserToNewUserDto(user *model.User, newUserDto *dto.NewUserDto) error
 
 func TestNewUserHandler(t *testing.T) {
 	mockService := NewMockUserService()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	if handler == nil {
 		t.Fatal("expected non-nil handler")
@@ -280,7 +285,7 @@ func TestNewUserHandler(t *testing.T) {
 func TestGetUsersAll_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	// Simulates a request to GET /user without query parameters to get all users
 	r := httptest.NewRequest(http.MethodGet, "/user", nil)
@@ -313,7 +318,7 @@ func TestGetUsersAll_RepoError(t *testing.T) {
 	errMessage := errors.New("Failed to fetch users")
 	mockService.getAllErr = customErrors.NewInternalError("Failed to fetch users", errMessage)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	r := httptest.NewRequest(http.MethodGet, "/user", nil)
 	w := httptest.NewRecorder()
@@ -333,7 +338,7 @@ func TestGetUsersAll_RepoError(t *testing.T) {
 func TestGetUsersByUsername_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user := mockService.users[0]
 
@@ -367,7 +372,7 @@ func TestGetUsersByUsername_NotFound(t *testing.T) {
 	mockService := NewMockUserService()
 	mockService.users = []model.User{}
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	r := httptest.NewRequest(http.MethodGet, "/user?username="+invalidUsername, nil)
 	w := httptest.NewRecorder()
@@ -383,7 +388,7 @@ func TestGetUsersByUsername_EmptyUsername(t *testing.T) {
 	mockService := NewMockUserService()
 	mockService.users = []model.User{}
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	r := httptest.NewRequest(http.MethodGet, "/user?username=", nil)
 	w := httptest.NewRecorder()
@@ -398,7 +403,7 @@ func TestGetUsersByUsername_EmptyUsername(t *testing.T) {
 // TestGetUsers_MultipleQueryParams tests the getUsers handler with multiple username query parameters
 func TestGetUsers_MultipleQueryParams(t *testing.T) {
 	mockService := setupTestData()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user1 := mockService.users[0]
 	user2 := mockService.users[1]
@@ -422,7 +427,7 @@ func TestGetUsers_MultipleQueryParams(t *testing.T) {
 // TestGetUsers_InvalidQueryParams tests the getUsers handler with invalid query parameters
 func TestGetUsers_InvalidQueryParams(t *testing.T) {
 	mockService := setupTestData()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	// Multiple username parameters
 	r := httptest.NewRequest(http.MethodGet, "/user?random=ok", nil)
@@ -444,7 +449,7 @@ func TestGetUsers_InvalidQueryParams(t *testing.T) {
 func TestGetUserByID_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	expected := mockService.users[0]
 
@@ -480,7 +485,7 @@ func TestGetUserByID_NotFound(t *testing.T) {
 	mockService := NewMockUserService()
 	mockService.users = []model.User{}
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	r := httptest.NewRequest(http.MethodGet, "/user/"+strconv.FormatInt(int64(invalidId), 10), nil)
 	ctx := context.WithValue(r.Context(), "id", int64(invalidId))
@@ -508,7 +513,7 @@ func TestAuthMe_Success(t *testing.T) {
 		AppTheme:  enum.Light,
 	}
 	mockService := NewMockUserService()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	r := httptest.NewRequest(http.MethodGet, "/user/me", nil)
 	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, authenticatedUser))
@@ -541,7 +546,7 @@ func TestAuthMe_Success(t *testing.T) {
 
 func TestAuthMe_MissingUserInContext(t *testing.T) {
 	mockService := NewMockUserService()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	r := httptest.NewRequest(http.MethodGet, "/user/me", nil)
 	w := httptest.NewRecorder()
@@ -559,7 +564,7 @@ func TestAuthMe_MissingUserInContext(t *testing.T) {
 
 func TestAuthMe_InvalidUserTypeInContext(t *testing.T) {
 	mockService := NewMockUserService()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	r := httptest.NewRequest(http.MethodGet, "/user/me", nil)
 	r = r.WithContext(context.WithValue(r.Context(), ctx.UserCtxKey{}, "not-a-user"))
@@ -581,7 +586,7 @@ func TestAuthMe_InvalidUserTypeInContext(t *testing.T) {
 func TestCreateUser_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	avatar := enum.Avatar1
 	newUser := dto.NewUserDto{
@@ -640,7 +645,7 @@ func TestCreateUser_Success(t *testing.T) {
 func TestCreateUser_Success_AvatarNil(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	newUser := dto.NewUserDto{
 		Username: validUsername,
@@ -697,7 +702,7 @@ func TestCreateUser_Success_AvatarNil(t *testing.T) {
 
 func TestCreateUser_InvalidBody(t *testing.T) {
 	mockService := setupTestData()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	usersNb := len(mockService.users)
 
@@ -724,7 +729,7 @@ func TestCreateUser_ValidationError(t *testing.T) {
 	mockService := setupTestData()
 	mockService.createErr = customErrors.NewValidationError("username", customErrors.USERNAME_FIELD_ERROR, nil)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	usersNb := len(mockService.users)
 
@@ -764,7 +769,7 @@ func TestCreateUser_ConflictError(t *testing.T) {
 	mockService := setupTestData()
 	mockService.createErr = customErrors.NewConflictError("User", "already exists", sqlite3.ErrConstraintUnique)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	usersNb := len(mockService.users)
 
@@ -804,7 +809,7 @@ func TestCreateUser_RepoError(t *testing.T) {
 	errMessage := "Failed to create user"
 	mockService.createErr = customErrors.NewInternalError(errMessage, nil)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	usersNb := len(mockService.users)
 
@@ -844,7 +849,7 @@ func TestCreateUser_RepoError(t *testing.T) {
 func TestUpdateUser_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	avatar := enum.Avatar2
 	user := mapper.ToUserDtoNoPassword(&mockService.users[0])
@@ -881,7 +886,7 @@ func TestUpdateUser_Success(t *testing.T) {
 
 func TestUpdateUser_InvalidBody(t *testing.T) {
 	mockService := setupTestData()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	r := httptest.NewRequest(http.MethodPut, "/user", bytes.NewReader([]byte("invalid json")))
 	r.Header.Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
@@ -902,7 +907,7 @@ func TestUpdateUser_ConflictError(t *testing.T) {
 	mockService := setupTestData()
 	mockService.updateErr = customErrors.NewConflictError("User", "already exists", sqlite3.ErrConstraintUnique)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user := mapper.ToUserDtoNoPassword(&mockService.users[0])
 	user.Username = mockService.users[1].Username
@@ -937,7 +942,7 @@ func TestUpdateUser_ValidationError(t *testing.T) {
 	mockService := setupTestData()
 	mockService.updateErr = customErrors.NewValidationError("username", customErrors.USERNAME_FIELD_ERROR, nil)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user := mapper.ToUserDtoNoPassword(&mockService.users[0])
 	user.Username = invalidUsername
@@ -974,7 +979,7 @@ func TestUpdateUser_RepoError(t *testing.T) {
 	errMessage := "Failed to update user"
 	mockService.updateErr = customErrors.NewInternalError(errMessage, nil)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	avatar := enum.Avatar2
 	user := mapper.ToUserDtoNoPassword(&mockService.users[0])
@@ -1012,7 +1017,8 @@ func TestUpdateUser_RepoError(t *testing.T) {
 func TestUpdateUserAdminRole_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	mockSessionService := &MockSessionService{}
+	handler := NewUserHandler(mockService, mockSessionService)
 
 	user := mockService.users[0]
 	adminRole := !user.AppAdmin
@@ -1045,11 +1051,15 @@ func TestUpdateUserAdminRole_Success(t *testing.T) {
 	if actual.AppAdmin != adminRole {
 		t.Errorf("expected appAdmin ='%v'instead of %v", adminRole, actual.AppAdmin)
 	}
+
+	if len(mockSessionService.invalidated) != 1 || mockSessionService.invalidated[0] != user.ID {
+		t.Errorf("expected sessions of user %d to be invalidated, got %v", user.ID, mockSessionService.invalidated)
+	}
 }
 
 func TestUpdateUserAdminRole_InvalidBody(t *testing.T) {
 	mockService := setupTestData()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user := mockService.users[0]
 
@@ -1084,7 +1094,7 @@ func TestUpdateUserAdminRole_RepoError(t *testing.T) {
 	errMessage := "Failed to update user admin role"
 	mockService.updateRoleErr = customErrors.NewInternalError(errMessage, nil)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user := mockService.users[0]
 	adminRole := !user.AppAdmin
@@ -1122,7 +1132,8 @@ func TestUpdateUserAdminRole_RepoError(t *testing.T) {
 func TestUpdateUserPassword_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	mockSessionService := &MockSessionService{}
+	handler := NewUserHandler(mockService, mockSessionService)
 
 	user := mockService.users[0]
 
@@ -1157,11 +1168,15 @@ func TestUpdateUserPassword_Success(t *testing.T) {
 	if actual.Password != validPassword {
 		t.Errorf("expected password ='%v'instead of %v", validPassword, actual.Password)
 	}
+
+	if len(mockSessionService.invalidated) != 1 || mockSessionService.invalidated[0] != user.ID {
+		t.Errorf("expected sessions of user %d to be invalidated, got %v", user.ID, mockSessionService.invalidated)
+	}
 }
 
 func TestUpdateUserPassword_InvalidBody(t *testing.T) {
 	mockService := setupTestData()
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user := mockService.users[0]
 
@@ -1195,7 +1210,7 @@ func TestUpdateUserPassword_ValidationError(t *testing.T) {
 	mockService := setupTestData()
 	mockService.updatePassErr = customErrors.NewValidationError("password", customErrors.PASSWORD_FIELD_ERROR, nil)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user := mockService.users[0]
 
@@ -1238,7 +1253,7 @@ func TestUpdateUserPassword_RepoError(t *testing.T) {
 	errMessage := "Failed to update user"
 	mockService.updatePassErr = customErrors.NewInternalError(errMessage, nil)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	user := mockService.users[0]
 
@@ -1275,12 +1290,38 @@ func TestUpdateUserPassword_RepoError(t *testing.T) {
 	}
 }
 
+func TestUpdateUserPassword_InvalidateError(t *testing.T) {
+	mockService := setupTestData()
+	mockSessionService := &MockSessionService{
+		invalidateErr: customErrors.NewInternalError("failed to delete sessions", nil),
+	}
+	handler := NewUserHandler(mockService, mockSessionService)
+
+	user := mockService.users[0]
+
+	body, _ := json.Marshal(dto.PasswordPayload{
+		OldPassword: user.Password,
+		NewPassword: validPassword,
+	})
+
+	r := httptest.NewRequest(http.MethodPatch, "/user/"+strconv.FormatInt(user.ID, 10)+"/password", bytes.NewReader(body))
+	r.Header.Set(constant.CONTENT_TYPE_HEADER, constant.CONTENT_TYPE_VALUE)
+	r = r.WithContext(context.WithValue(r.Context(), "id", int64(user.ID)))
+	w := httptest.NewRecorder()
+
+	handler.updateUserPassword(w, r)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d instead of %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
 /*** DELETE OPERATIONS TESTS ***/
 
 func TestDeleteUser_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	usersNb := len(mockService.users)
 	user := mockService.users[0]
@@ -1313,7 +1354,7 @@ func TestDeleteUser_Success(t *testing.T) {
 func TestDeleteUser_NotFound(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	usersNb := len(mockService.users)
 
@@ -1338,7 +1379,7 @@ func TestDeleteUser_RepoError(t *testing.T) {
 	errMessage := "Failed to delete user"
 	mockService.deleteErr = customErrors.NewInternalError(errMessage, nil)
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 
 	usersNb := len(mockService.users)
 	user := mockService.users[0]
@@ -1372,7 +1413,7 @@ func TestDeleteUser_RepoError(t *testing.T) {
 func TestRegisterRoutes_Success(t *testing.T) {
 	mockService := setupTestData()
 
-	handler := NewUserHandler(mockService)
+	handler := NewUserHandler(mockService, &MockSessionService{})
 	mux := http.NewServeMux()
 
 	handler.RegisterRoutes(mux, "/test/api/user")
@@ -1405,3 +1446,148 @@ func TestRegisterRoutes_Success(t *testing.T) {
 		t.Errorf("expected status %d for POST /test/api/user instead of %d", http.StatusCreated, w.Code)
 	}
 }
+
+// TestRegisterRoutes_APIToken tests that the accounts can't be modified with an API token.
+func TestRegisterRoutes_APIToken(t *testing.T) {
+	tests := []struct {
+		method string
+		target string
+	}{
+		{http.MethodPost, "/api/user"},
+		{http.MethodPut, "/api/user"},
+		{http.MethodPatch, "/api/user/1/admin"},
+		{http.MethodPatch, "/api/user/1/password"},
+		{http.MethodDelete, "/api/user/1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.target, func(t *testing.T) {
+			mockService := setupTestData()
+			mux := http.NewServeMux()
+			NewUserHandler(mockService, &MockSessionService{}).RegisterRoutes(mux, "/api/user")
+
+			r := httptest.NewRequest(tt.method, tt.target, strings.NewReader("{}"))
+			c := context.WithValue(r.Context(), ctx.UserCtxKey{}, &mockService.users[0])
+			c = context.WithValue(c, ctx.APITokenCtxKey{}, &model.APIToken{ID: 2, Scope: enum.WriteScope})
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, r.WithContext(c))
+
+			if w.Code != http.StatusForbidden {
+				t.Errorf("expected status %d instead of %d", http.StatusForbidden, w.Code)
+			}
+		})
+	}
+}
+
+// TestUpdateUserPassword_RevokesAPITokens tests that the API tokens of a user stop working once their password changed.
+func TestUpdateUserPassword_RevokesAPITokens(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	apiTokenRepo := repository.NewAPITokenRepository(db)
+	apiTokenService := service.NewAPITokenService(apiTokenRepo)
+	sessionService := service.NewSessionService(repository.NewSessionRepository(db), apiTokenRepo, "session_id", time.Hour)
+
+	mockService := setupTestData()
+	user := mockService.users[0]
+	handler := NewUserHandler(mockService, sessionService)
+
+	secret, err := apiTokenService.Create(&model.APIToken{UserID: user.ID, Name: "Script", Scope: enum.WriteScope})
+	if err != nil {
+		t.Fatalf("failed to create API token: %v", err)
+	}
+
+	// authenticate serves a request carrying the API token, returning its status.
+	authenticate := func() int {
+		r := httptest.NewRequest(http.MethodGet, "/api/user/me", nil)
+		r.Header.Set(constant.AUTHORIZATION_HEADER, constant.BEARER_PREFIX+secret)
+		w := httptest.NewRecorder()
+		middleware.APITokenInjector(apiTokenService, mockService)(http.HandlerFunc(handler.authMe)).ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := authenticate(); code != http.StatusOK {
+		t.Fatalf("expected status %d before the password change instead of %d", http.StatusOK, code)
+	}
+
+	body, _ := json.Marshal(dto.PasswordPayload{OldPassword: user.Password, NewPassword: validPassword})
+	r := httptest.NewRequest(http.MethodPatch, "/api/user/1/password", bytes.NewReader(body))
+	r = r.WithContext(context.WithValue(r.Context(), "id", user.ID))
+	w := httptest.NewRecorder()
+
+	handler.updateUserPassword(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d instead of %d", http.StatusNoContent, w.Code)
+	}
+
+	if code := authenticate(); code != http.StatusUnauthorized {
+		t.Errorf("expected status %d after the password change instead of %d", http.StatusUnauthorized, code)
+	}
+}
+
+// TestUpdateUserPassword_RequestInFlight tests that a session logged out by a password change while one of its requests
+// is still being served isn't saved back once the request ends.
+func TestUpdateUserPassword_RequestInFlight(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	// Each connection would open its own in-memory database
+	db.SetMaxOpenConns(1)
+
+	sessionService := service.NewSessionService(repository.NewSessionRepository(db), repository.NewAPITokenRepository(db), "session_id", time.Hour)
+
+	mockService := setupTestData()
+	user := mockService.users[0]
+	handler := NewUserHandler(mockService, sessionService)
+
+	// Two sessions of the user, stored as on login
+	stale, current := model.NewSession("", ""), model.NewSession("", "")
+	for _, session := range []*model.Session{stale, current} {
+		session.UserID = new(user.ID)
+		if err := sessionService.Rotate(session); err != nil {
+			t.Fatalf("failed to store session: %v", err)
+		}
+	}
+
+	// A request of the stale session is held until the password changed
+	started, release := make(chan struct{}), make(chan struct{})
+	var saves sync.WaitGroup
+	inFlight := middleware.SessionInjector(sessionService, &saves)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/api/recipe", nil)
+	r.AddCookie(&http.Cookie{Name: "session_id", Value: stale.ID})
+	served := make(chan struct{})
+	go func() {
+		inFlight.ServeHTTP(httptest.NewRecorder(), r)
+		close(served)
+	}()
+	<-started
+
+	body, _ := json.Marshal(dto.PasswordPayload{OldPassword: user.Password, NewPassword: validPassword})
+	r = httptest.NewRequest(http.MethodPatch, "/api/user/1/password", bytes.NewReader(body))
+	c := context.WithValue(r.Context(), "id", user.ID)
+	c = context.WithValue(c, ctx.SessionCtxKey{}, current)
+	w := httptest.NewRecorder()
+
+	handler.updateUserPassword(w, r.WithContext(c))
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d instead of %d", http.StatusNoContent, w.Code)
+	}
+
+	close(release)
+	<-served
+	saves.Wait()
+
+	sessions, err := sessionService.GetByUserID(user.ID)
+	if err != nil {
+		t.Fatalf("failed to fetch the sessions of the user: %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].ID != current.ID {
+		t.Errorf("expected only the rotated current session to be left, got %+v", sessions)
+	}
+}
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToAPITokenDto maps an APIToken model to an APITokenDto, without the digest of the token.
+func ToAPITokenDto(token *model.APIToken) *dto.APITokenDto {
+	return &dto.APITokenDto{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scope:      token.Scope,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+	}
+}
+
+// ToCreatedAPITokenDto maps a newly created APIToken model and its secret to a CreatedAPITokenDto.
+func ToCreatedAPITokenDto(token *model.APIToken, secret string) *dto.CreatedAPITokenDto {
+	return &dto.CreatedAPITokenDto{
+		APITokenDto: *ToAPITokenDto(token),
+		Token:       secret,
+	}
+}
+
+// FromNewAPITokenDtoToAPIToken maps a NewAPITokenDto to an APIToken model of the given user.
+func FromNewAPITokenDtoToAPIToken(newTokenDto *dto.NewAPITokenDto, userID int64) *model.APIToken {
+	return &model.APIToken{
+		UserID:    userID,
+		Name:      newTokenDto.Name,
+		Scope:     newTokenDto.Scope,
+		ExpiresAt: newTokenDto.ExpiresAt,
+	}
+}
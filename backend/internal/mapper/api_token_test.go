@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+/*** DATA ***/
+
+var tokenExpiresAt = time.Date(2025, time.April, 8, 12, 0, 0, 0, time.UTC)
+
+var apiToken = model.APIToken{
+	ID:         1,
+	UserID:     2,
+	Name:       "Meal planner",
+	TokenHash:  "tokenhash",
+	Scope:      enum.WriteScope,
+	CreatedAt:  groupCreatedAt,
+	ExpiresAt:  &tokenExpiresAt,
+	LastUsedAt: &sessionLastActivity,
+}
+
+var apiTokenDto = dto.APITokenDto{
+	ID:         1,
+	Name:       "Meal planner",
+	Scope:      enum.WriteScope,
+	CreatedAt:  groupCreatedAt,
+	ExpiresAt:  &tokenExpiresAt,
+	LastUsedAt: &sessionLastActivity,
+}
+
+/*** TESTS ***/
+
+func TestToAPITokenDto(t *testing.T) {
+	mappedDto := ToAPITokenDto(&apiToken)
+
+	if !reflect.DeepEqual(mappedDto, &apiTokenDto) {
+		t.Errorf("ToAPITokenDto mapping failed: expected %+v, got %+v", apiTokenDto, *mappedDto)
+	}
+}
+
+func TestToCreatedAPITokenDto(t *testing.T) {
+	mappedDto := ToCreatedAPITokenDto(&apiToken, "yum_secret")
+
+	expected := &dto.CreatedAPITokenDto{APITokenDto: apiTokenDto, Token: "yum_secret"}
+	if !reflect.DeepEqual(mappedDto, expected) {
+		t.Errorf("ToCreatedAPITokenDto mapping failed: expected %+v, got %+v", *expected, *mappedDto)
+	}
+}
+
+func TestFromNewAPITokenDtoToAPIToken(t *testing.T) {
+	newTokenDto := dto.NewAPITokenDto{Name: "Export", Scope: enum.ReadScope, ExpiresAt: &tokenExpiresAt}
+
+	expected := &model.APIToken{UserID: 2, Name: "Export", Scope: enum.ReadScope, ExpiresAt: &tokenExpiresAt}
+	if mapped := FromNewAPITokenDtoToAPIToken(&newTokenDto, 2); !reflect.DeepEqual(mapped, expected) {
+		t.Errorf("FromNewAPITokenDtoToAPIToken mapping failed: expected %+v, got %+v", *expected, *mapped)
+	}
+}
@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToCostEstimateDto maps a CostEstimate model to a CostEstimateDto, dividing its cost by its servings when they are known.
+func ToCostEstimateDto(estimate *model.CostEstimate) *dto.CostEstimateDto {
+	costEstimateDto := &dto.CostEstimateDto{
+		Cost:                estimate.Cost,
+		Servings:            estimate.Servings,
+		UnpricedIngredients: MapList(estimate.UnpricedIngredients, ToIngredientDto),
+	}
+
+	if estimate.Servings != nil && *estimate.Servings > 0 {
+		costEstimateDto.CostPerServing = new(estimate.Cost / float64(*estimate.Servings))
+	}
+
+	return costEstimateDto
+}
@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+func TestToCostEstimateDto(t *testing.T) {
+	unpriced := model.Ingredient{
+		ID:       3,
+		Quantity: new(100.0),
+		Item:     model.Item{ID: 13, Name: "Olive Oil"},
+		Unit:     model.Unit{ID: 4, Name: "Milliliter", Factor: 1},
+		RecipeID: 4,
+	}
+
+	tests := []struct {
+		name     string
+		estimate model.CostEstimate
+		expected *dto.CostEstimateDto
+	}{
+		{
+			name:     "Known servings",
+			estimate: model.CostEstimate{Cost: 6, Servings: new(4), UnpricedIngredients: []model.Ingredient{unpriced}},
+			expected: &dto.CostEstimateDto{
+				Cost:           6,
+				Servings:       new(4),
+				CostPerServing: new(1.5),
+				UnpricedIngredients: []dto.IngredientDto{
+					{ID: 3, Quantity: new(100.0), Item: dto.ItemSummaryDto{ID: 13, Name: "Olive Oil"}, Unit: dto.UnitSummaryDto{ID: 4, Name: "Milliliter"}},
+				},
+			},
+		},
+		{
+			name:     "Unknown servings",
+			estimate: model.CostEstimate{Cost: 6, UnpricedIngredients: []model.Ingredient{}},
+			expected: &dto.CostEstimateDto{Cost: 6, UnpricedIngredients: []dto.IngredientDto{}},
+		},
+		{
+			name:     "No servings",
+			estimate: model.CostEstimate{Servings: new(0), UnpricedIngredients: []model.Ingredient{}},
+			expected: &dto.CostEstimateDto{Servings: new(0), UnpricedIngredients: []dto.IngredientDto{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ToCostEstimateDto(&tt.estimate); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("ToCostEstimateDto mapping failed: expected %+v, got %+v", *tt.expected, *actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToDishDto maps a Dish model to a DishDto, with a summary of its recipes.
+func ToDishDto(dish *model.Dish) *dto.DishDto {
+	return &dto.DishDto{
+		ID:       dish.ID,
+		Portion:  dish.Portion,
+		Bought:   dish.Bought,
+		Cooked:   dish.Cooked,
+		Datetime: dish.Datetime,
+		Recipes: MapList(dish.Recipes, func(recipe *model.Recipe) dto.RecipeSummaryDto {
+			return *ToRecipeSummaryDto(recipe)
+		}),
+	}
+}
+
+// FromNewDishDtoToDish maps a NewDishDto to a Dish model belonging to the given group
+// (used when scheduling or updating a dish).
+func FromNewDishDtoToDish(newDishDto *dto.NewDishDto, groupID int64) *model.Dish {
+	recipes := make([]model.Recipe, len(newDishDto.RecipeIDs))
+	for i, id := range newDishDto.RecipeIDs {
+		recipes[i] = model.Recipe{ID: id}
+	}
+
+	return &model.Dish{
+		Portion:  newDishDto.Portion,
+		Datetime: newDishDto.Datetime,
+		GroupID:  groupID,
+		Recipes:  recipes,
+	}
+}
@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+var dishDatetime = time.Date(2026, time.October, 20, 19, 30, 0, 0, time.UTC)
+
+func TestToDishDto(t *testing.T) {
+	dish := model.Dish{
+		ID:       1,
+		Portion:  4,
+		Bought:   true,
+		Cooked:   true,
+		Datetime: dishDatetime,
+		GroupID:  1,
+		Recipes: []model.Recipe{
+			{ID: 1, Name: "Grilled Chicken", ImageURL: new("/static/recipes/chicken.jpg"), Servings: new(4), GroupID: 1},
+		},
+	}
+
+	expected := &dto.DishDto{
+		ID:       1,
+		Portion:  4,
+		Bought:   true,
+		Cooked:   true,
+		Datetime: dishDatetime,
+		Recipes: []dto.RecipeSummaryDto{
+			{ID: 1, Name: "Grilled Chicken", ImageURL: new("/static/recipes/chicken.jpg"), Servings: new(4)},
+		},
+	}
+
+	if actual := ToDishDto(&dish); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToDishDto mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
+
+func TestFromNewDishDtoToDish(t *testing.T) {
+	newDishDto := dto.NewDishDto{Portion: 2, Datetime: dishDatetime, RecipeIDs: []int64{1, 3}}
+
+	expected := &model.Dish{
+		Portion:  2,
+		Datetime: dishDatetime,
+		GroupID:  1,
+		Recipes:  []model.Recipe{{ID: 1}, {ID: 3}},
+	}
+
+	if actual := FromNewDishDtoToDish(&newDishDto, 1); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromNewDishDtoToDish mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToGroceryDto maps a Grocery model to a GroceryDto, the line being bought once its whole wanted quantity is.
+func ToGroceryDto(grocery *model.Grocery) dto.GroceryDto {
+	return dto.GroceryDto{
+		ID:             grocery.ID,
+		UserQuantity:   grocery.UserQuantity,
+		QuantityBought: grocery.QuantityBought,
+		Bought:         grocery.QuantityBought >= grocery.UserQuantity,
+		Item:           dto.ItemSummaryDto{ID: grocery.Item.ID, Name: grocery.Item.Name},
+		Unit:           dto.UnitSummaryDto{ID: grocery.Unit.ID, Name: grocery.Unit.Name},
+	}
+}
+
+// ToGroceryAisleDtos groups a grocery list by the item category of its items.
+// Aisles keep the order in which their first grocery line appears in the list, as do the lines of an aisle.
+func ToGroceryAisleDtos(groceries []model.Grocery) []dto.GroceryAisleDto {
+	aisles := []dto.GroceryAisleDto{}
+	aisleIndexes := make(map[int64]int)
+
+	for i := range groceries {
+		category := &groceries[i].Item.ItemCategory
+
+		index, ok := aisleIndexes[category.ID]
+		if !ok {
+			index = len(aisles)
+			aisleIndexes[category.ID] = index
+			aisles = append(aisles, dto.GroceryAisleDto{
+				ItemCategory: *ToItemCategoryDto(category),
+				Groceries:    []dto.GroceryDto{},
+			})
+		}
+
+		aisles[index].Groceries = append(aisles[index].Groceries, ToGroceryDto(&groceries[i]))
+	}
+
+	return aisles
+}
+
+// FromNewGroceryDtoToGrocery maps a NewGroceryDto to a Grocery model belonging to the given group
+// (used when adding or updating a grocery line).
+func FromNewGroceryDtoToGrocery(newGroceryDto *dto.NewGroceryDto, groupID int64) *model.Grocery {
+	return &model.Grocery{
+		UserQuantity: newGroceryDto.UserQuantity,
+		Item:         model.Item{ID: newGroceryDto.ItemID},
+		Unit:         model.Unit{ID: newGroceryDto.UnitID},
+		GroupID:      groupID,
+	}
+}
@@ -0,0 +1,89 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+var (
+	groceryCategoryGrains = model.ItemCategory{ID: 1, Name: "GRAINS AND PASTA", GroupID: 1, Position: 0}
+	groceryCategoryDairy  = model.ItemCategory{ID: 4, Name: "DAIRY", GroupID: 1, Position: 3}
+)
+
+func TestToGroceryDto(t *testing.T) {
+	tests := []struct {
+		name     string
+		grocery  model.Grocery
+		expected dto.GroceryDto
+	}{
+		{
+			name: "Partially bought",
+			grocery: model.Grocery{ID: 2, QuantityBought: 0.5, UserQuantity: 1, GroupID: 1,
+				Item: model.Item{ID: 2, Name: "Sugar"}, Unit: model.Unit{ID: 1, Name: "Kilogram"}},
+			expected: dto.GroceryDto{ID: 2, UserQuantity: 1, QuantityBought: 0.5,
+				Item: dto.ItemSummaryDto{ID: 2, Name: "Sugar"}, Unit: dto.UnitSummaryDto{ID: 1, Name: "Kilogram"}},
+		},
+		{
+			name: "Bought",
+			grocery: model.Grocery{ID: 3, QuantityBought: 12, UserQuantity: 12, GroupID: 1,
+				Item: model.Item{ID: 4, Name: "Eggs"}, Unit: model.Unit{ID: 8, Name: "Piece"}},
+			expected: dto.GroceryDto{ID: 3, UserQuantity: 12, QuantityBought: 12, Bought: true,
+				Item: dto.ItemSummaryDto{ID: 4, Name: "Eggs"}, Unit: dto.UnitSummaryDto{ID: 8, Name: "Piece"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ToGroceryDto(&tt.grocery); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("ToGroceryDto mapping failed: expected %+v, got %+v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestToGroceryAisleDtos(t *testing.T) {
+	groceries := []model.Grocery{
+		{ID: 1, UserQuantity: 2, Item: model.Item{ID: 1, Name: "Flour", ItemCategory: groceryCategoryGrains}},
+		{ID: 3, UserQuantity: 12, Item: model.Item{ID: 4, Name: "Eggs", ItemCategory: groceryCategoryDairy}},
+		{ID: 4, UserQuantity: 2, Item: model.Item{ID: 5, Name: "Milk", ItemCategory: groceryCategoryDairy}},
+	}
+
+	actual := ToGroceryAisleDtos(groceries)
+
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 aisles, got %d", len(actual))
+	}
+
+	expectedCategories := []dto.ItemCategoryDto{{ID: 1, Name: "GRAINS AND PASTA", Position: 0}, {ID: 4, Name: "DAIRY", Position: 3}}
+	expectedIDs := [][]int64{{1}, {3, 4}}
+	for i, aisle := range actual {
+		if aisle.ItemCategory != expectedCategories[i] {
+			t.Errorf("expected aisle %d to be %+v, got %+v", i, expectedCategories[i], aisle.ItemCategory)
+		}
+
+		actualIDs := make([]int64, len(aisle.Groceries))
+		for j, grocery := range aisle.Groceries {
+			actualIDs[j] = grocery.ID
+		}
+		if !reflect.DeepEqual(actualIDs, expectedIDs[i]) {
+			t.Errorf("expected aisle %d to contain %v, got %v", i, expectedIDs[i], actualIDs)
+		}
+	}
+
+	if empty := ToGroceryAisleDtos([]model.Grocery{}); empty == nil || len(empty) != 0 {
+		t.Errorf("expected an empty list of aisles, got %v", empty)
+	}
+}
+
+func TestFromNewGroceryDtoToGrocery(t *testing.T) {
+	newGroceryDto := dto.NewGroceryDto{UserQuantity: 2, ItemID: 1, UnitID: 1}
+
+	expected := &model.Grocery{UserQuantity: 2, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 1}, GroupID: 1}
+
+	if actual := FromNewGroceryDtoToGrocery(&newGroceryDto, 1); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromNewGroceryDtoToGrocery mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
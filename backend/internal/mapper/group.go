@@ -0,0 +1,45 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToGroupDto maps a Group model to a GroupDto, including its members.
+func ToGroupDto(group *model.Group) *dto.GroupDto {
+	return &dto.GroupDto{
+		ID:        group.ID,
+		Name:      group.Name,
+		ImageURL:  group.ImageURL,
+		CreatedAt: group.CreatedAt,
+		Members: MapList(group.Members, func(member *model.GroupMember) dto.GroupMemberDto {
+			return *ToGroupMemberDto(member)
+		}),
+	}
+}
+
+// ToGroupMemberDto maps a GroupMember model to a GroupMemberDto.
+func ToGroupMemberDto(member *model.GroupMember) *dto.GroupMemberDto {
+	return &dto.GroupMemberDto{
+		UserID:   member.UserID,
+		Admin:    member.Admin,
+		JoinedAt: member.JoinedAt,
+	}
+}
+
+// FromNewGroupDtoToGroup maps a NewGroupDto to a Group model (used when creating or updating a group).
+func FromNewGroupDtoToGroup(newGroupDto *dto.NewGroupDto) *model.Group {
+	return &model.Group{
+		Name:     newGroupDto.Name,
+		ImageURL: newGroupDto.ImageURL,
+	}
+}
+
+// FromNewGroupMemberDtoToGroupMember maps a NewGroupMemberDto to a GroupMember model of the given group.
+func FromNewGroupMemberDtoToGroupMember(newMemberDto *dto.NewGroupMemberDto, groupID int64) *model.GroupMember {
+	return &model.GroupMember{
+		UserID:  newMemberDto.UserID,
+		GroupId: groupID,
+		Admin:   newMemberDto.Admin,
+	}
+}
@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToGroupInvitationDto maps a GroupInvitation model to a GroupInvitationDto.
+func ToGroupInvitationDto(invitation *model.GroupInvitation) *dto.GroupInvitationDto {
+	return &dto.GroupInvitationDto{
+		ID:        invitation.ID,
+		Token:     invitation.Token,
+		GroupID:   invitation.GroupID,
+		CreatedBy: invitation.CreatedBy,
+		CreatedAt: invitation.CreatedAt,
+		ExpiresAt: invitation.ExpiresAt,
+		MaxUses:   invitation.MaxUses,
+		Uses:      invitation.Uses,
+		Revoked:   invitation.Revoked,
+	}
+}
+
+// FromNewGroupInvitationDtoToGroupInvitation maps a NewGroupInvitationDto to a GroupInvitation model
+// of the given group, created by the given user. A missing expiry date is left to zero.
+func FromNewGroupInvitationDtoToGroupInvitation(newInvitationDto *dto.NewGroupInvitationDto, groupID, createdBy int64) *model.GroupInvitation {
+	invitation := &model.GroupInvitation{
+		GroupID:   groupID,
+		CreatedBy: &createdBy,
+		MaxUses:   newInvitationDto.MaxUses,
+	}
+
+	if newInvitationDto.ExpiresAt != nil {
+		invitation.ExpiresAt = *newInvitationDto.ExpiresAt
+	}
+
+	return invitation
+}
@@ -0,0 +1,77 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+/*** DATA ***/
+
+var invitationExpiresAt = time.Date(2025, time.March, 8, 12, 0, 0, 0, time.UTC)
+
+var invitation = model.GroupInvitation{
+	ID:        1,
+	Token:     "token",
+	GroupID:   2,
+	CreatedBy: new(int64(3)),
+	CreatedAt: groupCreatedAt,
+	ExpiresAt: invitationExpiresAt,
+	MaxUses:   new(int64(5)),
+	Uses:      1,
+	Revoked:   false,
+}
+
+var invitationDto = dto.GroupInvitationDto{
+	ID:        1,
+	Token:     "token",
+	GroupID:   2,
+	CreatedBy: new(int64(3)),
+	CreatedAt: groupCreatedAt,
+	ExpiresAt: invitationExpiresAt,
+	MaxUses:   new(int64(5)),
+	Uses:      1,
+	Revoked:   false,
+}
+
+/*** TESTS ***/
+
+func TestToGroupInvitationDto(t *testing.T) {
+	mappedDto := ToGroupInvitationDto(&invitation)
+
+	if !reflect.DeepEqual(mappedDto, &invitationDto) {
+		t.Errorf("ToGroupInvitationDto mapping failed: expected %+v, got %+v", invitationDto, *mappedDto)
+	}
+}
+
+func TestFromNewGroupInvitationDtoToGroupInvitation(t *testing.T) {
+	tests := []struct {
+		name     string
+		dto      dto.NewGroupInvitationDto
+		expected *model.GroupInvitation
+	}{
+		{
+			name:     "With expiry date and max uses",
+			dto:      dto.NewGroupInvitationDto{ExpiresAt: &invitationExpiresAt, MaxUses: new(int64(1))},
+			expected: &model.GroupInvitation{GroupID: 2, CreatedBy: new(int64(3)), ExpiresAt: invitationExpiresAt, MaxUses: new(int64(1))},
+		},
+		{
+			name:     "Without expiry date nor max uses",
+			dto:      dto.NewGroupInvitationDto{},
+			expected: &model.GroupInvitation{GroupID: 2, CreatedBy: new(int64(3))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapped := FromNewGroupInvitationDtoToGroupInvitation(&tt.dto, 2, 3)
+
+			if !reflect.DeepEqual(mapped, tt.expected) {
+				t.Errorf("FromNewGroupInvitationDtoToGroupInvitation mapping failed: expected %+v, got %+v", *tt.expected, *mapped)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+/*** DATA ***/
+
+var groupCreatedAt = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+var group = model.Group{
+	ID:        1,
+	Name:      "Family",
+	ImageURL:  new("/static/images/family.jpg"),
+	CreatedAt: groupCreatedAt,
+	Items:     []model.Item{{ID: 3}},
+	Members: []model.GroupMember{
+		{UserID: 2, GroupId: 1, Admin: true, JoinedAt: groupCreatedAt},
+		{UserID: 3, GroupId: 1, Admin: false, JoinedAt: groupCreatedAt},
+	},
+}
+
+var groupDto = dto.GroupDto{
+	ID:        1,
+	Name:      "Family",
+	ImageURL:  new("/static/images/family.jpg"),
+	CreatedAt: groupCreatedAt,
+	Members: []dto.GroupMemberDto{
+		{UserID: 2, Admin: true, JoinedAt: groupCreatedAt},
+		{UserID: 3, Admin: false, JoinedAt: groupCreatedAt},
+	},
+}
+
+/*** TESTS ***/
+
+func TestToGroupDto(t *testing.T) {
+	mappedDto := ToGroupDto(&group)
+
+	if !reflect.DeepEqual(mappedDto, &groupDto) {
+		t.Errorf("ToGroupDto mapping failed: expected %+v, got %+v", groupDto, *mappedDto)
+	}
+}
+
+func TestToGroupDtoNoMembers(t *testing.T) {
+	mappedDto := ToGroupDto(&model.Group{ID: 3, Name: "Work"})
+
+	if mappedDto.Members == nil || len(mappedDto.Members) != 0 {
+		t.Errorf("ToGroupDto expected an empty members list, got %+v", mappedDto.Members)
+	}
+}
+
+func TestFromNewGroupDtoToGroup(t *testing.T) {
+	mappedGroup := FromNewGroupDtoToGroup(&dto.NewGroupDto{Name: "Family", ImageURL: new("/static/images/family.jpg")})
+
+	expected := &model.Group{
+		Name:     "Family",
+		ImageURL: new("/static/images/family.jpg"),
+	}
+
+	if !reflect.DeepEqual(mappedGroup, expected) {
+		t.Errorf("FromNewGroupDtoToGroup mapping failed: expected %+v, got %+v", *expected, *mappedGroup)
+	}
+}
+
+func TestFromNewGroupMemberDtoToGroupMember(t *testing.T) {
+	mappedMember := FromNewGroupMemberDtoToGroupMember(&dto.NewGroupMemberDto{UserID: 4, Admin: true}, 2)
+
+	expected := &model.GroupMember{UserID: 4, GroupId: 2, Admin: true}
+
+	if !reflect.DeepEqual(mappedMember, expected) {
+		t.Errorf("FromNewGroupMemberDtoToGroupMember mapping failed: expected %+v, got %+v", *expected, *mappedMember)
+	}
+}
@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToItemDto maps an Item model to an ItemDto, without its ingredients.
+func ToItemDto(item *model.Item) *dto.ItemDto {
+	return &dto.ItemDto{
+		ID:                 item.ID,
+		Name:               item.Name,
+		Description:        item.Description,
+		AverageMarketPrice: item.AverageMarketPrice,
+		UnitType:           item.UnitType,
+		GroupID:            item.GroupID,
+		ItemCategory: dto.ItemCategorySummaryDto{
+			ID:   item.ItemCategory.ID,
+			Name: item.ItemCategory.Name,
+		},
+	}
+}
+
+// FromNewItemDtoToItem maps a NewItemDto to an Item model belonging to the given group
+// (used when creating or updating an item).
+func FromNewItemDtoToItem(newItemDto *dto.NewItemDto, groupID int64) *model.Item {
+	return &model.Item{
+		Name:               newItemDto.Name,
+		Description:        newItemDto.Description,
+		AverageMarketPrice: newItemDto.AverageMarketPrice,
+		UnitType:           newItemDto.UnitType,
+		GroupID:            groupID,
+		ItemCategory: model.ItemCategory{
+			ID: newItemDto.ItemCategoryID,
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToItemCategoryDto maps an ItemCategory model to an ItemCategoryDto, without its items.
+func ToItemCategoryDto(category *model.ItemCategory) *dto.ItemCategoryDto {
+	return &dto.ItemCategoryDto{
+		ID:       category.ID,
+		Name:     category.Name,
+		Position: category.Position,
+	}
+}
+
+// FromNewItemCategoryDtoToItemCategory maps a NewItemCategoryDto to an ItemCategory model belonging to the given group
+// (used when creating or renaming an item category).
+func FromNewItemCategoryDtoToItemCategory(newCategoryDto *dto.NewItemCategoryDto, groupID int64) *model.ItemCategory {
+	return &model.ItemCategory{
+		Name:    newCategoryDto.Name,
+		GroupID: groupID,
+	}
+}
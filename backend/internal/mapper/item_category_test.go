@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+func TestToItemCategoryDto(t *testing.T) {
+	category := model.ItemCategory{
+		ID:       4,
+		Name:     "DAIRY",
+		GroupID:  1,
+		Position: 3,
+		Items:    []model.Item{{ID: 4}},
+	}
+
+	expected := &dto.ItemCategoryDto{ID: 4, Name: "DAIRY", Position: 3}
+
+	if actual := ToItemCategoryDto(&category); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToItemCategoryDto mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
+
+func TestFromNewItemCategoryDtoToItemCategory(t *testing.T) {
+	newCategoryDto := dto.NewItemCategoryDto{Name: "FROZEN"}
+
+	expected := &model.ItemCategory{Name: "FROZEN", GroupID: 2}
+
+	if actual := FromNewItemCategoryDtoToItemCategory(&newCategoryDto, 2); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromNewItemCategoryDtoToItemCategory mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
@@ -0,0 +1,68 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+/*** DATA ***/
+
+var item = model.Item{
+	ID:                 1,
+	Name:               "Flour",
+	Description:        new("All-purpose flour"),
+	AverageMarketPrice: new(2.50),
+	UnitType:           enum.Weight,
+	GroupID:            1,
+	ItemCategory:       model.ItemCategory{ID: 2, Name: "GRAINS AND PASTA", GroupID: 1},
+	Ingredients:        []model.Ingredient{{ID: 3}},
+}
+
+var itemDto = dto.ItemDto{
+	ID:                 1,
+	Name:               "Flour",
+	Description:        new("All-purpose flour"),
+	AverageMarketPrice: new(2.50),
+	UnitType:           enum.Weight,
+	GroupID:            1,
+	ItemCategory:       dto.ItemCategorySummaryDto{ID: 2, Name: "GRAINS AND PASTA"},
+}
+
+var newItemDto = dto.NewItemDto{
+	Name:               "Flour",
+	Description:        new("All-purpose flour"),
+	AverageMarketPrice: new(2.50),
+	UnitType:           enum.Weight,
+	ItemCategoryID:     2,
+}
+
+/*** TESTS ***/
+
+func TestToItemDto(t *testing.T) {
+	mappedDto := ToItemDto(&item)
+
+	if !reflect.DeepEqual(mappedDto, &itemDto) {
+		t.Errorf("ToItemDto mapping failed: expected %+v, got %+v", itemDto, *mappedDto)
+	}
+}
+
+func TestFromNewItemDtoToItem(t *testing.T) {
+	mappedItem := FromNewItemDtoToItem(&newItemDto, 1)
+
+	expected := &model.Item{
+		Name:               "Flour",
+		Description:        new("All-purpose flour"),
+		AverageMarketPrice: new(2.50),
+		UnitType:           enum.Weight,
+		GroupID:            1,
+		ItemCategory:       model.ItemCategory{ID: 2},
+	}
+
+	if !reflect.DeepEqual(mappedItem, expected) {
+		t.Errorf("FromNewItemDtoToItem mapping failed: expected %+v, got %+v", *expected, *mappedItem)
+	}
+}
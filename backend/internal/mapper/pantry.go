@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToPantryItemDto maps a PantryItem model to a PantryItemDto.
+func ToPantryItemDto(pantryItem *model.PantryItem) dto.PantryItemDto {
+	return dto.PantryItemDto{
+		ID:       pantryItem.ID,
+		Quantity: pantryItem.Quantity,
+		Item:     dto.ItemSummaryDto{ID: pantryItem.Item.ID, Name: pantryItem.Item.Name},
+		Unit:     dto.UnitSummaryDto{ID: pantryItem.Unit.ID, Name: pantryItem.Unit.Name},
+	}
+}
+
+// FromNewPantryItemDtoToPantryItem maps a NewPantryItemDto to a PantryItem model belonging to the given group
+// (used when stocking or updating a pantry line).
+func FromNewPantryItemDtoToPantryItem(newPantryItemDto *dto.NewPantryItemDto, groupID int64) *model.PantryItem {
+	return &model.PantryItem{
+		Quantity: newPantryItemDto.Quantity,
+		Item:     model.Item{ID: newPantryItemDto.ItemID},
+		Unit:     model.Unit{ID: newPantryItemDto.UnitID},
+		GroupID:  groupID,
+	}
+}
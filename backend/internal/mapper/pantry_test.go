@@ -0,0 +1,29 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+func TestToPantryItemDto(t *testing.T) {
+	pantryItem := model.PantryItem{ID: 2, Quantity: 6, GroupID: 1,
+		Item: model.Item{ID: 4, Name: "Eggs"}, Unit: model.Unit{ID: 8, Name: "Piece"}}
+	expected := dto.PantryItemDto{ID: 2, Quantity: 6,
+		Item: dto.ItemSummaryDto{ID: 4, Name: "Eggs"}, Unit: dto.UnitSummaryDto{ID: 8, Name: "Piece"}}
+
+	if actual := ToPantryItemDto(&pantryItem); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToPantryItemDto mapping failed: expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestFromNewPantryItemDtoToPantryItem(t *testing.T) {
+	newPantryItemDto := dto.NewPantryItemDto{Quantity: 500, ItemID: 1, UnitID: 2}
+	expected := &model.PantryItem{Quantity: 500, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 2}, GroupID: 1}
+
+	if actual := FromNewPantryItemDtoToPantryItem(&newPantryItemDto, 1); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromNewPantryItemDtoToPantryItem mapping failed: expected %+v, got %+v", expected, actual)
+	}
+}
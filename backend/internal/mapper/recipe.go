@@ -0,0 +1,142 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToRecipeSummaryDto maps a Recipe model to a RecipeSummaryDto, used when listing recipes.
+func ToRecipeSummaryDto(recipe *model.Recipe) *dto.RecipeSummaryDto {
+	return &dto.RecipeSummaryDto{
+		ID:                 recipe.ID,
+		Name:               recipe.Name,
+		ImageURL:           recipe.ImageURL,
+		PreparationTimeMin: recipe.PreparationTimeMin,
+		CookingTimeMin:     recipe.CookingTimeMin,
+		Servings:           recipe.Servings,
+	}
+}
+
+// ToRecipeDto maps a Recipe model to a RecipeDto, with its categories and ingredients but without its dishes.
+func ToRecipeDto(recipe *model.Recipe) *dto.RecipeDto {
+	return &dto.RecipeDto{
+		ID:                 recipe.ID,
+		Name:               recipe.Name,
+		Description:        recipe.Description,
+		ImageURL:           recipe.ImageURL,
+		OriginalLink:       recipe.OriginalLink,
+		PreparationTimeMin: recipe.PreparationTimeMin,
+		CookingTimeMin:     recipe.CookingTimeMin,
+		Servings:           recipe.Servings,
+		Instructions:       recipe.Instructions,
+		CreatedAt:          recipe.CreatedAt,
+		Public:             recipe.Public,
+		Comment:            recipe.Comment,
+		GroupID:            recipe.GroupID,
+		Categories: MapList(recipe.Categories, func(c *model.RecipeCategory) dto.RecipeCategoryDto {
+			return *ToRecipeCategoryDto(c)
+		}),
+		Ingredients: MapList(recipe.Ingredients, ToIngredientDto),
+	}
+}
+
+// ToPublicRecipeDto maps a public Recipe model to a PublicRecipeDto, without its private fields.
+func ToPublicRecipeDto(recipe *model.Recipe) *dto.PublicRecipeDto {
+	return &dto.PublicRecipeDto{
+		ID:                 recipe.ID,
+		Name:               recipe.Name,
+		Description:        recipe.Description,
+		ImageURL:           recipe.ImageURL,
+		OriginalLink:       recipe.OriginalLink,
+		PreparationTimeMin: recipe.PreparationTimeMin,
+		CookingTimeMin:     recipe.CookingTimeMin,
+		Servings:           recipe.Servings,
+		Instructions:       recipe.Instructions,
+		CreatedAt:          recipe.CreatedAt,
+		Categories: MapList(recipe.Categories, func(c *model.RecipeCategory) string {
+			return c.Name
+		}),
+		Ingredients: MapList(recipe.Ingredients, func(ing *model.Ingredient) dto.PublicIngredientDto {
+			return dto.PublicIngredientDto{Quantity: ing.Quantity, Item: ing.Item.Name, Unit: ing.Unit.Name}
+		}),
+	}
+}
+
+// ToIngredientDto maps an Ingredient model to an IngredientDto, with a summary of its item and unit.
+func ToIngredientDto(ing *model.Ingredient) dto.IngredientDto {
+	return dto.IngredientDto{
+		ID:       ing.ID,
+		Quantity: ing.Quantity,
+		Item:     dto.ItemSummaryDto{ID: ing.Item.ID, Name: ing.Item.Name},
+		Unit:     dto.UnitSummaryDto{ID: ing.Unit.ID, Name: ing.Unit.Name},
+	}
+}
+
+// ToRecipeMatchDto maps a RecipeMatch model to a RecipeMatchDto, with a summary of its recipe.
+func ToRecipeMatchDto(match *model.RecipeMatch) dto.RecipeMatchDto {
+	return dto.RecipeMatchDto{
+		Recipe:             *ToRecipeSummaryDto(&match.Recipe),
+		Coverage:           match.Coverage,
+		MissingIngredients: MapList(match.MissingIngredients, ToIngredientDto),
+	}
+}
+
+// ToRecipeDraftDto maps a RecipeDraft model to a RecipeDraftDto, with its whole recipe.
+func ToRecipeDraftDto(draft *model.RecipeDraft) dto.RecipeDraftDto {
+	return dto.RecipeDraftDto{
+		Recipe:               *ToRecipeDto(&draft.Recipe),
+		UnmatchedIngredients: draft.UnmatchedIngredients,
+	}
+}
+
+// ToParsedIngredientDto maps a ParsedIngredient model to a ParsedIngredientDto, with a summary of its unit and item.
+// Its new item is mapped to a NewItemDto, ready to be created in the "Uncategorized" category of the group.
+func ToParsedIngredientDto(parsed *model.ParsedIngredient) dto.ParsedIngredientDto {
+	parsedDto := dto.ParsedIngredientDto{
+		Line:        parsed.Line,
+		Quantity:    parsed.Quantity,
+		MinQuantity: parsed.MinQuantity,
+		Note:        parsed.Note,
+	}
+
+	if parsed.Unit != nil {
+		parsedDto.Unit = &dto.UnitSummaryDto{ID: parsed.Unit.ID, Name: parsed.Unit.Name}
+	}
+	if parsed.Item != nil {
+		parsedDto.Item = &dto.ItemSummaryDto{ID: parsed.Item.ID, Name: parsed.Item.Name}
+	}
+	if parsed.NewItem != nil {
+		parsedDto.NewItem = &dto.NewItemDto{Name: parsed.NewItem.Name, UnitType: parsed.NewItem.UnitType}
+	}
+
+	return parsedDto
+}
+
+// FromNewRecipeDtoToRecipe maps a NewRecipeDto to a Recipe model belonging to the given group
+// (used when creating or updating a recipe). Categories and ingredients only reference their item, unit and category IDs.
+func FromNewRecipeDtoToRecipe(newRecipeDto *dto.NewRecipeDto, groupID int64) *model.Recipe {
+	return &model.Recipe{
+		Name:               newRecipeDto.Name,
+		Description:        newRecipeDto.Description,
+		ImageURL:           newRecipeDto.ImageURL,
+		OriginalLink:       newRecipeDto.OriginalLink,
+		PreparationTimeMin: newRecipeDto.PreparationTimeMin,
+		CookingTimeMin:     newRecipeDto.CookingTimeMin,
+		Servings:           newRecipeDto.Servings,
+		Instructions:       newRecipeDto.Instructions,
+		Public:             newRecipeDto.Public,
+		Comment:            newRecipeDto.Comment,
+		GroupID:            groupID,
+		Categories: MapList(newRecipeDto.CategoryIDs, func(id *int64) model.RecipeCategory {
+			return model.RecipeCategory{ID: *id}
+		}),
+		Ingredients: MapList(newRecipeDto.Ingredients, func(ing *dto.NewIngredientDto) model.Ingredient {
+			return model.Ingredient{
+				ID:       ing.ID,
+				Quantity: ing.Quantity,
+				Item:     model.Item{ID: ing.ItemID},
+				Unit:     model.Unit{ID: ing.UnitID},
+			}
+		}),
+	}
+}
@@ -0,0 +1,101 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToRecipeBundleDto maps a RecipeBundle model to a RecipeBundleDto, references being mapped to names.
+func ToRecipeBundleDto(bundle *model.RecipeBundle) *dto.RecipeBundleDto {
+	return &dto.RecipeBundleDto{
+		Version: bundle.Version,
+		Units: MapList(bundle.Units, func(unit *model.Unit) dto.BundleUnitDto {
+			return dto.BundleUnitDto{Name: unit.Name, Factor: unit.Factor, UnitType: unit.UnitType}
+		}),
+		ItemCategories: MapList(bundle.ItemCategories, func(category *model.ItemCategory) string {
+			return category.Name
+		}),
+		Items: MapList(bundle.Items, func(item *model.Item) dto.BundleItemDto {
+			return dto.BundleItemDto{
+				Name:               item.Name,
+				Description:        item.Description,
+				AverageMarketPrice: item.AverageMarketPrice,
+				UnitType:           item.UnitType,
+				ItemCategory:       item.ItemCategory.Name,
+			}
+		}),
+		RecipeCategories: MapList(bundle.RecipeCategories, func(category *model.RecipeCategory) string {
+			return category.Name
+		}),
+		Recipes: MapList(bundle.Recipes, func(recipe *model.Recipe) dto.BundleRecipeDto {
+			return dto.BundleRecipeDto{
+				Name:               recipe.Name,
+				Description:        recipe.Description,
+				ImageURL:           recipe.ImageURL,
+				OriginalLink:       recipe.OriginalLink,
+				PreparationTimeMin: recipe.PreparationTimeMin,
+				CookingTimeMin:     recipe.CookingTimeMin,
+				Servings:           recipe.Servings,
+				Instructions:       recipe.Instructions,
+				Public:             recipe.Public,
+				Comment:            recipe.Comment,
+				Categories: MapList(recipe.Categories, func(category *model.RecipeCategory) string {
+					return category.Name
+				}),
+				Ingredients: MapList(recipe.Ingredients, func(ing *model.Ingredient) dto.BundleIngredientDto {
+					return dto.BundleIngredientDto{Quantity: ing.Quantity, Item: ing.Item.Name, Unit: ing.Unit.Name}
+				}),
+			}
+		}),
+	}
+}
+
+// FromRecipeBundleDtoToRecipeBundle maps a RecipeBundleDto to a RecipeBundle model (used when importing a bundle).
+// References are only made of names; the bundle doesn't belong to any group yet.
+func FromRecipeBundleDtoToRecipeBundle(bundleDto *dto.RecipeBundleDto) *model.RecipeBundle {
+	return &model.RecipeBundle{
+		Version: bundleDto.Version,
+		Units: MapList(bundleDto.Units, func(unit *dto.BundleUnitDto) model.Unit {
+			return model.Unit{Name: unit.Name, Factor: unit.Factor, UnitType: unit.UnitType}
+		}),
+		ItemCategories: MapList(bundleDto.ItemCategories, func(name *string) model.ItemCategory {
+			return model.ItemCategory{Name: *name}
+		}),
+		Items: MapList(bundleDto.Items, func(item *dto.BundleItemDto) model.Item {
+			return model.Item{
+				Name:               item.Name,
+				Description:        item.Description,
+				AverageMarketPrice: item.AverageMarketPrice,
+				UnitType:           item.UnitType,
+				ItemCategory:       model.ItemCategory{Name: item.ItemCategory},
+			}
+		}),
+		RecipeCategories: MapList(bundleDto.RecipeCategories, func(name *string) model.RecipeCategory {
+			return model.RecipeCategory{Name: *name}
+		}),
+		Recipes: MapList(bundleDto.Recipes, func(recipe *dto.BundleRecipeDto) model.Recipe {
+			return model.Recipe{
+				Name:               recipe.Name,
+				Description:        recipe.Description,
+				ImageURL:           recipe.ImageURL,
+				OriginalLink:       recipe.OriginalLink,
+				PreparationTimeMin: recipe.PreparationTimeMin,
+				CookingTimeMin:     recipe.CookingTimeMin,
+				Servings:           recipe.Servings,
+				Instructions:       recipe.Instructions,
+				Public:             recipe.Public,
+				Comment:            recipe.Comment,
+				Categories: MapList(recipe.Categories, func(name *string) model.RecipeCategory {
+					return model.RecipeCategory{Name: *name}
+				}),
+				Ingredients: MapList(recipe.Ingredients, func(ing *dto.BundleIngredientDto) model.Ingredient {
+					return model.Ingredient{
+						Quantity: ing.Quantity,
+						Item:     model.Item{Name: ing.Item},
+						Unit:     model.Unit{Name: ing.Unit},
+					}
+				}),
+			}
+		}),
+	}
+}
@@ -0,0 +1,58 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+var (
+	testRecipeBundle = model.RecipeBundle{
+		Version:          1,
+		Units:            []model.Unit{{Name: "Gram", Factor: 1, UnitType: enum.Weight}},
+		ItemCategories:   []model.ItemCategory{{Name: "PANTRY"}},
+		Items:            []model.Item{{Name: "Flour", Description: new("All-purpose flour"), UnitType: enum.Weight, ItemCategory: model.ItemCategory{Name: "PANTRY"}}},
+		RecipeCategories: []model.RecipeCategory{{Name: "DESSERT"}},
+		Recipes: []model.Recipe{
+			{
+				Name:        "Pancakes",
+				Servings:    new(4),
+				Public:      true,
+				Categories:  []model.RecipeCategory{{Name: "DESSERT"}},
+				Ingredients: []model.Ingredient{{Quantity: new(250.0), Item: model.Item{Name: "Flour"}, Unit: model.Unit{Name: "Gram"}}},
+			},
+		},
+	}
+
+	testRecipeBundleDto = dto.RecipeBundleDto{
+		Version:          1,
+		Units:            []dto.BundleUnitDto{{Name: "Gram", Factor: 1, UnitType: enum.Weight}},
+		ItemCategories:   []string{"PANTRY"},
+		Items:            []dto.BundleItemDto{{Name: "Flour", Description: new("All-purpose flour"), UnitType: enum.Weight, ItemCategory: "PANTRY"}},
+		RecipeCategories: []string{"DESSERT"},
+		Recipes: []dto.BundleRecipeDto{
+			{
+				Name:        "Pancakes",
+				Servings:    new(4),
+				Public:      true,
+				Categories:  []string{"DESSERT"},
+				Ingredients: []dto.BundleIngredientDto{{Quantity: new(250.0), Item: "Flour", Unit: "Gram"}},
+			},
+		},
+	}
+)
+
+func TestToRecipeBundleDto(t *testing.T) {
+	if actual := ToRecipeBundleDto(&testRecipeBundle); !reflect.DeepEqual(*actual, testRecipeBundleDto) {
+		t.Errorf("ToRecipeBundleDto mapping failed: expected %+v, got %+v", testRecipeBundleDto, *actual)
+	}
+}
+
+func TestFromRecipeBundleDtoToRecipeBundle(t *testing.T) {
+	if actual := FromRecipeBundleDtoToRecipeBundle(&testRecipeBundleDto); !reflect.DeepEqual(*actual, testRecipeBundle) {
+		t.Errorf("FromRecipeBundleDtoToRecipeBundle mapping failed: expected %+v, got %+v", testRecipeBundle, *actual)
+	}
+}
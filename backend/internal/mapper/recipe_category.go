@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToRecipeCategoryDto maps a RecipeCategory model to a RecipeCategoryDto, without its recipes.
+func ToRecipeCategoryDto(category *model.RecipeCategory) *dto.RecipeCategoryDto {
+	return &dto.RecipeCategoryDto{
+		ID:   category.ID,
+		Name: category.Name,
+	}
+}
+
+// FromNewRecipeCategoryDtoToRecipeCategory maps a NewRecipeCategoryDto to a RecipeCategory model belonging to the given group
+// (used when creating or renaming a recipe category).
+func FromNewRecipeCategoryDtoToRecipeCategory(newCategoryDto *dto.NewRecipeCategoryDto, groupID int64) *model.RecipeCategory {
+	return &model.RecipeCategory{
+		Name:    newCategoryDto.Name,
+		GroupID: groupID,
+	}
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+func TestToRecipeCategoryDto(t *testing.T) {
+	category := model.RecipeCategory{
+		ID:      1,
+		Name:    "DESSERT",
+		GroupID: 1,
+		Recipes: []model.Recipe{{ID: 2}},
+	}
+
+	expected := &dto.RecipeCategoryDto{ID: 1, Name: "DESSERT"}
+
+	if actual := ToRecipeCategoryDto(&category); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToRecipeCategoryDto mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
+
+func TestFromNewRecipeCategoryDtoToRecipeCategory(t *testing.T) {
+	newCategoryDto := dto.NewRecipeCategoryDto{Name: "SOUP"}
+
+	expected := &model.RecipeCategory{Name: "SOUP", GroupID: 2}
+
+	if actual := FromNewRecipeCategoryDtoToRecipeCategory(&newCategoryDto, 2); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromNewRecipeCategoryDtoToRecipeCategory mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
@@ -0,0 +1,244 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+func TestToRecipeSummaryDto(t *testing.T) {
+	recipe := model.Recipe{
+		ID:                 1,
+		Name:               "Grilled Chicken",
+		Description:        new("Simple grilled chicken breast with herbs"),
+		ImageURL:           new("/static/recipes/chicken.jpg"),
+		PreparationTimeMin: new(10),
+		CookingTimeMin:     new(20),
+		Servings:           new(4),
+		Comment:            new("comment"),
+		GroupID:            1,
+	}
+
+	expected := &dto.RecipeSummaryDto{
+		ID:                 1,
+		Name:               "Grilled Chicken",
+		ImageURL:           new("/static/recipes/chicken.jpg"),
+		PreparationTimeMin: new(10),
+		CookingTimeMin:     new(20),
+		Servings:           new(4),
+	}
+
+	if actual := ToRecipeSummaryDto(&recipe); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToRecipeSummaryDto mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
+
+func TestToRecipeDto(t *testing.T) {
+	createdAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	recipe := model.Recipe{
+		ID:           2,
+		Name:         "Chocolate Chip Cookies",
+		OriginalLink: new("https://example.com/cookies"),
+		Servings:     new(24),
+		Instructions: new("Mix ingredients and bake at 350F"),
+		CreatedAt:    createdAt,
+		Public:       true,
+		Comment:      new("Family favorite!"),
+		GroupID:      1,
+		Categories:   []model.RecipeCategory{{ID: 1, Name: "DESSERT", GroupID: 1}},
+		Ingredients: []model.Ingredient{
+			{ID: 4, Quantity: new(2.0), Item: model.Item{ID: 1, Name: "Flour"}, Unit: model.Unit{ID: 1, Name: "Kilogram"}, RecipeID: 2},
+		},
+		Dishes: []model.Dish{{ID: 1}},
+	}
+
+	expected := &dto.RecipeDto{
+		ID:           2,
+		Name:         "Chocolate Chip Cookies",
+		OriginalLink: new("https://example.com/cookies"),
+		Servings:     new(24),
+		Instructions: new("Mix ingredients and bake at 350F"),
+		CreatedAt:    createdAt,
+		Public:       true,
+		Comment:      new("Family favorite!"),
+		GroupID:      1,
+		Categories:   []dto.RecipeCategoryDto{{ID: 1, Name: "DESSERT"}},
+		Ingredients: []dto.IngredientDto{
+			{ID: 4, Quantity: new(2.0), Item: dto.ItemSummaryDto{ID: 1, Name: "Flour"}, Unit: dto.UnitSummaryDto{ID: 1, Name: "Kilogram"}},
+		},
+	}
+
+	if actual := ToRecipeDto(&recipe); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToRecipeDto mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
+
+func TestToPublicRecipeDto(t *testing.T) {
+	createdAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	recipe := model.Recipe{
+		ID:         2,
+		Name:       "Chocolate Chip Cookies",
+		Servings:   new(24),
+		CreatedAt:  createdAt,
+		Public:     true,
+		Comment:    new("Family favorite!"),
+		GroupID:    1,
+		Categories: []model.RecipeCategory{{ID: 1, Name: "DESSERT", GroupID: 1}},
+		Ingredients: []model.Ingredient{
+			{ID: 4, Quantity: new(2.0), Item: model.Item{ID: 1, Name: "Flour"}, Unit: model.Unit{ID: 1, Name: "Kilogram"}, RecipeID: 2},
+		},
+	}
+
+	expected := &dto.PublicRecipeDto{
+		ID:          2,
+		Name:        "Chocolate Chip Cookies",
+		Servings:    new(24),
+		CreatedAt:   createdAt,
+		Categories:  []string{"DESSERT"},
+		Ingredients: []dto.PublicIngredientDto{{Quantity: new(2.0), Item: "Flour", Unit: "Kilogram"}},
+	}
+
+	if actual := ToPublicRecipeDto(&recipe); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToPublicRecipeDto mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
+
+func TestToRecipeMatchDto(t *testing.T) {
+	match := model.RecipeMatch{
+		Recipe: model.Recipe{
+			ID:          3,
+			Name:        "Tomato Soup",
+			Servings:    new(6),
+			Description: new("Creamy tomato soup"),
+			GroupID:     2,
+		},
+		Coverage: 0.75,
+		MissingIngredients: []model.Ingredient{
+			{ID: 9, Quantity: new(1.0), Item: model.Item{ID: 9, Name: "Onions"}, Unit: model.Unit{ID: 8, Name: "Piece"}, RecipeID: 3},
+		},
+	}
+
+	expected := dto.RecipeMatchDto{
+		Recipe:   dto.RecipeSummaryDto{ID: 3, Name: "Tomato Soup", Servings: new(6)},
+		Coverage: 0.75,
+		MissingIngredients: []dto.IngredientDto{
+			{ID: 9, Quantity: new(1.0), Item: dto.ItemSummaryDto{ID: 9, Name: "Onions"}, Unit: dto.UnitSummaryDto{ID: 8, Name: "Piece"}},
+		},
+	}
+
+	if actual := ToRecipeMatchDto(&match); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToRecipeMatchDto mapping failed: expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestToRecipeDraftDto(t *testing.T) {
+	draft := model.RecipeDraft{
+		Recipe: model.Recipe{
+			Name:         "Fluffy Pancakes",
+			OriginalLink: new("https://cooking.example.com/fluffy-pancakes/"),
+			Servings:     new(4),
+			GroupID:      1,
+			Categories:   []model.RecipeCategory{},
+			Ingredients: []model.Ingredient{
+				{Quantity: new(250.0), Item: model.Item{ID: 1, Name: "Flour"}, Unit: model.Unit{ID: 2, Name: "Gram"}},
+			},
+		},
+		UnmatchedIngredients: []string{"a pinch of salt"},
+	}
+
+	expected := dto.RecipeDraftDto{
+		Recipe: dto.RecipeDto{
+			Name:         "Fluffy Pancakes",
+			OriginalLink: new("https://cooking.example.com/fluffy-pancakes/"),
+			Servings:     new(4),
+			GroupID:      1,
+			Categories:   []dto.RecipeCategoryDto{},
+			Ingredients: []dto.IngredientDto{
+				{Quantity: new(250.0), Item: dto.ItemSummaryDto{ID: 1, Name: "Flour"}, Unit: dto.UnitSummaryDto{ID: 2, Name: "Gram"}},
+			},
+		},
+		UnmatchedIngredients: []string{"a pinch of salt"},
+	}
+
+	if actual := ToRecipeDraftDto(&draft); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ToRecipeDraftDto mapping failed: expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestToParsedIngredientDto(t *testing.T) {
+	tests := []struct {
+		name     string
+		parsed   model.ParsedIngredient
+		expected dto.ParsedIngredientDto
+	}{
+		{
+			name: "Known item",
+			parsed: model.ParsedIngredient{
+				Line: "2-3 tbsp olive oil, extra virgin", Quantity: new(3.0), MinQuantity: new(2.0), Note: "extra virgin",
+				Unit: &model.Unit{ID: 6, Name: "Tablespoon", Factor: 15, UnitType: enum.Volume},
+				Item: &model.Item{ID: 5, Name: "Olive Oil", UnitType: enum.Volume, GroupID: 1},
+			},
+			expected: dto.ParsedIngredientDto{
+				Line: "2-3 tbsp olive oil, extra virgin", Quantity: new(3.0), MinQuantity: new(2.0), Note: "extra virgin",
+				Unit: &dto.UnitSummaryDto{ID: 6, Name: "Tablespoon"},
+				Item: &dto.ItemSummaryDto{ID: 5, Name: "Olive Oil"},
+			},
+		},
+		{
+			name: "New item",
+			parsed: model.ParsedIngredient{
+				Line:    "sel",
+				NewItem: &model.Item{Name: "Sel", UnitType: enum.Undefined, GroupID: 1},
+			},
+			expected: dto.ParsedIngredientDto{
+				Line:    "sel",
+				NewItem: &dto.NewItemDto{Name: "Sel", UnitType: enum.Undefined},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ToParsedIngredientDto(&tt.parsed); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("ToParsedIngredientDto mapping failed: expected %+v, got %+v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFromNewRecipeDtoToRecipe(t *testing.T) {
+	newRecipeDto := dto.NewRecipeDto{
+		Name:           "Crepes",
+		Description:    new("Thin pancakes"),
+		CookingTimeMin: new(15),
+		Servings:       new(4),
+		Public:         true,
+		CategoryIDs:    []int64{1, 6},
+		Ingredients: []dto.NewIngredientDto{
+			{ID: 3, Quantity: new(250.0), ItemID: 1, UnitID: 2},
+			{ItemID: 4, UnitID: 8},
+		},
+	}
+
+	expected := &model.Recipe{
+		Name:           "Crepes",
+		Description:    new("Thin pancakes"),
+		CookingTimeMin: new(15),
+		Servings:       new(4),
+		Public:         true,
+		GroupID:        1,
+		Categories:     []model.RecipeCategory{{ID: 1}, {ID: 6}},
+		Ingredients: []model.Ingredient{
+			{ID: 3, Quantity: new(250.0), Item: model.Item{ID: 1}, Unit: model.Unit{ID: 2}},
+			{Item: model.Item{ID: 4}, Unit: model.Unit{ID: 8}},
+		},
+	}
+
+	if actual := FromNewRecipeDtoToRecipe(&newRecipeDto, 1); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromNewRecipeDtoToRecipe mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
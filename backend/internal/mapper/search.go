@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	"html"
+	"strings"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// highlightReplacer wraps the highlighted terms of a search hit in <mark> elements.
+var highlightReplacer = strings.NewReplacer(model.HighlightStart, "<mark>", model.HighlightEnd, "</mark>")
+
+// ToSearchHitDto maps a SearchHit model to a SearchHitDto.
+// Its texts are HTML escaped before their matching terms are highlighted, so that they can be safely rendered as HTML.
+func ToSearchHitDto(hit *model.SearchHit) dto.SearchHitDto {
+	return dto.SearchHitDto{
+		Kind:    hit.Kind,
+		ID:      hit.ID,
+		Name:    highlightReplacer.Replace(html.EscapeString(hit.Name)),
+		Snippet: highlightReplacer.Replace(html.EscapeString(hit.Snippet)),
+		Rank:    hit.Rank,
+	}
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+func TestToSearchHitDto(t *testing.T) {
+	tests := []struct {
+		name     string
+		hit      model.SearchHit
+		expected dto.SearchHitDto
+	}{
+		{
+			name:     "Highlighted terms",
+			hit:      model.SearchHit{Kind: model.SearchHitRecipe, ID: 3, Name: "\x02Tomato\x03 Soup", Snippet: "…ripe \x02tomatoes\x03 and…", Rank: -1.5},
+			expected: dto.SearchHitDto{Kind: "recipe", ID: 3, Name: "<mark>Tomato</mark> Soup", Snippet: "…ripe <mark>tomatoes</mark> and…", Rank: -1.5},
+		},
+		{
+			name:     "Escaped HTML",
+			hit:      model.SearchHit{Kind: model.SearchHitItem, ID: 8, Name: "<b>Salt</b> & \x02Pepper\x03", Snippet: "<script>\x02pepper\x03</script>"},
+			expected: dto.SearchHitDto{Kind: "item", ID: 8, Name: "&lt;b&gt;Salt&lt;/b&gt; &amp; <mark>Pepper</mark>", Snippet: "&lt;script&gt;<mark>pepper</mark>&lt;/script&gt;"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ToSearchHitDto(&tt.hit); actual != tt.expected {
+				t.Errorf("ToSearchHitDto mapping failed: expected %+v, got %+v", tt.expected, actual)
+			}
+		})
+	}
+}
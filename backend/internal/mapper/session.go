@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"net"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/useragent"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+// ToSessionDto maps a Session model to a SessionDto, marking it as current if its ID is currentID.
+// The session ID is replaced by its digest and the port is removed from the IP address.
+func ToSessionDto(session *model.Session, currentID string) *dto.SessionDto {
+	agent := useragent.Parse(session.UserAgent)
+
+	ip := session.IPAddress
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	return &dto.SessionDto{
+		ID:           utils.HashToken(session.ID),
+		CreatedAt:    session.CreatedAt,
+		LastActivity: session.LastActivity,
+		IPAddress:    ip,
+		Browser:      agent.Browser,
+		OS:           agent.OS,
+		Label:        agent.Label(),
+		Current:      session.ID == currentID,
+	}
+}
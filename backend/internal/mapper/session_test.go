@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+/*** DATA ***/
+
+var sessionLastActivity = time.Date(2025, time.March, 9, 18, 30, 0, 0, time.UTC)
+
+var session = model.Session{
+	ID:           "session-id",
+	CreatedAt:    groupCreatedAt,
+	LastActivity: sessionLastActivity,
+	IPAddress:    "192.0.2.1:54321",
+	UserAgent:    "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0",
+	UserID:       new(int64(1)),
+}
+
+/*** TESTS ***/
+
+func TestToSessionDto(t *testing.T) {
+	expected := &dto.SessionDto{
+		ID:           utils.HashToken("session-id"),
+		CreatedAt:    groupCreatedAt,
+		LastActivity: sessionLastActivity,
+		IPAddress:    "192.0.2.1",
+		Browser:      "Firefox",
+		OS:           "Linux",
+		Label:        "Firefox on Linux",
+		Current:      true,
+	}
+
+	mappedDto := ToSessionDto(&session, "session-id")
+	if !reflect.DeepEqual(mappedDto, expected) {
+		t.Errorf("ToSessionDto mapping failed: expected %+v, got %+v", *expected, *mappedDto)
+	}
+
+	if ToSessionDto(&session, "other-session-id").Current {
+		t.Error("ToSessionDto should not mark a session as current when its ID isn't the current one")
+	}
+}
+
+func TestToSessionDto_AddressWithoutPort(t *testing.T) {
+	s := session
+	s.IPAddress = "192.0.2.1"
+
+	if mappedDto := ToSessionDto(&s, ""); mappedDto.IPAddress != "192.0.2.1" {
+		t.Errorf("expected IP address %q, got %q", "192.0.2.1", mappedDto.IPAddress)
+	}
+}
@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+// ToUnitDto maps a Unit model to a UnitDto.
+func ToUnitDto(unit *model.Unit) *dto.UnitDto {
+	return &dto.UnitDto{
+		ID:       unit.ID,
+		Name:     unit.Name,
+		Factor:   unit.Factor,
+		UnitType: unit.UnitType,
+		Custom:   unit.GroupID != nil,
+	}
+}
+
+// FromNewUnitDtoToUnit maps a NewUnitDto to a custom Unit model belonging to the given group
+// (used when creating or updating a custom unit).
+func FromNewUnitDtoToUnit(newUnitDto *dto.NewUnitDto, groupID int64) *model.Unit {
+	return &model.Unit{
+		Name:     newUnitDto.Name,
+		Factor:   newUnitDto.Factor,
+		UnitType: newUnitDto.UnitType,
+		GroupID:  &groupID,
+	}
+}
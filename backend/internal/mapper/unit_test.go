@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/dto"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+func TestToUnitDto(t *testing.T) {
+	tests := []struct {
+		name     string
+		unit     model.Unit
+		expected *dto.UnitDto
+	}{
+		{
+			name:     "Catalogue unit",
+			unit:     model.Unit{ID: 1, Name: "Kilogram", Factor: 1000, UnitType: enum.Weight},
+			expected: &dto.UnitDto{ID: 1, Name: "Kilogram", Factor: 1000, UnitType: enum.Weight},
+		},
+		{
+			name:     "Custom unit",
+			unit:     model.Unit{ID: 22, Name: "Bowl", Factor: 250, UnitType: enum.Volume, GroupID: new(int64(1))},
+			expected: &dto.UnitDto{ID: 22, Name: "Bowl", Factor: 250, UnitType: enum.Volume, Custom: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ToUnitDto(&tt.unit); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("ToUnitDto mapping failed: expected %+v, got %+v", *tt.expected, *actual)
+			}
+		})
+	}
+}
+
+func TestFromNewUnitDtoToUnit(t *testing.T) {
+	newUnitDto := dto.NewUnitDto{Name: "Handful", Factor: 30, UnitType: enum.Weight}
+
+	expected := &model.Unit{Name: "Handful", Factor: 30, UnitType: enum.Weight, GroupID: new(int64(2))}
+
+	if actual := FromNewUnitDtoToUnit(&newUnitDto, 2); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromNewUnitDtoToUnit mapping failed: expected %+v, got %+v", *expected, *actual)
+	}
+}
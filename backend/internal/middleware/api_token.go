@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// APITokenInjector is a middleware authenticating the requests carrying an API token in their Authorization header,
+// in place of the session. It injects the user of the token into the request context, as UserInjector does,
+// along with the token itself; it must be stacked before UserInjector, which then lets the request through.
+// Unknown or expired tokens are rejected with an UnauthorizedError,
+// and read-only tokens with a ForbiddenError on requests with unsafe methods.
+func APITokenInjector(apiTokenService service.APITokenServiceInterface, userService service.UserServiceInterface) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			secret, ok := bearerToken(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, err := apiTokenService.Authenticate(secret)
+			if err != nil {
+				if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+					http.Error(w, err.Error(), appErr.HTTPStatus())
+					return
+				}
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			if token.Scope == enum.ReadScope && !isSafeMethod(r.Method) {
+				slog.Debug("rejected unsafe request of read-only API token", "token", token.ID, "method", r.Method)
+				http.Error(w, customErrors.API_TOKEN_SCOPE_ERROR, http.StatusForbidden)
+				return
+			}
+
+			user, err := userService.GetByID(token.UserID)
+			if err != nil {
+				if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+					http.Error(w, err.Error(), appErr.HTTPStatus())
+					return
+				}
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			slog.Debug("request authenticated by API token", "token", token.ID, "user", user.ID)
+
+			c := context.WithValue(r.Context(), ctx.UserCtxKey{}, user)
+			c = context.WithValue(c, ctx.APITokenCtxKey{}, token)
+			next.ServeHTTP(w, r.WithContext(c))
+		})
+	}
+}
+
+// NoAPIToken is a middleware rejecting the requests authenticated by an API token with a ForbiddenError,
+// e.g. on the routes managing the tokens, so that a leaked token can't be used to issue new ones.
+func NoAPIToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Context().Value(ctx.APITokenCtxKey{}) != nil {
+			http.Error(w, customErrors.NewForbiddenError(nil).Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken returns the API token sent in the Authorization header of the request, if any.
+func bearerToken(r *http.Request) (string, bool) {
+	return strings.CutPrefix(r.Header.Get(constant.AUTHORIZATION_HEADER), constant.BEARER_PREFIX)
+}
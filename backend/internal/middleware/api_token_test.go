@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+type mockAPITokenService struct {
+	tokens     map[string]*model.APIToken
+	authCalls  int
+	lastSecret string
+}
+
+func (m *mockAPITokenService) GetByUserID(userID int64) ([]model.APIToken, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockAPITokenService) Create(token *model.APIToken) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (m *mockAPITokenService) Revoke(userID, id int64) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockAPITokenService) Authenticate(secret string) (*model.APIToken, error) {
+	m.authCalls++
+	m.lastSecret = secret
+	if token, ok := m.tokens[secret]; ok {
+		return token, nil
+	}
+	return nil, customErrors.NewUnauthorizedError("invalid API token", nil)
+}
+
+/*** HELPERS ***/
+
+func newMockAPITokenService() *mockAPITokenService {
+	return &mockAPITokenService{
+		tokens: map[string]*model.APIToken{
+			"yum_read":  {ID: 1, UserID: 7, Scope: enum.ReadScope},
+			"yum_write": {ID: 2, UserID: 7, Scope: enum.WriteScope},
+		},
+	}
+}
+
+/*** TESTS ***/
+
+func TestAPITokenInjector(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		authorization  string
+		expectedStatus int
+		expectedToken  int64
+	}{
+		{"No Authorization header", http.MethodPost, "", http.StatusOK, 0},
+		{"Other authorization scheme", http.MethodGet, "Basic dXNlcjpwYXNz", http.StatusOK, 0},
+		{"Read token with safe method", http.MethodGet, "Bearer yum_read", http.StatusOK, 1},
+		{"Write token with unsafe method", http.MethodDelete, "Bearer yum_write", http.StatusOK, 2},
+		{"Read token with unsafe method", http.MethodPost, "Bearer yum_read", http.StatusForbidden, 0},
+		{"Unknown token", http.MethodGet, "Bearer yum_unknown", http.StatusUnauthorized, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user *model.User
+			var token *model.APIToken
+			handlerCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handlerCalled = true
+				user, _ = r.Context().Value(ctx.UserCtxKey{}).(*model.User)
+				token, _ = r.Context().Value(ctx.APITokenCtxKey{}).(*model.APIToken)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			r := httptest.NewRequest(tt.method, "/api/test", nil)
+			if tt.authorization != "" {
+				r.Header.Set(constant.AUTHORIZATION_HEADER, tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			APITokenInjector(newMockAPITokenService(), &mockUserService{})(next).ServeHTTP(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+			if handlerCalled != (tt.expectedStatus == http.StatusOK) {
+				t.Fatalf("expected handler called = %v, got %v", tt.expectedStatus == http.StatusOK, handlerCalled)
+			}
+
+			if tt.expectedToken == 0 {
+				if token != nil || user != nil {
+					t.Errorf("expected neither user nor token in context, got %+v and %+v", user, token)
+				}
+				return
+			}
+			if token == nil || token.ID != tt.expectedToken {
+				t.Errorf("expected token %d in context, got %+v", tt.expectedToken, token)
+			}
+			if user == nil || user.ID != 7 {
+				t.Errorf("expected user 7 in context, got %+v", user)
+			}
+		})
+	}
+}
+
+func TestAPITokenInjector_getByIDError(t *testing.T) {
+	userService := &mockUserService{getByIDErr: customErrors.NewNotFoundError("users", "id", nil)}
+	next := &mockSessionHandler{}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/test", nil)
+	r.Header.Set(constant.AUTHORIZATION_HEADER, "Bearer yum_read")
+	w := httptest.NewRecorder()
+
+	APITokenInjector(newMockAPITokenService(), userService)(next).ServeHTTP(w, r)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d instead of %d", http.StatusNotFound, w.Code)
+	}
+	if next.called {
+		t.Error("expected handler not to be called")
+	}
+}
+
+// TestAPITokenInjector_UserInjector verifies that UserInjector lets through the requests authenticated by an API token,
+// without any logged-in session.
+func TestAPITokenInjector_UserInjector(t *testing.T) {
+	userService := &mockUserService{}
+	next := &mockSessionHandler{}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/test", nil)
+	r.Header.Set(constant.AUTHORIZATION_HEADER, "Bearer yum_read")
+	r = r.WithContext(context.WithValue(r.Context(), ctx.SessionCtxKey{}, model.NewSession("", "")))
+	w := httptest.NewRecorder()
+
+	Stack(APITokenInjector(newMockAPITokenService(), userService), UserInjector(userService))(next).ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d instead of %d", http.StatusOK, w.Code)
+	}
+	if !next.called {
+		t.Error("expected handler to be called")
+	}
+	if userService.getByIDCalls != 1 {
+		t.Errorf("expected the user to be fetched once instead of %d times", userService.getByIDCalls)
+	}
+}
+
+// TestCSRF_APIToken verifies that the requests authenticated by an API token don't need any CSRF token.
+func TestCSRF_APIToken(t *testing.T) {
+	session := model.NewSession("", "")
+	next := &mockSessionHandler{}
+	rr := httptest.NewRecorder()
+
+	r := newCSRFRequest(http.MethodPost, session, session.ID, "")
+	r.Header.Set(constant.AUTHORIZATION_HEADER, "Bearer yum_write")
+
+	CSRF(newMockSessionService(session))(next).ServeHTTP(rr, r)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+	if !next.called {
+		t.Error("expected next handler to be called")
+	}
+}
+
+func TestNoAPIToken(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          *model.APIToken
+		expectedStatus int
+	}{
+		{"Session", nil, http.StatusOK},
+		{"API token", &model.APIToken{ID: 2, Scope: enum.WriteScope}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &mockSessionHandler{}
+
+			r := httptest.NewRequest(http.MethodGet, "/api/user/me/tokens", nil)
+			if tt.token != nil {
+				r = r.WithContext(context.WithValue(r.Context(), ctx.APITokenCtxKey{}, tt.token))
+			}
+			w := httptest.NewRecorder()
+
+			NoAPIToken(next).ServeHTTP(w, r)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, w.Code)
+			}
+			if next.called != (tt.expectedStatus == http.StatusOK) {
+				t.Errorf("expected handler called = %v, got %v", tt.expectedStatus == http.StatusOK, next.called)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// CSRF is a middleware protecting the cookie authenticated requests against cross-site request forgery.
+// It must be stacked after SessionInjector.
+//
+// The CSRF token of a session is derived from its ID, and sent in a cookie readable by JS.
+// The requests with unsafe methods carrying the session cookie must send it back in the X-CSRF-Token header,
+// which a cross-site page can't do since it can read neither the cookie nor the session ID.
+// The requests without the session cookie, or authenticated by an API token, aren't authenticated by the cookie,
+// so there's nothing to forge and they are let through.
+func CSRF(sessionService service.SessionServiceInterface) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, apiToken := bearerToken(r); !apiToken && !isSafeMethod(r.Method) {
+				// The token is checked against the session ID presented by the client,
+				// which can differ from the one of the session in context if it was expired or unknown.
+				if cookie, err := r.Cookie(sessionService.CookieName()); err == nil {
+					token := r.Header.Get(constant.CSRF_HEADER)
+					if subtle.ConstantTimeCompare([]byte(token), []byte(csrfToken(cookie.Value))) != 1 {
+						slog.Debug("rejected request without a valid CSRF token", "method", r.Method, "path", r.URL.Path)
+						http.Error(w, customErrors.CSRF_TOKEN_ERROR, http.StatusForbidden)
+						return
+					}
+				}
+			}
+
+			s, ok := r.Context().Value(ctx.SessionCtxKey{}).(*model.Session)
+			if !ok || s == nil {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+
+			// Like the session cookie, the token is sent right before the headers are written,
+			// since it changes along with the session ID.
+			writer := &beforeHeaderWriter{
+				ResponseWriter: w,
+				hook: func(w http.ResponseWriter) {
+					http.SetCookie(w, csrfCookie(sessionService, s))
+				},
+			}
+			next.ServeHTTP(writer, r)
+			writer.callHook()
+		})
+	}
+}
+
+// isSafeMethod reports whether an HTTP method is safe, i.e. doesn't change the state of the server.
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+// csrfToken derives the CSRF token of a session from its ID.
+// The prefix keeps it distinct from the digest identifying the session in the API.
+func csrfToken(sessionID string) string {
+	return utils.HashToken("csrf:" + sessionID)
+}
+
+// csrfCookie builds the cookie carrying the CSRF token of the session.
+// It has the same attributes as the session cookie, except that it is readable by JS.
+func csrfCookie(sessionService service.SessionServiceInterface, s *model.Session) *http.Cookie {
+	return &http.Cookie{
+		Name:     constant.CSRF_COOKIE_NAME,
+		Value:    csrfToken(s.ID),
+		HttpOnly: false,
+		Path:     "/",
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Now().Add(sessionService.Expiration()).UTC(),
+		MaxAge:   int(sessionService.Expiration().Seconds()),
+	}
+}
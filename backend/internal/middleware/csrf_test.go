@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+/*** HELPERS ***/
+
+// newCSRFRequest builds a request within the session, carrying the session cookie with the given value if any,
+// and the CSRF token if any.
+func newCSRFRequest(method string, session *model.Session, sessionCookie string, token string) *http.Request {
+	r := httptest.NewRequest(method, "/api/test", nil)
+	if sessionCookie != "" {
+		r.AddCookie(&http.Cookie{Name: cookieName, Value: sessionCookie})
+	}
+	if token != "" {
+		r.Header.Set(constant.CSRF_HEADER, token)
+	}
+	return r.WithContext(context.WithValue(r.Context(), ctx.SessionCtxKey{}, session))
+}
+
+// findCSRFCookie returns the CSRF cookie set in the response, or nil.
+func findCSRFCookie(rr *httptest.ResponseRecorder) *http.Cookie {
+	for _, c := range rr.Result().Cookies() {
+		if c.Name == constant.CSRF_COOKIE_NAME {
+			return c
+		}
+	}
+	return nil
+}
+
+/*** TESTS ***/
+
+func TestCSRF(t *testing.T) {
+	session := model.NewSession("", "")
+
+	tests := []struct {
+		name           string
+		method         string
+		sessionCookie  string
+		token          string
+		expectedStatus int
+	}{
+		{"Safe method without token", http.MethodGet, session.ID, "", http.StatusOK},
+		{"Head without token", http.MethodHead, session.ID, "", http.StatusOK},
+		{"Unsafe method with valid token", http.MethodPost, session.ID, csrfToken(session.ID), http.StatusOK},
+		{"Unsafe method without session cookie", http.MethodDelete, "", "", http.StatusOK},
+		{"Unsafe method with expired session cookie", http.MethodPut, "expired-session-id", csrfToken("expired-session-id"), http.StatusOK},
+		{"Unsafe method without token", http.MethodPost, session.ID, "", http.StatusForbidden},
+		{"Unsafe method with invalid token", http.MethodPatch, session.ID, "invalid", http.StatusForbidden},
+		{"Unsafe method with token of another session", http.MethodDelete, session.ID, csrfToken("other-session-id"), http.StatusForbidden},
+		{"Unsafe method with session ID as token", http.MethodPost, session.ID, session.ID, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newMockSessionService(session)
+			next := &mockSessionHandler{}
+			rr := httptest.NewRecorder()
+
+			CSRF(svc)(next).ServeHTTP(rr, newCSRFRequest(tt.method, session, tt.sessionCookie, tt.token))
+
+			if rr.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if next.called != (tt.expectedStatus == http.StatusOK) {
+				t.Errorf("expected next handler called = %v, got %v", tt.expectedStatus == http.StatusOK, next.called)
+			}
+		})
+	}
+}
+
+// TestCSRF_SetsCookie verifies that the CSRF token of the session is sent in a cookie readable by JS.
+func TestCSRF_SetsCookie(t *testing.T) {
+	session := model.NewSession("", "")
+	svc := newMockSessionService(session)
+	rr := httptest.NewRecorder()
+
+	CSRF(svc)(&mockSessionHandler{}).ServeHTTP(rr, newCSRFRequest(http.MethodGet, session, "", ""))
+
+	cookie := findCSRFCookie(rr)
+	if cookie == nil {
+		t.Fatal("CSRF cookie not set in response")
+	}
+	if cookie.Value != csrfToken(session.ID) {
+		t.Errorf("expected cookie Value %q, got %q", csrfToken(session.ID), cookie.Value)
+	}
+	if cookie.HttpOnly {
+		t.Error("expected cookie HttpOnly to be false")
+	}
+	if !cookie.Secure || cookie.SameSite != http.SameSiteStrictMode || cookie.Path != "/" {
+		t.Errorf("expected a Secure, SameSite Strict cookie on /, got %+v", cookie)
+	}
+	if cookie.MaxAge != int(expiration.Seconds()) {
+		t.Errorf("expected cookie MaxAge %d, got %d", int(expiration.Seconds()), cookie.MaxAge)
+	}
+}
+
+// TestCSRF_CookieFollowsRotatedSession verifies that the CSRF cookie carries the token of the new ID
+// of a session rotated by the handler, e.g. on login.
+func TestCSRF_CookieFollowsRotatedSession(t *testing.T) {
+	session := model.NewSession("", "")
+	oldID := session.ID
+	svc := newMockSessionService(session)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		svc.Rotate(r.Context().Value(ctx.SessionCtxKey{}).(*model.Session))
+		w.WriteHeader(http.StatusOK)
+	})
+	rr := httptest.NewRecorder()
+
+	CSRF(svc)(next).ServeHTTP(rr, newCSRFRequest(http.MethodPost, session, oldID, csrfToken(oldID)))
+
+	cookie := findCSRFCookie(rr)
+	if cookie == nil {
+		t.Fatal("CSRF cookie not set in response")
+	}
+	if cookie.Value != csrfToken(session.ID) || session.ID == oldID {
+		t.Errorf("expected cookie to carry the token of the rotated session, got %q", cookie.Value)
+	}
+}
+
+// TestCSRF_NoSession verifies that the middleware fails when it isn't stacked after SessionInjector.
+func TestCSRF_NoSession(t *testing.T) {
+	next := &mockSessionHandler{}
+	rr := httptest.NewRecorder()
+
+	CSRF(newMockSessionService(nil))(next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/test", nil))
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rr.Code)
+	}
+	if next.called {
+		t.Error("expected next handler not to be called")
+	}
+}
@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/service"
+)
+
+// GroupResolver returns the ID of the group a request applies to.
+type GroupResolver func(r *http.Request) (int64, error)
+
+// GroupFromPath resolves the group from an integer path value,
+// which must have been parsed beforehand by IntPathValues.
+func GroupFromPath(valueName string) GroupResolver {
+	return func(r *http.Request) (int64, error) {
+		groupID, ok := r.Context().Value(valueName).(int64)
+		if !ok {
+			return 0, customErrors.NewInvalidParamsError([]string{valueName}, nil)
+		}
+		return groupID, nil
+	}
+}
+
+// GroupFromResource resolves the group owning the resource identified by an integer path value,
+// which must have been parsed beforehand by IntPathValues, along with the {groupId} path value.
+// lookup returns the ID of the group owning the resource, nil for a resource shared by every group, or a NotFoundError.
+// Resources of another group than the one of the path are reported as not found, like missing ones,
+// to avoid leaking their existence; shared resources are resolved to the group of the path.
+// It should come after a GroupMember middleware resolving the group from the path, so that non-members
+// are rejected before their requests can tell which resources the group owns.
+func GroupFromResource(entity string, valueName string, lookup func(id int64) (*int64, error)) GroupResolver {
+	return func(r *http.Request) (int64, error) {
+		groupID, ok := r.Context().Value("groupId").(int64)
+		if !ok {
+			return 0, customErrors.NewInvalidParamsError([]string{"groupId"}, nil)
+		}
+		id, ok := r.Context().Value(valueName).(int64)
+		if !ok {
+			return 0, customErrors.NewInvalidParamsError([]string{valueName}, nil)
+		}
+
+		ownerID, err := lookup(id)
+		if err != nil {
+			return 0, err
+		}
+		if ownerID != nil && *ownerID != groupID {
+			slog.Debug("resource belongs to another group", "entity", entity, "id", id, "group", groupID)
+			return 0, customErrors.NewNotFoundError(entity, valueName, nil)
+		}
+
+		return groupID, nil
+	}
+}
+
+// GroupMember is a middleware that ensures the session user is a member of the group resolved by resolve.
+// The GroupMember is stored in the request context under ctx.GroupMemberCtxKey{}
+// and the group ID under "groupId", as IntPathValues would.
+// Non-members are rejected with a ForbiddenError.
+func GroupMember(groupService service.GroupServiceInterface, resolve GroupResolver) Middleware {
+	return groupAccess(groupService, resolve, false)
+}
+
+// GroupAdmin is a middleware that behaves as GroupMember, but also rejects members
+// who are not admins of the group with a ForbiddenError.
+func GroupAdmin(groupService service.GroupServiceInterface, resolve GroupResolver) Middleware {
+	return groupAccess(groupService, resolve, true)
+}
+
+/*** PRIVATE HELPERS ***/
+
+func groupAccess(groupService service.GroupServiceInterface, resolve GroupResolver, adminOnly bool) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			member, err := loadGroupMember(r, groupService, resolve)
+			if err == nil && adminOnly && !member.Admin {
+				slog.Debug("group member is not admin", "group", member.GroupId, "user", member.UserID)
+				err = customErrors.NewForbiddenError(nil)
+			}
+			if err != nil {
+				if appErr, ok := errors.AsType[customErrors.AppError](err); ok {
+					http.Error(w, err.Error(), appErr.HTTPStatus())
+					return
+				}
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			c := context.WithValue(r.Context(), ctx.GroupMemberCtxKey{}, member)
+			c = context.WithValue(c, "groupId", member.GroupId)
+
+			next.ServeHTTP(w, r.WithContext(c))
+		})
+	}
+}
+
+// loadGroupMember resolves the group of the request and returns the membership of the session user in it.
+func loadGroupMember(r *http.Request, groupService service.GroupServiceInterface, resolve GroupResolver) (*model.GroupMember, error) {
+	u, ok := r.Context().Value(ctx.UserCtxKey{}).(*model.User)
+	if !ok || u == nil {
+		return nil, customErrors.NewUnauthorizedError("no authenticated user", nil)
+	}
+
+	groupID, err := resolve(r)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := groupService.GetMember(groupID, u.ID)
+	if err != nil {
+		// Unknown groups are reported as forbidden too, to avoid leaking their existence.
+		if _, ok := errors.AsType[*customErrors.NotFoundError](err); ok {
+			slog.Debug("user is not a group member", "group", groupID, "user", u.ID)
+			return nil, customErrors.NewForbiddenError(err)
+		}
+		return nil, err
+	}
+
+	return member, nil
+}
@@ -0,0 +1,234 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/ctx"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type mockGroupService struct {
+	members      []model.GroupMember
+	getMemberErr error
+}
+
+func (m *mockGroupService) GetByID(id int64) (*model.Group, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockGroupService) GetByUserID(userID int64) ([]model.Group, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockGroupService) GetMember(groupID, userID int64) (*model.GroupMember, error) {
+	if m.getMemberErr != nil {
+		return nil, m.getMemberErr
+	}
+
+	for i := range m.members {
+		if m.members[i].GroupId == groupID && m.members[i].UserID == userID {
+			return &m.members[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("group_members", "group_id, user_id", nil)
+}
+
+func (m *mockGroupService) Create(_ context.Context, group *model.Group, creatorID int64) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (m *mockGroupService) Update(group *model.Group) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockGroupService) Delete(_ context.Context, id int64) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockGroupService) AddMember(member *model.GroupMember) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockGroupService) UpdateMemberAdmin(groupID, userID int64, admin bool) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockGroupService) RemoveMember(groupID, userID int64) error {
+	return errors.New("not implemented")
+}
+
+func newMockGroupService() *mockGroupService {
+	return &mockGroupService{
+		members: []model.GroupMember{
+			{UserID: 1, GroupId: 1, Admin: true},
+			{UserID: 2, GroupId: 1, Admin: false},
+		},
+	}
+}
+
+// newGroupRequest builds a request carrying the session user and the parsed path values in its context.
+func newGroupRequest(user *model.User, pathValues map[string]int64) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/test", nil)
+	c := r.Context()
+	if user != nil {
+		c = context.WithValue(c, ctx.UserCtxKey{}, user)
+	}
+	for k, v := range pathValues {
+		c = context.WithValue(c, k, v)
+	}
+	return r.WithContext(c)
+}
+
+func TestGroupMember(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		pathValues     map[string]int64
+		getMemberErr   error
+		expectedStatus int
+		expectNext     bool
+	}{
+		{"Admin member", &model.User{ID: 1}, map[string]int64{"groupId": 1}, nil, http.StatusOK, true},
+		{"Simple member", &model.User{ID: 2}, map[string]int64{"groupId": 1}, nil, http.StatusOK, true},
+		{"User not member of the group", &model.User{ID: 3}, map[string]int64{"groupId": 1}, nil, http.StatusForbidden, false},
+		{"Unknown group", &model.User{ID: 1}, map[string]int64{"groupId": 99}, nil, http.StatusForbidden, false},
+		{"No session user", nil, map[string]int64{"groupId": 1}, nil, http.StatusUnauthorized, false},
+		{"Group ID not parsed", &model.User{ID: 1}, nil, nil, http.StatusBadRequest, false},
+		{
+			"Service error",
+			&model.User{ID: 1},
+			map[string]int64{"groupId": 1},
+			customErrors.NewInternalError("failed to fetch group member", nil),
+			http.StatusInternalServerError,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupService := newMockGroupService()
+			groupService.getMemberErr = tt.getMemberErr
+			mockNext := &mockHandler{}
+
+			handler := GroupMember(groupService, GroupFromPath("groupId"))(mockNext)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newGroupRequest(tt.user, tt.pathValues))
+
+			if rr.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, rr.Code)
+			}
+
+			if mockNext.called != tt.expectNext {
+				t.Fatalf("expected next handler called = %v", tt.expectNext)
+			}
+
+			if !tt.expectNext {
+				return
+			}
+
+			member, ok := mockNext.request.Context().Value(ctx.GroupMemberCtxKey{}).(*model.GroupMember)
+			if !ok || member == nil {
+				t.Fatal("expected group member in context")
+			}
+
+			if member.UserID != tt.user.ID || member.GroupId != tt.pathValues["groupId"] {
+				t.Errorf("unexpected group member %+v", member)
+			}
+		})
+	}
+}
+
+func TestGroupAdmin(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		expectedStatus int
+		expectNext     bool
+	}{
+		{"Admin member", &model.User{ID: 1}, http.StatusOK, true},
+		{"Simple member", &model.User{ID: 2}, http.StatusForbidden, false},
+		{"User not member of the group", &model.User{ID: 3}, http.StatusForbidden, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockNext := &mockHandler{}
+
+			handler := GroupAdmin(newMockGroupService(), GroupFromPath("groupId"))(mockNext)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newGroupRequest(tt.user, map[string]int64{"groupId": 1}))
+
+			if rr.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, rr.Code)
+			}
+
+			if mockNext.called != tt.expectNext {
+				t.Fatalf("expected next handler called = %v", tt.expectNext)
+			}
+		})
+	}
+}
+
+func TestGroupFromResource(t *testing.T) {
+	// Resource 10 belongs to group 1, resource 20 to group 2 and resource 30 to every group.
+	lookup := func(id int64) (*int64, error) {
+		switch id {
+		case 10:
+			return new(int64(1)), nil
+		case 20:
+			return new(int64(2)), nil
+		case 30:
+			return nil, nil
+		}
+		return nil, customErrors.NewNotFoundError("items", "id", nil)
+	}
+
+	tests := []struct {
+		name           string
+		user           *model.User
+		groupID        int64
+		resourceID     int64
+		expectedStatus int
+		expectNext     bool
+	}{
+		{"Resource of the group", &model.User{ID: 2}, 1, 10, http.StatusOK, true},
+		{"Shared resource", &model.User{ID: 2}, 1, 30, http.StatusOK, true},
+		{"Resource of another group", &model.User{ID: 2}, 1, 20, http.StatusNotFound, false},
+		{"Resource of the group, as a non-member", &model.User{ID: 3}, 2, 20, http.StatusForbidden, false},
+		{"Unknown resource", &model.User{ID: 2}, 1, 40, http.StatusNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockNext := &mockHandler{}
+
+			handler := GroupMember(newMockGroupService(), GroupFromResource("items", "id", lookup))(mockNext)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newGroupRequest(tt.user, map[string]int64{"groupId": tt.groupID, "id": tt.resourceID}))
+
+			if rr.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d instead of %d", tt.expectedStatus, rr.Code)
+			}
+
+			if mockNext.called != tt.expectNext {
+				t.Fatalf("expected next handler called = %v", tt.expectNext)
+			}
+
+			if !tt.expectNext {
+				return
+			}
+
+			if groupID, _ := mockNext.request.Context().Value("groupId").(int64); groupID != tt.groupID {
+				t.Errorf("expected groupId %d in context instead of %d", tt.groupID, groupID)
+			}
+		})
+	}
+}
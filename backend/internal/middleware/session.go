@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/zouipo/yumsday/backend/internal/ctx"
+	"github.com/zouipo/yumsday/backend/internal/model"
 	"github.com/zouipo/yumsday/backend/internal/service"
 )
 
@@ -22,39 +23,51 @@ func SessionInjector(sessionService service.SessionServiceInterface, wg *sync.Wa
 				s,
 			))
 
-			cookie := &http.Cookie{
-				Name:  sessionService.CookieName(),
-				Value: s.ID,
-				// JS cannot access the cookie via document.cookie;
-				// security measure that prevents XSS attacks from stealing the session ID
-				HttpOnly: true,
-				// The URL path prefix for which the browser will send the cookie.
-				// "/" means it is sent on all paths of the domain.
-				Path: "/",
-				// The browser will only send the cookie over HTTPS connections;
-				// prevents the session ID from being intercepted over plain HTTP.
-				Secure: true,
-				// The cookie will be sent by the browser only when Same-Site Sub-Requests are done,
-				// or when the domain is typed directly in the URL.
-				// It won't be sent for cross-site sub-requests, which helps mitigate CSRF attacks.
-				SameSite: http.SameSiteStrictMode,
-				Expires:  time.Now().Add(sessionService.Expiration()).UTC(),
-				// The lifetime of the cookie in seconds from when it was received.
-				// Prefered over Expires because it is not dependent on the client's clock,
-				// but we set them both for compatibility with older browsers.
-				MaxAge: int(sessionService.Expiration().Seconds()),
+			// The cookie is built right before the headers are written, since handlers can rotate the session ID (e.g. on login).
+			writer := &beforeHeaderWriter{
+				ResponseWriter: w,
+				hook: func(w http.ResponseWriter) {
+					// Adds a Set-Cookie header to the ResponseWriter's headers.
+					// This header instructs the browser to store the cookie and its attributes
+					// and send it with future requests to the same domain.
+					http.SetCookie(w, sessionCookie(sessionService, s))
+				},
 			}
-			// Adds a Set-Cookie header to the ResponseWriter's headers.
-			// This header instructs the browser to store the cookie and its attributes
-			// and send it with future requests to the same domain.
-			http.SetCookie(w, cookie)
+			next.ServeHTTP(writer, r)
+			// Nothing was written by the handler, the cookie still has to be sent with the implicit response.
+			writer.callHook()
 
-			next.ServeHTTP(w, r)
-
-			if !strings.HasPrefix(r.URL.Path, "/auth") {
+			// The requests authenticated by an API token don't use the session, so its activity isn't recorded.
+			if _, apiToken := bearerToken(r); !apiToken && !strings.HasPrefix(r.URL.Path, "/auth") {
 				// Save session in dedicated goroutine to reduce response latency.
 				wg.Go(func() { sessionService.Save(s) })
 			}
 		})
 	}
 }
+
+// sessionCookie builds the cookie carrying the ID of the session.
+func sessionCookie(sessionService service.SessionServiceInterface, s *model.Session) *http.Cookie {
+	return &http.Cookie{
+		Name:  sessionService.CookieName(),
+		Value: s.ID,
+		// JS cannot access the cookie via document.cookie;
+		// security measure that prevents XSS attacks from stealing the session ID
+		HttpOnly: true,
+		// The URL path prefix for which the browser will send the cookie.
+		// "/" means it is sent on all paths of the domain.
+		Path: "/",
+		// The browser will only send the cookie over HTTPS connections;
+		// prevents the session ID from being intercepted over plain HTTP.
+		Secure: true,
+		// The cookie will be sent by the browser only when Same-Site Sub-Requests are done,
+		// or when the domain is typed directly in the URL.
+		// It won't be sent for cross-site sub-requests, which helps mitigate CSRF attacks.
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Now().Add(sessionService.Expiration()).UTC(),
+		// The lifetime of the cookie in seconds from when it was received.
+		// Prefered over Expires because it is not dependent on the client's clock,
+		// but we set them both for compatibility with older browsers.
+		MaxAge: int(sessionService.Expiration().Seconds()),
+	}
+}
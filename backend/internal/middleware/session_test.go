@@ -81,6 +81,30 @@ func (m *mockSessionService) Remove(_ *model.Session) error {
 	return nil
 }
 
+// Rotate gives a fresh ID to the session, as the real service does.
+func (m *mockSessionService) Rotate(session *model.Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session.ID = utils.GenerateSessionID()
+	return nil
+}
+
+func (m *mockSessionService) Invalidate(_ int64, _ *model.Session) error {
+	return nil
+}
+
+func (m *mockSessionService) GetByUserID(_ int64) ([]model.Session, error) {
+	return nil, nil
+}
+
+func (m *mockSessionService) Revoke(_ int64, _ string, _ *model.Session) error {
+	return nil
+}
+
+func (m *mockSessionService) RevokeOthers(_ int64, _ *model.Session) error {
+	return nil
+}
+
 func (m *mockSessionService) getSaveCalled() int {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -264,6 +288,50 @@ func TestSessionInjector_CookieValueMatchesSessionID(t *testing.T) {
 	}
 }
 
+// TestSessionInjector_CookieCarriesRotatedSessionID verifies that the session cookie
+// carries the new ID of a session rotated by the handler, e.g. on login.
+func TestSessionInjector_CookieCarriesRotatedSessionID(t *testing.T) {
+	svc := newMockSessionService(model.NewSession("", ""))
+	oldID := svc.sessionToReturn.ID
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		svc.Rotate(r.Context().Value(ctx.SessionCtxKey{}).(*model.Session))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+	rr := httptest.NewRecorder()
+
+	SessionInjector(svc, &wg)(next).ServeHTTP(rr, req)
+
+	cookies := rr.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("expected exactly 1 cookie, got %d", len(cookies))
+	}
+	if cookies[0].Value == oldID {
+		t.Error("expected cookie to carry the rotated session ID, got the old one")
+	}
+	compareCookiesSession(t, cookies[0], cookieName, svc.sessionToReturn.ID)
+}
+
+// TestSessionInjector_SetsCookieWhenHandlerWritesNothing verifies that the session cookie
+// is still sent when the handler doesn't write the response itself.
+func TestSessionInjector_SetsCookieWhenHandlerWritesNothing(t *testing.T) {
+	svc := newMockSessionService(model.NewSession("", ""))
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	rr := httptest.NewRecorder()
+
+	SessionInjector(svc, &wg)(next).ServeHTTP(rr, req)
+
+	cookies := rr.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("expected exactly 1 cookie, got %d", len(cookies))
+	}
+	compareCookiesSession(t, cookies[0], cookieName, svc.sessionToReturn.ID)
+}
+
 // TestSessionInjector_SavesSessionAfterHandler verifies that the session is saved
 // after the handler returns when the request path is not /auth.
 func TestSessionInjector_SavesSessionAfterHandler(t *testing.T) {
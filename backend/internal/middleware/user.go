@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/zouipo/yumsday/backend/internal/ctx"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
 	"github.com/zouipo/yumsday/backend/internal/service"
 )
@@ -18,6 +19,12 @@ func UserInjector(userService service.UserServiceInterface) Middleware {
 				return
 			}
 
+			// Already authenticated by APITokenInjector
+			if u, ok := r.Context().Value(ctx.UserCtxKey{}).(*model.User); ok && u != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			s, ok := r.Context().Value(ctx.SessionCtxKey{}).(*model.Session)
 			if !ok || s == nil {
 				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -50,3 +57,24 @@ func UserInjector(userService service.UserServiceInterface) Middleware {
 		})
 	}
 }
+
+// AppAdmin is a middleware that ensures the session user, injected by UserInjector, is an admin of the application.
+// Other users are rejected with a ForbiddenError.
+func AppAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, ok := r.Context().Value(ctx.UserCtxKey{}).(*model.User)
+		if !ok || u == nil {
+			err := customErrors.NewUnauthorizedError("no authenticated user", nil)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		if !u.AppAdmin {
+			slog.Debug("user is not app admin", "user", u.ID)
+			http.Error(w, customErrors.NewForbiddenError(nil).Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
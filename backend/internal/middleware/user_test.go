@@ -178,3 +178,40 @@ func TestUserInjector_authenticated_getByIDError(t *testing.T) {
 
 	mw(next).ServeHTTP(w, r)
 }
+
+func TestAppAdmin(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *model.User
+		expectedStatus int
+	}{
+		{"admin", &model.User{ID: 1, AppAdmin: true}, http.StatusOK},
+		{"not an admin", &model.User{ID: 2}, http.StatusForbidden},
+		{"no user", nil, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/user/1/lockout", nil)
+			if tt.user != nil {
+				req = req.WithContext(context.WithValue(req.Context(), ctx.UserCtxKey{}, tt.user))
+			}
+			rr := httptest.NewRecorder()
+
+			AppAdmin(next).ServeHTTP(rr, req)
+
+			if rr.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if nextCalled != (tt.expectedStatus == http.StatusOK) {
+				t.Errorf("expected next to be called only for admins, called = %v", nextCalled)
+			}
+		})
+	}
+}
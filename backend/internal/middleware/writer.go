@@ -36,6 +36,34 @@ func (w *responseWriter) Write(data []byte) (int, error) {
 	return w.ResponseWriter.Write(data)
 }
 
+// Custom response writer calling a hook once, right before the headers are written,
+// e.g. to set a cookie from a state the handler may have changed.
+type beforeHeaderWriter struct {
+	http.ResponseWriter
+	hook   func(w http.ResponseWriter)
+	called bool
+}
+
+// callHook calls the hook if it hasn't been called yet.
+// Middlewares also call it once the handler returned, in case nothing was written.
+func (w *beforeHeaderWriter) callHook() {
+	if w.called {
+		return
+	}
+	w.called = true
+	w.hook(w.ResponseWriter)
+}
+
+func (w *beforeHeaderWriter) WriteHeader(status int) {
+	w.callHook()
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *beforeHeaderWriter) Write(data []byte) (int, error) {
+	w.callHook()
+	return w.ResponseWriter.Write(data)
+}
+
 // ResponseWriter is a middleware that wraps the ResponseWriter struct to capture status codes.
 func ResponseWriter(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 type migration struct {
@@ -116,7 +117,7 @@ func performMigrations(db *sql.DB, migrations []migration, currentVersion int) e
 		slog.Info("Applying migration", "version", m.version, "name", m.name)
 		_, err := db.Exec(m.script)
 		if err != nil {
-			return fmt.Errorf("Failed to apply migration %d_%s: %w", m.version, m.name, err)
+			return fmt.Errorf("Failed to apply migration %d_%s: %w", m.version, m.name, explainScriptError(err))
 		}
 
 		_, err = db.Exec(`UPDATE _migration_version SET version = ?;`, m.version)
@@ -128,6 +129,15 @@ func performMigrations(db *sql.DB, migrations []migration, currentVersion int) e
 	return nil
 }
 
+// explainScriptError tells how to build the application when a script fails because of an SQLite module left out of the build,
+// the go-sqlite3 driver only including some of them with a build tag.
+func explainScriptError(err error) error {
+	if strings.Contains(err.Error(), "no such module: fts5") {
+		return fmt.Errorf("%w (SQLite was built without FTS5, build with the sqlite_fts5 tag, e.g. go run -tags \"dev sqlite_fts5\" .)", err)
+	}
+	return err
+}
+
 func initializeMigrationVersion(db *sql.DB) error {
 	version, err := getMigrationVersion(db)
 	if err == nil {
@@ -3,6 +3,7 @@ package migration
 import (
 	"database/sql"
 	"embed"
+	"errors"
 	"io/fs"
 	"os"
 	"strings"
@@ -183,6 +184,19 @@ func TestPerformMigrations_InvalidScript(t *testing.T) {
 	}
 }
 
+func TestExplainScriptError(t *testing.T) {
+	missingFTS5 := errors.New("no such module: fts5")
+	err := explainScriptError(missingFTS5)
+	if !errors.Is(err, missingFTS5) || !strings.Contains(err.Error(), "sqlite_fts5") {
+		t.Errorf("Expected an error naming the sqlite_fts5 build tag, got %v", err)
+	}
+
+	syntaxErr := errors.New(`near "dummy": syntax error`)
+	if err := explainScriptError(syntaxErr); err != syntaxErr {
+		t.Errorf("Expected the error to be returned as is, got %v", err)
+	}
+}
+
 func TestInitializeMigrationVersion(t *testing.T) {
 	db, err := sql.Open("sqlite3", testSQLiteDSN)
 	if err != nil {
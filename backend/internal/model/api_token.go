@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+// APIToken is a personal token authenticating the requests of a user in place of a session.
+// Only the digest of the token is stored.
+type APIToken struct {
+	ID         int64           `json:"id"`
+	UserID     int64           `json:"user_id"`
+	Name       string          `json:"name"`
+	TokenHash  string          `json:"token_hash"`
+	Scope      enum.TokenScope `json:"scope"`
+	CreatedAt  time.Time       `json:"created_at"`
+	ExpiresAt  *time.Time      `json:"expires_at"`
+	LastUsedAt *time.Time      `json:"last_used_at"`
+}
@@ -0,0 +1,12 @@
+package model
+
+// CostEstimate is the estimated cost of one or several recipes, computed from the average market price of their items.
+// It isn't stored in the database.
+type CostEstimate struct {
+	Cost float64 `json:"cost"`
+	// Servings is the number of servings the cost is estimated for, nil when unknown.
+	Servings *int `json:"servings"`
+	// UnpricedIngredients are the ingredients left out of the cost: their item has no price,
+	// or their unit can't be converted to the unit type of the item.
+	UnpricedIngredients []Ingredient `json:"unpriced_ingredients"`
+}
@@ -0,0 +1,66 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// TokenScope represents what an API token is allowed to do.
+type TokenScope struct {
+	value string
+}
+
+var (
+	// ReadScope only allows the safe requests, e.g. GET.
+	ReadScope = TokenScope{"READ"}
+	// WriteScope allows every request.
+	WriteScope = TokenScope{"WRITE"}
+)
+
+func (t TokenScope) String() string {
+	return t.value
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for TokenScope.
+func (t *TokenScope) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	switch s {
+	case ReadScope.value:
+		*t = ReadScope
+	case WriteScope.value:
+		*t = WriteScope
+	default:
+		return fmt.Errorf("invalid token scope value: %s", s)
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for TokenScope.
+func (t TokenScope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+// Scan implements the sql.Scanner interface for TokenScope.
+func (t *TokenScope) Scan(value interface{}) error {
+	if value == nil {
+		return fmt.Errorf("token scope cannot be null")
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into TokenScope", value)
+	}
+
+	*t = TokenScope{value: s}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for TokenScope.
+func (t TokenScope) Value() (driver.Value, error) {
+	return t.value, nil
+}
@@ -0,0 +1,115 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+)
+
+func TestTokenScope_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		jsonData  string
+		expected  TokenScope
+		expectErr bool
+	}{
+		{"Valid Read", `"READ"`, ReadScope, false},
+		{"Valid Write", `"WRITE"`, WriteScope, false},
+		{"Invalid value", `"ADMIN"`, TokenScope{}, true},
+		{"Invalid JSON", `invalid`, TokenScope{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scope TokenScope
+			err := json.Unmarshal([]byte(tt.jsonData), &scope)
+
+			if tt.expectErr && err == nil {
+				t.Error("Expected error, got nil")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if !tt.expectErr && scope != tt.expected {
+				t.Errorf("UnmarshalJSON() = %v, expected %v", scope, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTokenScope_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    TokenScope
+		expected string
+	}{
+		{"Read scope", ReadScope, `"READ"`},
+		{"Write scope", WriteScope, `"WRITE"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.scope)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("MarshalJSON() = %v, expected %v", string(data), tt.expected)
+			}
+		})
+	}
+}
+
+func TestTokenScope_Scan(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     interface{}
+		expected  TokenScope
+		expectErr bool
+	}{
+		{"Valid string", "READ", ReadScope, false},
+		{"Another valid string", "WRITE", WriteScope, false},
+		{"Nil value", nil, TokenScope{}, true},
+		{"Invalid type", 123, TokenScope{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scope TokenScope
+			err := scope.Scan(tt.value)
+
+			if tt.expectErr && err == nil {
+				t.Error("Expected error, got nil")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if !tt.expectErr && scope != tt.expected {
+				t.Errorf("Scan() = %v, expected %v", scope, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTokenScope_Value(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    TokenScope
+		expected driver.Value
+	}{
+		{"Read scope", ReadScope, "READ"},
+		{"Write scope", WriteScope, "WRITE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := tt.scope.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if val != tt.expected {
+				t.Errorf("Value() = %v, expected %v", val, tt.expected)
+			}
+		})
+	}
+}
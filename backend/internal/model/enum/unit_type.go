@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+
+	"go.yaml.in/yaml/v3"
 )
 
 type UnitType struct {
@@ -30,23 +32,7 @@ func (u *UnitType) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	switch s {
-	case Volume.value:
-		*u = Volume
-	case Weight.value:
-		*u = Weight
-	case Numeric.value:
-		*u = Numeric
-	case Piece.value:
-		*u = Piece
-	case Bag.value:
-		*u = Bag
-	case Undefined.value:
-		*u = Undefined
-	default:
-		return fmt.Errorf("invalid unit type value: %s", s)
-	}
-	return nil
+	return u.parse(s)
 }
 
 // MarshalJSON implements the json.Marshaler interface for UnitType.
@@ -54,6 +40,21 @@ func (u UnitType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(u.value)
 }
 
+// UnmarshalYAML implements the yaml.Unmarshaler interface for UnitType.
+func (u *UnitType) UnmarshalYAML(node *yaml.Node) error {
+	var s string
+	if err := node.Decode(&s); err != nil {
+		return err
+	}
+
+	return u.parse(s)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for UnitType.
+func (u UnitType) MarshalYAML() (any, error) {
+	return u.value, nil
+}
+
 // Scan implements the sql.Scanner interface for UnitType.
 func (u *UnitType) Scan(value interface{}) error {
 	if value == nil {
@@ -73,3 +74,24 @@ func (u *UnitType) Scan(value interface{}) error {
 func (u UnitType) Value() (driver.Value, error) {
 	return u.value, nil
 }
+
+// parse sets u to the unit type whose value is s.
+func (u *UnitType) parse(s string) error {
+	switch s {
+	case Volume.value:
+		*u = Volume
+	case Weight.value:
+		*u = Weight
+	case Numeric.value:
+		*u = Numeric
+	case Piece.value:
+		*u = Piece
+	case Bag.value:
+		*u = Bag
+	case Undefined.value:
+		*u = Undefined
+	default:
+		return fmt.Errorf("invalid unit type value: %s", s)
+	}
+	return nil
+}
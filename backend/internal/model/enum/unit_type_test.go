@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"testing"
+
+	"go.yaml.in/yaml/v3"
 )
 
 func TestUnitType_String(t *testing.T) {
@@ -91,6 +93,46 @@ func TestUnitType_MarshalJSON(t *testing.T) {
 	}
 }
 
+func TestUnitType_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name      string
+		yamlData  string
+		expected  UnitType
+		expectErr bool
+	}{
+		{"Valid Volume", "VOLUME", Volume, false},
+		{"Valid Bag", "BAG", Bag, false},
+		{"Valid Undefined", `"UNDEFINED"`, Undefined, false},
+		{"Invalid value", "LENGTH", UnitType{}, true},
+		{"Lowercase value", "volume", UnitType{}, true},
+		{"Not a string", "[VOLUME]", UnitType{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var unitType UnitType
+			err := yaml.Unmarshal([]byte(tt.yamlData), &unitType)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("UnmarshalYAML() error = %v, expectErr %v", err, tt.expectErr)
+				return
+			}
+			if !tt.expectErr && unitType != tt.expected {
+				t.Errorf("UnmarshalYAML() = %v, expected %v", unitType, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnitType_MarshalYAML(t *testing.T) {
+	data, err := yaml.Marshal(map[string]UnitType{"unit_type": Weight})
+	if err != nil {
+		t.Fatalf("MarshalYAML() error = %v", err)
+	}
+	if string(data) != "unit_type: WEIGHT\n" {
+		t.Errorf("MarshalYAML() = %q, expected %q", string(data), "unit_type: WEIGHT\n")
+	}
+}
+
 func TestUnitType_Scan(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type GroupInvitation struct {
+	ID        int64     `json:"id"`
+	Token     string    `json:"token"`
+	GroupID   int64     `json:"group_id"`
+	CreatedBy *int64    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	MaxUses   *int64    `json:"max_uses"`
+	Uses      int64     `json:"uses"`
+	Revoked   bool      `json:"revoked"`
+}
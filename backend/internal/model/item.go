@@ -3,9 +3,10 @@ package model
 import "github.com/zouipo/yumsday/backend/internal/model/enum"
 
 type Item struct {
-	ID                 int64         `json:"id"`
-	Name               string        `json:"name"`
-	Description        *string       `json:"description"`
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	// AverageMarketPrice is the price of a kilogram, a liter or a single unit of the item, depending on its unit type.
 	AverageMarketPrice *float64      `json:"average_market_price"`
 	UnitType           enum.UnitType `json:"unit_type"`
 	GroupID            int64         `json:"group_id"`
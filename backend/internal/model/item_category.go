@@ -1,8 +1,9 @@
 package model
 
 type ItemCategory struct {
-	ID      int64  `json:"id"`
-	Name    string `json:"name"`
-	GroupID int64  `json:"group_id"`
-	Items   []Item `json:"items"`
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	GroupID  int64  `json:"group_id"`
+	Position int    `json:"position"`
+	Items    []Item `json:"items"`
 }
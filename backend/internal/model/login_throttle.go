@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// LoginThrottle counts the recent failed login attempts of a username or a client IP.
+type LoginThrottle struct {
+	// Kind of the key, constant.LOGIN_THROTTLE_USERNAME or constant.LOGIN_THROTTLE_IP.
+	Kind          string    `json:"kind"`
+	Key           string    `json:"key"`
+	Failures      int       `json:"failures"`
+	LastFailureAt time.Time `json:"last_failure_at"`
+	// BlockedUntil is nil while the logins aren't delayed yet.
+	BlockedUntil *time.Time `json:"blocked_until"`
+}
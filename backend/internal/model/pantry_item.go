@@ -0,0 +1,10 @@
+package model
+
+// PantryItem is the quantity of an item owned by a group, in a given unit.
+type PantryItem struct {
+	ID       int64   `json:"id"`
+	Quantity float64 `json:"quantity"`
+	Item     Item    `json:"item"`
+	Unit     Unit    `json:"unit"`
+	GroupID  int64   `json:"group_id"`
+}
@@ -0,0 +1,17 @@
+package model
+
+// ParsedIngredient is an ingredient line of free text resolved against the items and units of a group.
+// It isn't stored in the database.
+type ParsedIngredient struct {
+	Line string `json:"line"`
+	// Quantity is the upper bound of a range such as "2-3", whose lower bound is kept in MinQuantity.
+	Quantity    *float64 `json:"quantity"`
+	MinQuantity *float64 `json:"min_quantity"`
+	// Unit is nil when the line names a unit unknown to the group, or when it names neither a unit nor an item.
+	Unit *Unit `json:"unit"`
+	// Item is the item of the group named in the line, nil when the group has none.
+	Item *Item `json:"item"`
+	// NewItem is the item offered to be created when the line names an item unknown to the group.
+	NewItem *Item  `json:"new_item"`
+	Note    string `json:"note"`
+}
@@ -0,0 +1,15 @@
+package model
+
+// RecipeBundle is a set of recipes with the units, items and categories they reference, meant to be moved between
+// groups or Yumsday instances. Its parts reference each other by name, IDs being meaningless outside of a database:
+// items reference their item category, and recipes their categories, items and units, by name only.
+// It isn't stored in the database as such.
+type RecipeBundle struct {
+	// Version is the version of the bundle format, constant.RECIPE_BUNDLE_VERSION for the bundles exported by this instance.
+	Version          int              `json:"version"`
+	Units            []Unit           `json:"units"`
+	ItemCategories   []ItemCategory   `json:"item_categories"`
+	Items            []Item           `json:"items"`
+	RecipeCategories []RecipeCategory `json:"recipe_categories"`
+	Recipes          []Recipe         `json:"recipes"`
+}
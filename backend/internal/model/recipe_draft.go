@@ -0,0 +1,9 @@
+package model
+
+// RecipeDraft is a recipe imported from a web page, to be reviewed before being created.
+// It isn't stored in the database.
+type RecipeDraft struct {
+	Recipe Recipe `json:"recipe"`
+	// UnmatchedIngredients are the ingredient lines of the page whose item or unit couldn't be found in the group.
+	UnmatchedIngredients []string `json:"unmatched_ingredients"`
+}
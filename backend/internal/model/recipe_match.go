@@ -0,0 +1,11 @@
+package model
+
+// RecipeMatch is a recipe of a group which can be cooked, at least partly, from a set of available items.
+// It isn't stored in the database.
+type RecipeMatch struct {
+	Recipe Recipe `json:"recipe"`
+	// Coverage is the share of the ingredients of the recipe whose item is available, between 0 and 1.
+	Coverage float64 `json:"coverage"`
+	// MissingIngredients are the ingredients of the recipe whose item isn't available.
+	MissingIngredients []Ingredient `json:"missing_ingredients"`
+}
@@ -0,0 +1,23 @@
+package model
+
+const (
+	SearchHitRecipe = "recipe"
+	SearchHitItem   = "item"
+
+	// Delimiters of the matching terms in the highlighted texts of a search hit.
+	HighlightStart = "\x02"
+	HighlightEnd   = "\x03"
+)
+
+// SearchHit is a recipe or an item matching a full-text search. It isn't stored in the database.
+type SearchHit struct {
+	// Kind is SearchHitRecipe or SearchHitItem.
+	Kind string `json:"kind"`
+	ID   int64  `json:"id"`
+	// Name is the name of the recipe or item, its matching terms being highlighted.
+	Name string `json:"name"`
+	// Snippet is an excerpt of the text which best matches the search, its matching terms being highlighted.
+	Snippet string `json:"snippet"`
+	// Rank is the relevance of the hit computed by FTS5 (bm25); the lower, the more relevant.
+	Rank float64 `json:"rank"`
+}
@@ -7,4 +7,6 @@ type Unit struct {
 	Name     string        `json:"name"`
 	Factor   float64       `json:"factor"`
 	UnitType enum.UnitType `json:"unit_type"`
+	// GroupID is nil for the units of the catalogue, shared by every group.
+	GroupID *int64 `json:"group_id"`
 }
@@ -0,0 +1,312 @@
+package ingredientline
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+// Line is an ingredient line of free text split into its parts, e.g. "2 1/2 cups flour, sifted"
+// gives the quantity 2.5, the unit "Cup", the item "flour" and the note "sifted".
+// Empty strings and nil pointers stand for the parts missing from the text.
+type Line struct {
+	Text string
+	// Quantity is the upper bound of a range such as "2-3", whose lower bound is kept in MinQuantity.
+	Quantity    *float64
+	MinQuantity *float64
+	// Unit is the name of a unit of the catalogue, e.g. "Tablespoon" for "c. à soupe", or of a custom unit given to the parser.
+	Unit string
+	Item string
+	Note string
+}
+
+// unitAlias is a way of writing a unit, e.g. "tbsp" for "Tablespoon".
+type unitAlias struct {
+	alias string
+	unit  string
+}
+
+// vocabulary holds the words of a language used in ingredient lines.
+type vocabulary struct {
+	aliases []unitAlias
+	// articles stand for a quantity of 1 when followed by a unit, e.g. "a cup of sugar".
+	articles []string
+	// rangeWords join the bounds of a range, besides a dash.
+	rangeWords []string
+	// connectors link a unit to its item, e.g. "of" in "a cup of sugar".
+	connectors []string
+}
+
+var vocabularies = map[enum.Language]vocabulary{
+	enum.English: {
+		aliases: slices.Concat(
+			aliases("Kilogram", "kg", "kgs", "kilo", "kilos", "kilogram", "kilograms", "kilogramme", "kilogrammes"),
+			aliases("Gram", "g", "gr", "grs", "gram", "grams", "gramme", "grammes"),
+			aliases("Milligram", "mg", "milligram", "milligrams"),
+			aliases("Ounce", "oz", "ounce", "ounces"),
+			aliases("Pound", "lb", "lbs", "pound", "pounds"),
+			aliases("Liter", "l", "liter", "liters", "litre", "litres"),
+			aliases("Milliliter", "ml", "milliliter", "milliliters", "millilitre", "millilitres"),
+			aliases("Centiliter", "cl", "centiliter", "centiliters", "centilitre", "centilitres"),
+			aliases("Deciliter", "dl", "deciliter", "deciliters", "decilitre", "decilitres"),
+			aliases("Fluid ounce", "fl oz", "fl. oz.", "fluid ounce", "fluid ounces"),
+			aliases("Cup", "c", "cup", "cups"),
+			aliases("Tablespoon", "tbsp", "tbsps", "tbs", "tbl", "tablespoon", "tablespoons"),
+			aliases("Teaspoon", "tsp", "tsps", "teaspoon", "teaspoons"),
+			aliases("Pint", "pt", "pint", "pints"),
+			aliases("Quart", "qt", "quart", "quarts"),
+			aliases("Gallon", "gal", "gallon", "gallons"),
+			aliases("Piece", "pc", "pcs", "piece", "pieces"),
+			aliases("Bag", "bag", "bags", "packet", "packets"),
+			aliases("Dozen", "doz", "dozen", "dozens"),
+		),
+		articles:   []string{"a", "an", "one"},
+		rangeWords: []string{"to", "or"},
+		connectors: []string{"of"},
+	},
+	enum.French: {
+		aliases: slices.Concat(
+			aliases("Kilogram", "kg", "kilo", "kilos", "kilogramme", "kilogrammes"),
+			aliases("Gram", "g", "gr", "gramme", "grammes"),
+			aliases("Milligram", "mg", "milligramme", "milligrammes"),
+			aliases("Pound", "livre", "livres"),
+			aliases("Liter", "l", "litre", "litres"),
+			aliases("Milliliter", "ml", "millilitre", "millilitres"),
+			aliases("Centiliter", "cl", "centilitre", "centilitres"),
+			aliases("Deciliter", "dl", "décilitre", "décilitres"),
+			aliases("Cup", "tasse", "tasses"),
+			aliases("Tablespoon", "cuillère à soupe", "cuillères à soupe", "cuillerée à soupe", "cuillerées à soupe",
+				"c. à soupe", "c. à s.", "c.à.s.", "c.à.s", "c à s", "càs", "cas", "cs"),
+			aliases("Teaspoon", "cuillère à café", "cuillères à café", "cuillerée à café", "cuillerées à café",
+				"c. à café", "c. à c.", "c.à.c.", "c.à.c", "c à c", "càc", "cac", "cc"),
+			aliases("Pint", "pinte", "pintes"),
+			aliases("Piece", "pièce", "pièces"),
+			aliases("Bag", "sachet", "sachets"),
+			aliases("Dozen", "douzaine", "douzaines"),
+		),
+		articles:   []string{"un", "une"},
+		rangeWords: []string{"à", "a", "ou"},
+		connectors: []string{"de la", "de l'", "de l’", "d'", "d’", "des", "du", "de"},
+	},
+}
+
+var (
+	// vulgarFractions are replaced by plain fractions before parsing, spaced from a preceding whole number.
+	vulgarFractions = strings.NewReplacer(
+		"½", " 1/2", "⅓", " 1/3", "⅔", " 2/3", "¼", " 1/4", "¾", " 3/4",
+		"⅕", " 1/5", "⅖", " 2/5", "⅗", " 3/5", "⅘", " 4/5", "⅙", " 1/6", "⅚", " 5/6",
+		"⅐", " 1/7", "⅛", " 1/8", "⅜", " 3/8", "⅝", " 5/8", "⅞", " 7/8", "⅑", " 1/9", "⅒", " 1/10",
+		"⁄", "/", "–", "-", "—", "-", " ", " ", " ", " ",
+	)
+	accents = strings.NewReplacer("à", "a", "è", "e", "é", "e")
+
+	amountPattern     = `(\d+\s+\d+/\d+|\d+/\d+|\d+(?:[.,]\d+)?)`
+	parenthesesRegexp = regexp.MustCompile(`\s*\(([^)]*)\)`)
+)
+
+// Parser splits ingredient lines written in a language, with the units of the catalogue and custom units.
+type Parser struct {
+	vocabulary
+	quantityRegexp *regexp.Regexp
+}
+
+// NewParser creates a Parser of the ingredient lines written in a language, English being used for unknown languages.
+// customUnits are the names of units added to the catalogue, e.g. "Handful", which are recognized in the singular and plural.
+func NewParser(language enum.Language, customUnits ...string) *Parser {
+	v, ok := vocabularies[language]
+	if !ok {
+		v = vocabularies[enum.English]
+	}
+
+	for _, name := range customUnits {
+		v.aliases = append(slices.Clip(v.aliases), aliases(name, strings.ToLower(name), strings.ToLower(name)+"s")...)
+	}
+
+	// Longer aliases are tried first, so that "cl" isn't read as a "c" followed by an "l".
+	v.aliases = slices.Clone(v.aliases)
+	slices.SortStableFunc(v.aliases, func(a, b unitAlias) int {
+		return len(b.alias) - len(a.alias)
+	})
+
+	rangeWords := make([]string, len(v.rangeWords))
+	for i, word := range v.rangeWords {
+		rangeWords[i] = regexp.QuoteMeta(word)
+	}
+
+	return &Parser{
+		vocabulary: v,
+		quantityRegexp: regexp.MustCompile(`^` + amountPattern +
+			`(?:(?:\s*-\s*|\s+(?:` + strings.Join(rangeWords, "|") + `)\s+)` + amountPattern + `)?`),
+	}
+}
+
+// Parse splits an ingredient line written in a language with the units of the catalogue.
+func Parse(text string, language enum.Language) Line {
+	return NewParser(language).Parse(text)
+}
+
+// Parse splits an ingredient line into its quantity, unit, item and note.
+// Quantities are numbers, with a dot or a comma as decimal separator, fractions such as "1/2" or "½",
+// whole numbers followed by a fraction such as "2 1/2" or "2½", or ranges of them such as "2-3" or "2 to 3".
+// The unit follows the quantity, possibly without space, e.g. "200g", or an article, e.g. "a cup of sugar".
+// The note gathers the texts between parentheses and after the first comma.
+func (p *Parser) Parse(text string) Line {
+	line := Line{Text: strings.TrimSpace(text)}
+
+	s := strings.Join(strings.Fields(vulgarFractions.Replace(line.Text)), " ")
+
+	notes := []string{}
+	for _, match := range parenthesesRegexp.FindAllStringSubmatch(s, -1) {
+		if note := strings.TrimSpace(match[1]); note != "" {
+			notes = append(notes, note)
+		}
+	}
+	s = parenthesesRegexp.ReplaceAllString(s, "")
+
+	if i := noteComma(s); i >= 0 {
+		if note := strings.TrimSpace(s[i+1:]); note != "" {
+			notes = append(notes, note)
+		}
+		s = s[:i]
+	}
+	line.Note = strings.Join(notes, ", ")
+
+	s = strings.TrimSpace(s)
+
+	if match := p.quantityRegexp.FindStringSubmatch(s); match != nil {
+		if quantity, ok := parseAmount(match[1]); ok {
+			line.Quantity = &quantity
+			if maxQuantity, ok := parseAmount(match[2]); ok && maxQuantity > quantity {
+				line.MinQuantity, line.Quantity = line.Quantity, &maxQuantity
+			}
+			line.Unit, s = p.cutUnit(strings.TrimSpace(s[len(match[0]):]))
+		}
+	} else if _, rest, ok := cutWord(s, p.articles); ok {
+		// An article is only a quantity when followed by a unit, "a pinch of salt" having no quantity.
+		if unit, afterUnit := p.cutUnit(rest); unit != "" {
+			line.Quantity = new(1.0)
+			line.Unit, s = unit, afterUnit
+		}
+	}
+
+	if line.Unit != "" {
+		if _, rest, ok := cutWord(s, p.connectors); ok {
+			s = rest
+		}
+	}
+
+	line.Item = strings.TrimFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r) && r != '\'' && r != '’'
+	})
+
+	return line
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// aliases returns the ways of writing a unit, each one also being accepted without accent.
+func aliases(unit string, names ...string) []unitAlias {
+	result := []unitAlias{}
+	for _, name := range names {
+		result = append(result, unitAlias{alias: name, unit: unit})
+		if plain := accents.Replace(name); plain != name {
+			result = append(result, unitAlias{alias: plain, unit: unit})
+		}
+	}
+	return result
+}
+
+// cutUnit cuts the unit starting s, returning its name and the rest of s.
+// It returns an empty name and s untouched when s doesn't start with a unit.
+func (p *Parser) cutUnit(s string) (string, string) {
+	for _, a := range p.aliases {
+		if rest, ok := cutPrefixWord(s, a.alias); ok {
+			return a.unit, rest
+		}
+	}
+	return "", s
+}
+
+// cutWord cuts the first of words starting s, returning it and the rest of s.
+func cutWord(s string, words []string) (string, string, bool) {
+	for _, word := range words {
+		if rest, ok := cutPrefixWord(s, word); ok {
+			return word, rest, true
+		}
+	}
+	return "", s, false
+}
+
+// cutPrefixWord cuts prefix from s regardless of case, provided that it ends a word of s.
+// Prefixes ending with a dot or an apostrophe end a word on their own.
+func cutPrefixWord(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+
+	last, _ := utf8.DecodeLastRuneInString(prefix)
+	next, _ := utf8.DecodeRuneInString(s[len(prefix):])
+	if last != '.' && last != '\'' && last != '’' && (unicode.IsLetter(next) || unicode.IsDigit(next)) {
+		return s, false
+	}
+
+	return strings.TrimSpace(s[len(prefix):]), true
+}
+
+// noteComma returns the index of the first comma of s which isn't a decimal separator, or -1.
+func noteComma(s string) int {
+	for i := strings.IndexByte(s, ','); i >= 0; {
+		if i == 0 || i == len(s)-1 || !isASCIIDigit(s[i-1]) || !isASCIIDigit(s[i+1]) {
+			return i
+		}
+		next := strings.IndexByte(s[i+1:], ',')
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return -1
+}
+
+func isASCIIDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// parseAmount parses a number, a fraction or a whole number followed by a fraction.
+func parseAmount(amount string) (float64, bool) {
+	if amount == "" {
+		return 0, false
+	}
+
+	whole, fraction, isMixed := strings.Cut(amount, " ")
+	if !isMixed {
+		whole, fraction = "0", amount
+	}
+
+	wholeValue, err := strconv.ParseFloat(whole, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	numerator, denominator, isFraction := strings.Cut(fraction, "/")
+	if !isFraction {
+		value, err := strconv.ParseFloat(strings.Replace(fraction, ",", ".", 1), 64)
+		return wholeValue + value, err == nil
+	}
+
+	n, err := strconv.ParseFloat(numerator, 64)
+	if err != nil {
+		return 0, false
+	}
+	d, err := strconv.ParseFloat(denominator, 64)
+	if err != nil || d == 0 {
+		return 0, false
+	}
+
+	return wholeValue + n/d, true
+}
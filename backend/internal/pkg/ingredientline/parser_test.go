@@ -0,0 +1,173 @@
+package ingredientline
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+)
+
+// Tests for Parse
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		language enum.Language
+		want     Line
+	}{
+		{
+			name:     "Mixed number with a unit in the plural and a note",
+			text:     "2 1/2 cups flour, sifted",
+			language: enum.English,
+			want:     Line{Quantity: new(2.5), Unit: "Cup", Item: "flour", Note: "sifted"},
+		},
+		{
+			name:     "Unicode vulgar fraction stuck to a whole number",
+			text:     "1½ tsp baking soda",
+			language: enum.English,
+			want:     Line{Quantity: new(1.5), Unit: "Teaspoon", Item: "baking soda"},
+		},
+		{
+			name:     "Lone unicode vulgar fraction",
+			text:     "¾ cup of milk",
+			language: enum.English,
+			want:     Line{Quantity: new(0.75), Unit: "Cup", Item: "milk"},
+		},
+		{
+			name:     "Range with a dash",
+			text:     "2-3 tbsp. olive oil",
+			language: enum.English,
+			want:     Line{Quantity: new(3.0), MinQuantity: new(2.0), Unit: "Tablespoon", Item: "olive oil"},
+		},
+		{
+			name:     "Range with a word",
+			text:     "4 to 6 eggs",
+			language: enum.English,
+			want:     Line{Quantity: new(6.0), MinQuantity: new(4.0), Item: "eggs"},
+		},
+		{
+			name:     "Unit stuck to a decimal quantity",
+			text:     "1.5kg potatoes (peeled)",
+			language: enum.English,
+			want:     Line{Quantity: new(1.5), Unit: "Kilogram", Item: "potatoes", Note: "peeled"},
+		},
+		{
+			name:     "Article standing for a quantity",
+			text:     "A cup of sugar",
+			language: enum.English,
+			want:     Line{Quantity: new(1.0), Unit: "Cup", Item: "sugar"},
+		},
+		{
+			name:     "Article without unit",
+			text:     "a pinch of salt",
+			language: enum.English,
+			want:     Line{Item: "a pinch of salt"},
+		},
+		{
+			name:     "Unit of several words",
+			text:     "8 fl oz cream",
+			language: enum.English,
+			want:     Line{Quantity: new(8.0), Unit: "Fluid ounce", Item: "cream"},
+		},
+		{
+			name:     "Word starting like a unit",
+			text:     "3 carrots",
+			language: enum.English,
+			want:     Line{Quantity: new(3.0), Item: "carrots"},
+		},
+		{
+			name:     "French unit and connector",
+			text:     "200 g de beurre",
+			language: enum.French,
+			want:     Line{Quantity: new(200.0), Unit: "Gram", Item: "beurre"},
+		},
+		{
+			name:     "French decimal comma and elided connector",
+			text:     "1,5 c. à soupe d'huile d'olive, vierge extra",
+			language: enum.French,
+			want:     Line{Quantity: new(1.5), Unit: "Tablespoon", Item: "huile d'olive", Note: "vierge extra"},
+		},
+		{
+			name:     "French unit without accent",
+			text:     "2 cuilleres a cafe de sel",
+			language: enum.French,
+			want:     Line{Quantity: new(2.0), Unit: "Teaspoon", Item: "sel"},
+		},
+		{
+			name:     "French range and article",
+			text:     "1 à 2 gousses d'ail",
+			language: enum.French,
+			want:     Line{Quantity: new(2.0), MinQuantity: new(1.0), Item: "gousses d'ail"},
+		},
+		{
+			name:     "French article standing for a quantity",
+			text:     "une tasse de lait",
+			language: enum.French,
+			want:     Line{Quantity: new(1.0), Unit: "Cup", Item: "lait"},
+		},
+		{
+			name:     "Unknown language read as English",
+			text:     "500 ml milk",
+			language: enum.Language{},
+			want:     Line{Quantity: new(500.0), Unit: "Milliliter", Item: "milk"},
+		},
+		{
+			name:     "Item only",
+			text:     "Freshly ground pepper",
+			language: enum.English,
+			want:     Line{Item: "Freshly ground pepper"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Text = tt.text
+
+			actual := Parse(tt.text, tt.language)
+
+			if !reflect.DeepEqual(actual, tt.want) {
+				t.Errorf("Parse(%q) = %s, want %s", tt.text, format(actual), format(tt.want))
+			}
+		})
+	}
+}
+
+// Tests for NewParser
+func TestNewParserCustomUnits(t *testing.T) {
+	parser := NewParser(enum.English, "Handful", "Clove")
+
+	tests := []struct {
+		text string
+		want Line
+	}{
+		{"2 handfuls of spinach", Line{Quantity: new(2.0), Unit: "Handful", Item: "spinach"}},
+		{"1 Clove garlic", Line{Quantity: new(1.0), Unit: "Clove", Item: "garlic"}},
+		{"250 g flour", Line{Quantity: new(250.0), Unit: "Gram", Item: "flour"}},
+	}
+
+	for _, tt := range tests {
+		tt.want.Text = tt.text
+		if actual := parser.Parse(tt.text); !reflect.DeepEqual(actual, tt.want) {
+			t.Errorf("Parse(%q) = %s, want %s", tt.text, format(actual), format(tt.want))
+		}
+	}
+
+	if actual := NewParser(enum.English).Parse("2 handfuls of spinach"); actual.Unit != "" {
+		t.Errorf("expected custom units not to leak into other parsers, got unit %q", actual.Unit)
+	}
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// format prints a line with the values of its quantities rather than their addresses.
+func format(line Line) string {
+	quantity := func(q *float64) any {
+		if q == nil {
+			return nil
+		}
+		return *q
+	}
+	return fmt.Sprintf("{Text:%q Quantity:%v MinQuantity:%v Unit:%q Item:%q Note:%q}",
+		line.Text, quantity(line.Quantity), quantity(line.MinQuantity), line.Unit, line.Item, line.Note)
+}
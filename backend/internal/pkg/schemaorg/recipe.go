@@ -0,0 +1,260 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoRecipe is returned when a page holds no schema.org Recipe in its JSON-LD blocks.
+var ErrNoRecipe = errors.New("no schema.org Recipe found in the page")
+
+// Recipe holds the fields of a schema.org Recipe used to draft a recipe, cleaned from their markup.
+// Empty strings and nil pointers stand for the properties missing from the page.
+type Recipe struct {
+	Name         string
+	Description  string
+	Image        string
+	PrepTime     *time.Duration
+	CookTime     *time.Duration
+	Yield        *int
+	Instructions []string
+	Ingredients  []string
+	// Language is the inLanguage of the recipe, an IETF BCP 47 tag such as "fr-FR" by the standard.
+	Language string
+}
+
+var (
+	scriptTagRegexp   = regexp.MustCompile(`(?is)<script\b([^>]*)>(.*?)</script\s*>`)
+	markupRegexp      = regexp.MustCompile(`<[^>]*>`)
+	firstIntRegexp    = regexp.MustCompile(`\d+`)
+	isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?(?:T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+)
+
+// ExtractRecipe returns the first schema.org Recipe found in the JSON-LD blocks of an HTML page.
+// Recipes can be the root of a block, an element of a root array, or be nested in a @graph or a mainEntity.
+// Blocks which aren't valid JSON are skipped, as do the properties whose value can't be understood.
+func ExtractRecipe(page []byte) (*Recipe, error) {
+	for _, match := range scriptTagRegexp.FindAllSubmatch(page, -1) {
+		if !strings.Contains(strings.ToLower(string(match[1])), "application/ld+json") {
+			continue
+		}
+
+		var node any
+		if err := json.Unmarshal(match[2], &node); err != nil {
+			continue
+		}
+
+		if recipeNode := findRecipeNode(node); recipeNode != nil {
+			return toRecipe(recipeNode), nil
+		}
+	}
+
+	return nil, ErrNoRecipe
+}
+
+// ParseDuration parses an ISO 8601 duration such as "PT1H30M" or "P1DT2H".
+// Years and months are refused since their length varies.
+func ParseDuration(value string) (time.Duration, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	match := isoDurationRegexp.FindStringSubmatch(value)
+	if match == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, unit := range units {
+		if match[i+1] == "" {
+			continue
+		}
+		number, err := strconv.ParseFloat(strings.Replace(match[i+1], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q: %w", value, err)
+		}
+		duration += time.Duration(number * float64(unit))
+	}
+
+	return duration, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// findRecipeNode walks a JSON-LD node looking for an object typed as a Recipe.
+func findRecipeNode(node any) map[string]any {
+	switch n := node.(type) {
+	case []any:
+		for _, element := range n {
+			if recipeNode := findRecipeNode(element); recipeNode != nil {
+				return recipeNode
+			}
+		}
+	case map[string]any:
+		if isRecipeType(n["@type"]) {
+			return n
+		}
+		for _, key := range []string{"@graph", "mainEntity"} {
+			if recipeNode := findRecipeNode(n[key]); recipeNode != nil {
+				return recipeNode
+			}
+		}
+	}
+
+	return nil
+}
+
+// isRecipeType tells whether a @type, a single type or a list of types, designates a schema.org Recipe.
+func isRecipeType(value any) bool {
+	switch v := value.(type) {
+	case string:
+		v = strings.TrimPrefix(strings.TrimPrefix(v, "http://schema.org/"), "https://schema.org/")
+		return v == "Recipe"
+	case []any:
+		for _, t := range v {
+			if isRecipeType(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// toRecipe maps the properties of a Recipe node.
+func toRecipe(node map[string]any) *Recipe {
+	recipe := &Recipe{
+		Name:         text(node["name"]),
+		Description:  text(node["description"]),
+		Image:        image(node["image"]),
+		Yield:        yield(node["recipeYield"]),
+		Instructions: instructions(node["recipeInstructions"]),
+		Ingredients:  texts(node["recipeIngredient"]),
+		Language:     language(node["inLanguage"]),
+	}
+
+	// "ingredients" is the former name of recipeIngredient, still used by some sites.
+	if len(recipe.Ingredients) == 0 {
+		recipe.Ingredients = texts(node["ingredients"])
+	}
+
+	if prepTime, err := ParseDuration(text(node["prepTime"])); err == nil {
+		recipe.PrepTime = &prepTime
+	}
+	if cookTime, err := ParseDuration(text(node["cookTime"])); err == nil {
+		recipe.CookTime = &cookTime
+	}
+
+	return recipe
+}
+
+// text returns a string property without its markup, entities decoded and spaces collapsed.
+// Other types of values give an empty string.
+func text(value any) string {
+	s, ok := value.(string)
+	if !ok {
+		return ""
+	}
+
+	s = html.UnescapeString(markupRegexp.ReplaceAllString(s, " "))
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// texts returns the non-empty strings of a property which is either a string or a list of strings.
+func texts(value any) []string {
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+
+	result := []string{}
+	for _, v := range values {
+		if t := text(v); t != "" {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// language returns the tag of an inLanguage, which is either a text or a Language whose alternateName is the tag.
+func language(value any) string {
+	if v, ok := value.(map[string]any); ok {
+		return text(v["alternateName"])
+	}
+	return text(value)
+}
+
+// image returns the URL of the first image of a property which is either a URL, an ImageObject, or a list of them.
+func image(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case map[string]any:
+		return image(v["url"])
+	case []any:
+		for _, element := range v {
+			if url := image(element); url != "" {
+				return url
+			}
+		}
+	}
+	return ""
+}
+
+// yield returns the first positive integer of a recipeYield, e.g. 4 for "Serves 4 to 6".
+// The property is either a number, a text or a list of them.
+func yield(value any) *int {
+	switch v := value.(type) {
+	case float64:
+		if servings := int(v); servings > 0 {
+			return &servings
+		}
+	case string:
+		if servings, err := strconv.Atoi(firstIntRegexp.FindString(v)); err == nil && servings > 0 {
+			return &servings
+		}
+	case []any:
+		for _, element := range v {
+			if servings := yield(element); servings != nil {
+				return servings
+			}
+		}
+	}
+	return nil
+}
+
+// instructions returns the steps of a recipeInstructions, which is either a text, one step per line,
+// or a list of texts, HowToSteps and HowToSections. The name of a section is kept as a step heading its own steps.
+func instructions(value any) []string {
+	switch v := value.(type) {
+	case string:
+		steps := []string{}
+		for line := range strings.Lines(strings.ReplaceAll(v, "<br", "\n<br")) {
+			if step := text(line); step != "" {
+				steps = append(steps, step)
+			}
+		}
+		return steps
+	case []any:
+		steps := []string{}
+		for _, element := range v {
+			steps = append(steps, instructions(element)...)
+		}
+		return steps
+	case map[string]any:
+		if elements, ok := v["itemListElement"]; ok {
+			steps := texts(v["name"])
+			return append(steps, instructions(elements)...)
+		}
+		if step := text(v["text"]); step != "" {
+			return []string{step}
+		}
+		return texts(v["name"])
+	}
+	return []string{}
+}
@@ -0,0 +1,128 @@
+package schemaorg
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Tests for ExtractRecipe
+func TestExtractRecipe(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		want    *Recipe
+		err     error
+	}{
+		{
+			name:    "Recipe in a @graph",
+			fixture: "graph.html",
+			want: &Recipe{
+				Name:        "Fluffy Pancakes",
+				Description: "Thick & fluffy pancakes, ready in no time.",
+				Image:       "https://cooking.example.com/images/pancakes.jpg",
+				PrepTime:    new(10 * time.Minute),
+				CookTime:    new(20 * time.Minute),
+				Yield:       new(4),
+				Instructions: []string{
+					"Whisk the flour, the sugar and the salt.",
+					"Add the eggs and the milk, then whisk until smooth.",
+					"Cook the pancakes in a hot buttered pan.",
+				},
+				Ingredients: []string{"250 g flour", "2 eggs", "500 ml milk", "1 tbsp sugar", "a pinch of salt"},
+				Language:    "en",
+			},
+		},
+		{
+			name:    "Recipe in a list, after other and invalid blocks",
+			fixture: "list.html",
+			want: &Recipe{
+				Name:     "Soupe de tomates",
+				Image:    "https://cuisine.example.com/soupe.jpg",
+				PrepTime: new(15 * time.Minute),
+				CookTime: new(time.Hour + 5*time.Minute),
+				Yield:    new(6),
+				Instructions: []string{
+					"Préparation",
+					"Couper les tomates et l'oignon.",
+					"Cuisson",
+					"Faire revenir l'oignon dans l'huile.",
+					"Ajouter les tomates et cuire une heure.",
+				},
+				Ingredients: []string{"1 kg de tomates", "1 oignon", "2 c. à soupe d'huile d'olive"},
+				Language:    "fr-FR",
+			},
+		},
+		{
+			name:    "Recipe as a main entity, with legacy properties",
+			fixture: "legacy.html",
+			want: &Recipe{
+				Name:         "Grandma's Cookies",
+				Image:        "https://baking.example.com/cookies.png",
+				Yield:        new(24),
+				Instructions: []string{"Cream the butter.", "Add the flour.", "Bake 12 minutes."},
+				Ingredients:  []string{"200 g butter", "300 g flour"},
+			},
+		},
+		{
+			name:    "Page without recipe",
+			fixture: "no_recipe.html",
+			err:     ErrNoRecipe,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			if err != nil {
+				t.Fatalf("failed to read fixture: %v", err)
+			}
+
+			got, err := ExtractRecipe(page)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ExtractRecipe() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractRecipe() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Tests for ParseDuration
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"valid-minutes", "PT20M", 20 * time.Minute, false},
+		{"valid-hours and minutes", "PT1H30M", 90 * time.Minute, false},
+		{"valid-zero hours", "PT0H15M", 15 * time.Minute, false},
+		{"valid-days", "P1DT2H", 26 * time.Hour, false},
+		{"valid-weeks", "P1W", 7 * 24 * time.Hour, false},
+		{"valid-decimal hours", "PT1.5H", 90 * time.Minute, false},
+		{"valid-lowercase", "pt45s", 45 * time.Second, false},
+		{"invalid-empty", "", 0, true},
+		{"invalid-no component", "P", 0, true},
+		{"invalid-no time component", "P1DT", 0, true},
+		{"invalid-months", "P1M", 0, true},
+		{"invalid-text", "20 minutes", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
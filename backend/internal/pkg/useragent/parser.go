@@ -0,0 +1,86 @@
+package useragent
+
+import "strings"
+
+const (
+	UnknownBrowser = "Unknown browser"
+	UnknownOS      = "Unknown OS"
+)
+
+// Agent is the browser and operating system read from a User-Agent header,
+// e.g. "Firefox" and "Linux" for "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0".
+type Agent struct {
+	Browser string
+	OS      string
+}
+
+// marker is a substring of a User-Agent header identifying a browser or an operating system.
+type marker struct {
+	token string
+	name  string
+}
+
+// browsers are checked in order, since most of them also claim to be the ones they are based on,
+// e.g. Edge sends "Chrome/" and "Safari/" besides "Edg/".
+var browsers = []marker{
+	{"Edg/", "Edge"},
+	{"EdgiOS/", "Edge"},
+	{"EdgA/", "Edge"},
+	{"OPR/", "Opera"},
+	{"SamsungBrowser/", "Samsung Internet"},
+	{"Vivaldi/", "Vivaldi"},
+	{"FxiOS/", "Firefox"},
+	{"Firefox/", "Firefox"},
+	{"CriOS/", "Chrome"},
+	{"Chromium/", "Chromium"},
+	{"Chrome/", "Chrome"},
+	{"Safari/", "Safari"},
+}
+
+// systems are checked in order, e.g. Android sends "Linux" and iOS sends "like Mac OS X".
+var systems = []marker{
+	{"Windows", "Windows"},
+	{"Android", "Android"},
+	{"iPhone", "iOS"},
+	{"iPad", "iPadOS"},
+	{"CrOS", "ChromeOS"},
+	{"Mac OS X", "macOS"},
+	{"Macintosh", "macOS"},
+	{"Linux", "Linux"},
+}
+
+// Parse reads the browser and the operating system from a User-Agent header.
+// A client which isn't a browser, e.g. "curl/8.5.0", is named after its first product token.
+func Parse(userAgent string) Agent {
+	agent := Agent{
+		Browser: find(userAgent, browsers),
+		OS:      find(userAgent, systems),
+	}
+
+	if agent.Browser == "" {
+		agent.Browser = UnknownBrowser
+		if product, _, ok := strings.Cut(userAgent, "/"); ok && product != "" && product != "Mozilla" && !strings.ContainsAny(product, " ;()") {
+			agent.Browser = product
+		}
+	}
+	if agent.OS == "" {
+		agent.OS = UnknownOS
+	}
+
+	return agent
+}
+
+// Label describes the agent for a human, e.g. "Firefox on Linux".
+func (a Agent) Label() string {
+	return a.Browser + " on " + a.OS
+}
+
+// find returns the name of the first marker found in the User-Agent header, or an empty string.
+func find(userAgent string, markers []marker) string {
+	for _, m := range markers {
+		if strings.Contains(userAgent, m.token) {
+			return m.name
+		}
+	}
+	return ""
+}
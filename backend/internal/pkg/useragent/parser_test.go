@@ -0,0 +1,80 @@
+package useragent
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		expected  Agent
+		label     string
+	}{
+		{
+			name:      "firefox on linux",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0",
+			expected:  Agent{Browser: "Firefox", OS: "Linux"},
+			label:     "Firefox on Linux",
+		},
+		{
+			name:      "chrome on windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+			expected:  Agent{Browser: "Chrome", OS: "Windows"},
+			label:     "Chrome on Windows",
+		},
+		{
+			name:      "edge on windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36 Edg/126.0.2592.68",
+			expected:  Agent{Browser: "Edge", OS: "Windows"},
+			label:     "Edge on Windows",
+		},
+		{
+			name:      "safari on macos",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Safari/605.1.15",
+			expected:  Agent{Browser: "Safari", OS: "macOS"},
+			label:     "Safari on macOS",
+		},
+		{
+			name:      "safari on iphone",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1",
+			expected:  Agent{Browser: "Safari", OS: "iOS"},
+			label:     "Safari on iOS",
+		},
+		{
+			name:      "chrome on android",
+			userAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Mobile Safari/537.36",
+			expected:  Agent{Browser: "Chrome", OS: "Android"},
+			label:     "Chrome on Android",
+		},
+		{
+			name:      "firefox on iphone",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) FxiOS/127.0 Mobile/15E148 Safari/605.1.15",
+			expected:  Agent{Browser: "Firefox", OS: "iOS"},
+			label:     "Firefox on iOS",
+		},
+		{
+			name:      "command line client",
+			userAgent: "curl/8.5.0",
+			expected:  Agent{Browser: "curl", OS: UnknownOS},
+			label:     "curl on Unknown OS",
+		},
+		{
+			name:      "empty user agent",
+			userAgent: "",
+			expected:  Agent{Browser: UnknownBrowser, OS: UnknownOS},
+			label:     "Unknown browser on Unknown OS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := Parse(tt.userAgent)
+
+			if actual != tt.expected {
+				t.Errorf("Parse() = %+v, want %+v", actual, tt.expected)
+			}
+			if actual.Label() != tt.label {
+				t.Errorf("Label() = %q, want %q", actual.Label(), tt.label)
+			}
+		})
+	}
+}
@@ -7,11 +7,28 @@ import (
 )
 
 func GenerateSessionID() string {
+	return generateRandomToken("session ID")
+}
+
+// GenerateInvitationToken returns a random URL-safe token used to invite users into a group.
+func GenerateInvitationToken() string {
+	return generateRandomToken("invitation token")
+}
+
+// GenerateAPIToken returns a random token authenticating the requests of a user.
+// Its "yum_" prefix makes it recognizable, e.g. by secret scanners.
+func GenerateAPIToken() string {
+	return "yum_" + generateRandomToken("API token")
+}
+
+// generateRandomToken returns 32 random bytes encoded in base64 RawURL.
+// It panics if the random generator fails, naming the kind of token in the panic message.
+func generateRandomToken(what string) string {
 	id := make([]byte, 32)
 
 	_, err := io.ReadFull(rand.Reader, id)
 	if err != nil {
-		panic("Failed to generate session ID: " + err.Error())
+		panic("Failed to generate " + what + ": " + err.Error())
 	}
 
 	return base64.RawURLEncoding.EncodeToString(id)
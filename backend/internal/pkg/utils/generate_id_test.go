@@ -30,3 +30,32 @@ func TestGenerateUniqueSessionIDs(t *testing.T) {
 		t.Error("Expected unique session IDs, got identical values")
 	}
 }
+
+func TestGenerateInvitationTokenWithCorrectLength(t *testing.T) {
+	token := GenerateInvitationToken()
+
+	// 32 bytes encoded in base64 RawURL = 43 characters
+	expectedLength := 43
+	if len(token) != expectedLength {
+		t.Errorf("Expected invitation token length to be %d, got %d", expectedLength, len(token))
+	}
+}
+
+func TestGenerateUniqueInvitationTokens(t *testing.T) {
+	token1 := GenerateInvitationToken()
+	token2 := GenerateInvitationToken()
+
+	if token1 == token2 {
+		t.Error("Expected unique invitation tokens, got identical values")
+	}
+}
+
+func TestGenerateAPITokenWithPrefix(t *testing.T) {
+	token := GenerateAPIToken()
+
+	// "yum_" followed by 32 bytes encoded in base64 RawURL = 4 + 43 characters
+	expectedLength := 47
+	if len(token) != expectedLength || token[:4] != "yum_" {
+		t.Errorf("Expected API token of length %d prefixed by yum_, got %q", expectedLength, token)
+	}
+}
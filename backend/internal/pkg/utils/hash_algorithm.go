@@ -1,6 +1,11 @@
 package utils
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
+)
 
 func HashPassword(password string) (string, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword(
@@ -13,3 +18,10 @@ func HashPassword(password string) (string, error) {
 
 	return string(hashedPassword), nil
 }
+
+// HashToken returns the hex encoded SHA-256 digest of a random token, e.g. a session ID.
+// Unlike passwords, such tokens have enough entropy to not need a slow, salted hash.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
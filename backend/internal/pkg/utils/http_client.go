@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when a PublicHTTPClient is asked to reach a non-public address.
+var ErrNonPublicAddress = errors.New("connections to non-public addresses are refused")
+
+// NewPublicHTTPClient returns an HTTP client which only connects to public addresses,
+// so that the URLs provided by users can't be used to reach the server itself or its private network.
+// The check is made on the resolved address of every connection, redirects included.
+func NewPublicHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !IsPublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrNonPublicAddress, address)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+}
+
+// IsPublicAddr tells whether an IP address is routable on the internet,
+// i.e. neither loopback, private, link-local, multicast nor unspecified.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() &&
+		// Shared address space of carrier-grade NATs (RFC 6598)
+		!netip.MustParsePrefix("100.64.0.0/10").Contains(addr)
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+// Tests for IsPublicAddr
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"valid-public IPv4", "93.184.215.14", true},
+		{"valid-public IPv6", "2606:2800:21f:cb07:6820:80da:af6b:8b2c", true},
+		{"invalid-loopback", "127.0.0.1", false},
+		{"invalid-IPv6 loopback", "::1", false},
+		{"invalid-private", "192.168.1.10", false},
+		{"invalid-IPv4-mapped private", "::ffff:10.0.0.1", false},
+		{"invalid-link-local", "169.254.169.254", false},
+		{"invalid-carrier-grade NAT", "100.64.0.1", false},
+		{"invalid-unspecified", "0.0.0.0", false},
+		{"invalid-multicast", "224.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsPublicAddr(netip.MustParseAddr(tt.input))
+			if got != tt.want {
+				t.Fatalf("IsPublicAddr(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// Tests for NewPublicHTTPClient
+func TestPublicHTTPClientRefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := NewPublicHTTPClient(time.Second).Get(server.URL)
+	if !errors.Is(err, ErrNonPublicAddress) {
+		t.Fatalf("expected the connection to %s to be refused, got %v", server.URL, err)
+	}
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type APITokenRepositoryInterface interface {
+	GetByID(id int64) (*model.APIToken, error)
+	GetByHash(tokenHash string) (*model.APIToken, error)
+	GetByUserID(userID int64) ([]model.APIToken, error)
+	Create(token *model.APIToken) (int64, error)
+	UpdateLastUsed(id int64, lastUsedAt time.Time) error
+	Delete(id int64) error
+	DeleteByUserID(userID int64) error
+}
+
+type APITokenRepository struct {
+	db *sql.DB
+}
+
+// NewAPITokenRepository constructs a new APITokenRepository using the provided database.
+func NewAPITokenRepository(db *sql.DB) *APITokenRepository {
+	return &APITokenRepository{
+		db: db,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID retrieves an API token by its ID.
+func (r *APITokenRepository) GetByID(id int64) (*model.APIToken, error) {
+	tokens, err := r.fetchTokens("WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, customErrors.NewNotFoundError("api_tokens", "id", nil)
+	}
+
+	return &tokens[0], nil
+}
+
+// GetByHash retrieves an API token by the digest of its token.
+func (r *APITokenRepository) GetByHash(tokenHash string) (*model.APIToken, error) {
+	tokens, err := r.fetchTokens("WHERE token_hash = ?", tokenHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, customErrors.NewNotFoundError("api_tokens", "token", nil)
+	}
+
+	return &tokens[0], nil
+}
+
+// GetByUserID retrieves all the API tokens of a user, the most recent first.
+func (r *APITokenRepository) GetByUserID(userID int64) ([]model.APIToken, error) {
+	return r.fetchTokens("WHERE user_id = ? ORDER BY created_at DESC, id DESC", userID)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create inserts a new API token and returns its ID.
+func (r *APITokenRepository) Create(token *model.APIToken) (int64, error) {
+	res, err := r.db.Exec(
+		`INSERT INTO api_tokens (user_id, name, token_hash, scope, created_at, expires_at, last_used_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		token.UserID,
+		token.Name,
+		token.TokenHash,
+		token.Scope,
+		token.CreatedAt,
+		token.ExpiresAt,
+		token.LastUsedAt,
+	)
+	if err != nil {
+		if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok && sqlerr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+			return 0, customErrors.NewNotFoundError("users", "id", sqlerr)
+		}
+		return 0, customErrors.NewInternalError("failed to create API token", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve API token ID", err)
+	}
+
+	return id, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// UpdateLastUsed sets the time of the last use of an API token.
+func (r *APITokenRepository) UpdateLastUsed(id int64, lastUsedAt time.Time) error {
+	_, err := r.db.Exec("UPDATE api_tokens SET last_used_at = ? WHERE id = ?", lastUsedAt, id)
+	if err != nil {
+		return customErrors.NewInternalError("failed to update the last use of API token", err)
+	}
+	return nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes an API token, which can then no longer be used.
+func (r *APITokenRepository) Delete(id int64) error {
+	result, err := r.db.Exec("DELETE FROM api_tokens WHERE id = ?", id)
+	if err != nil {
+		return customErrors.NewInternalError("failed to delete API token", err)
+	}
+
+	deletedRow, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to retrieve deleted API token", err)
+	}
+
+	if deletedRow == 0 {
+		return customErrors.NewNotFoundError("api_tokens", "id", nil)
+	}
+
+	return nil
+}
+
+// DeleteByUserID removes all the API tokens of a user.
+func (r *APITokenRepository) DeleteByUserID(userID int64) error {
+	_, err := r.db.Exec("DELETE FROM api_tokens WHERE user_id = ?", userID)
+	if err != nil {
+		return customErrors.NewInternalError("failed to delete user API tokens", err)
+	}
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
+func (r *APITokenRepository) fetchTokens(clauses string, values ...any) ([]model.APIToken, error) {
+	query := `SELECT id, user_id, name, token_hash, scope, created_at, expires_at, last_used_at
+	FROM api_tokens ` + clauses
+
+	slog.Debug("fetching API tokens", "query", query)
+
+	rows, err := r.db.Query(query, values...)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch API tokens", err)
+	}
+	defer rows.Close()
+
+	tokens := []model.APIToken{}
+
+	for rows.Next() {
+		var token model.APIToken
+		err := rows.Scan(
+			&token.ID,
+			&token.UserID,
+			&token.Name,
+			&token.TokenHash,
+			&token.Scope,
+			&token.CreatedAt,
+			&token.ExpiresAt,
+			&token.LastUsedAt,
+		)
+		if err != nil {
+			return nil, customErrors.NewInternalError("failed to fetch API tokens", err)
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch API tokens", err)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+var invalidAPITokenID = int64(-1)
+
+func TestNewAPITokenRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewAPITokenRepository(db)
+
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+
+	if repo.db != db {
+		t.Error("expected repository db to match the provided db")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetAPITokenByID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewAPITokenRepository(db)
+
+	tests := []struct {
+		name         string
+		id           int64
+		expectedName string
+		expectErr    error
+	}{
+		{name: "Existing token", id: 2, expectedName: "Meal planner"},
+		{
+			name:      "Unknown token",
+			id:        invalidAPITokenID,
+			expectErr: customErrors.NewNotFoundError("api_tokens", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := repo.GetByID(tt.id)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if token.Name != tt.expectedName {
+				t.Errorf("expected name %s, got %s", tt.expectedName, token.Name)
+			}
+		})
+	}
+}
+
+func TestGetAPITokenByHash(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewAPITokenRepository(db)
+
+	tests := []struct {
+		name      string
+		tokenHash string
+		expected  *model.APIToken
+		expectErr error
+	}{
+		{
+			name:      "Token without expiry",
+			tokenHash: "readtokenhash",
+			expected: &model.APIToken{
+				ID: 1, UserID: 1, Name: "Grocery export", TokenHash: "readtokenhash", Scope: enum.ReadScope,
+				CreatedAt: time.Now().Add(-2 * 24 * time.Hour),
+			},
+		},
+		{
+			name:      "Used token with expiry",
+			tokenHash: "writetokenhash",
+			expected: &model.APIToken{
+				ID: 2, UserID: 1, Name: "Meal planner", TokenHash: "writetokenhash", Scope: enum.WriteScope,
+				CreatedAt:  time.Now().Add(-24 * time.Hour),
+				ExpiresAt:  new(time.Now().Add(30 * 24 * time.Hour)),
+				LastUsedAt: new(time.Now().Add(-time.Hour)),
+			},
+		},
+		{
+			name:      "Unknown token",
+			tokenHash: "unknown",
+			expectErr: customErrors.NewNotFoundError("api_tokens", "token", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := repo.GetByHash(tt.tokenHash)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if token.ID != tt.expected.ID || token.UserID != tt.expected.UserID || token.Name != tt.expected.Name {
+				t.Errorf("expected token %d '%s' of user %d, got %d '%s' of user %d",
+					tt.expected.ID, tt.expected.Name, tt.expected.UserID, token.ID, token.Name, token.UserID)
+			}
+			if token.TokenHash != tt.expected.TokenHash || token.Scope != tt.expected.Scope {
+				t.Errorf("expected hash %s and scope %v, got %s and %v", tt.expected.TokenHash, tt.expected.Scope, token.TokenHash, token.Scope)
+			}
+			if !utils.TimesApproximatelyEqual(token.CreatedAt, tt.expected.CreatedAt, time.Minute) {
+				t.Errorf("expected CreatedAt around %v, got %v", tt.expected.CreatedAt, token.CreatedAt)
+			}
+			compareOptionalTimes(t, "ExpiresAt", token.ExpiresAt, tt.expected.ExpiresAt)
+			compareOptionalTimes(t, "LastUsedAt", token.LastUsedAt, tt.expected.LastUsedAt)
+		})
+	}
+}
+
+func TestGetAPITokensByUserID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewAPITokenRepository(db)
+
+	tests := []struct {
+		name        string
+		userID      int64
+		expectedIDs []int64
+	}{
+		{name: "User with several tokens", userID: 1, expectedIDs: []int64{2, 1, 3}},
+		{name: "User with one token", userID: 2, expectedIDs: []int64{4}},
+		{name: "User without token", userID: 3, expectedIDs: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := repo.GetByUserID(tt.userID)
+			if err != nil {
+				t.Fatalf("GetByUserID() unexpected error = %v", err)
+			}
+
+			if len(tokens) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d tokens, got %d", len(tt.expectedIDs), len(tokens))
+			}
+
+			for i := range tokens {
+				if tokens[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected token %d, got %d", tt.expectedIDs[i], tokens[i].ID)
+				}
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreateAPIToken(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewAPITokenRepository(db)
+
+	tests := []struct {
+		name      string
+		token     *model.APIToken
+		expectErr error
+	}{
+		{
+			name: "Token with expiry",
+			token: &model.APIToken{
+				UserID: 3, Name: "Backup", TokenHash: "newtokenhash", Scope: enum.ReadScope,
+				CreatedAt: time.Now().UTC(), ExpiresAt: new(time.Now().UTC().Add(time.Hour)),
+			},
+		},
+		{
+			name: "Unknown user",
+			token: &model.APIToken{
+				UserID: -1, Name: "Backup", TokenHash: "othertokenhash", Scope: enum.ReadScope, CreatedAt: time.Now().UTC(),
+			},
+			expectErr: customErrors.NewNotFoundError("users", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := repo.Create(tt.token)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			created, err := repo.GetByID(id)
+			if err != nil {
+				t.Fatalf("failed to retrieve created token: %v", err)
+			}
+
+			if created.UserID != tt.token.UserID || created.Name != tt.token.Name || created.TokenHash != tt.token.TokenHash || created.Scope != tt.token.Scope {
+				t.Errorf("expected token %+v, got %+v", tt.token, created)
+			}
+			compareOptionalTimes(t, "ExpiresAt", created.ExpiresAt, tt.token.ExpiresAt)
+			if created.LastUsedAt != nil {
+				t.Errorf("expected LastUsedAt nil, got %v", created.LastUsedAt)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestUpdateAPITokenLastUsed(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewAPITokenRepository(db)
+	now := time.Now().UTC()
+
+	if err := repo.UpdateLastUsed(1, now); err != nil {
+		t.Fatalf("UpdateLastUsed() unexpected error = %v", err)
+	}
+
+	token, err := repo.GetByID(1)
+	if err != nil {
+		t.Fatalf("failed to retrieve updated token: %v", err)
+	}
+
+	compareOptionalTimes(t, "LastUsedAt", token.LastUsedAt, &now)
+}
+
+/*** DELETE OPERATIONS ***/
+
+func TestDeleteAPIToken(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewAPITokenRepository(db)
+
+	tests := []struct {
+		name      string
+		id        int64
+		expectErr error
+	}{
+		{name: "Existing token", id: 1},
+		{
+			name:      "Unknown token",
+			id:        invalidAPITokenID,
+			expectErr: customErrors.NewNotFoundError("api_tokens", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.Delete(tt.id)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if _, err := repo.GetByID(tt.id); err == nil {
+				t.Error("token still exists after deletion")
+			}
+		})
+	}
+}
+
+func TestDeleteAPITokensByUserID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewAPITokenRepository(db)
+
+	if err := repo.DeleteByUserID(1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tokens, err := repo.GetByUserID(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tokens) != 0 {
+		t.Errorf("expected no token left for user 1, got %d", len(tokens))
+	}
+
+	// The tokens of the other users are kept
+	if tokens, _ := repo.GetByUserID(2); len(tokens) != 1 {
+		t.Errorf("expected 1 token left for user 2, got %d", len(tokens))
+	}
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// compareOptionalTimes checks that both times are nil, or both set and approximately equal.
+func compareOptionalTimes(t *testing.T, field string, actual, expected *time.Time) {
+	t.Helper()
+
+	if (actual == nil) != (expected == nil) {
+		t.Errorf("expected %s %v, got %v", field, expected, actual)
+		return
+	}
+	if actual != nil && !utils.TimesApproximatelyEqual(*actual, *expected, time.Minute) {
+		t.Errorf("expected %s around %v, got %v", field, *expected, *actual)
+	}
+}
@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type DishRepositoryInterface interface {
+	GetByID(id int64) (*model.Dish, error)
+	GetByGroupIDAndPeriod(groupID int64, from, to time.Time) ([]model.Dish, error)
+	Create(ctx context.Context, dish *model.Dish) (int64, error)
+	Update(ctx context.Context, dish *model.Dish) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type DishRepository struct {
+	db *sql.DB
+}
+
+func NewDishRepository(db *sql.DB) *DishRepository {
+	return &DishRepository{
+		db: db,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID retrieves a dish from the database by its ID, with a summary of its recipes.
+func (r *DishRepository) GetByID(id int64) (*model.Dish, error) {
+	dishes, err := r.fetchDishes("WHERE dishes.id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dishes) == 0 {
+		return nil, customErrors.NewNotFoundError("dishes", "id", nil)
+	}
+
+	return &dishes[0], nil
+}
+
+// GetByGroupIDAndPeriod retrieves the dishes of a group scheduled from the from date included to the to date excluded,
+// sorted by date.
+func (r *DishRepository) GetByGroupIDAndPeriod(groupID int64, from, to time.Time) ([]model.Dish, error) {
+	// Dates are compared through datetime() as they may be stored in different text formats.
+	return r.fetchDishes(
+		`WHERE dishes.group_id = ? AND datetime(dishes.datetime) >= datetime(?) AND datetime(dishes.datetime) < datetime(?)
+		ORDER BY datetime(dishes.datetime), dishes.id`,
+		groupID, from.UTC(), to.UTC(),
+	)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create inserts a new dish with the links to its recipes and returns its ID.
+func (r *DishRepository) Create(ctx context.Context, dish *model.Dish) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"INSERT INTO dishes (portion, bought, cooked, datetime, group_id) VALUES (?, ?, ?, ?, ?)",
+		dish.Portion,
+		dish.Bought,
+		dish.Cooked,
+		dish.Datetime.UTC(),
+		dish.GroupID,
+	)
+	if err != nil {
+		return 0, dishWriteError(err, "failed to create dish")
+	}
+
+	dish.ID, err = res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve dish ID", err)
+	}
+
+	if err = r.updateRecipesDishesJunction(ctx, tx, dish); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return dish.ID, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update replaces the portion, date, bought state and recipes of the dish identified by dish.ID.
+// Whether the dish was cooked is only set by PantryRepository.DeductForDish.
+func (r *DishRepository) Update(ctx context.Context, dish *model.Dish) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE dishes SET portion = ?, bought = ?, datetime = ? WHERE id = ?",
+		dish.Portion,
+		dish.Bought,
+		dish.Datetime.UTC(),
+		dish.ID,
+	)
+	if err != nil {
+		return customErrors.NewInternalError("failed to update dish", err)
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if dish was updated", err)
+	}
+	if updatedRows == 0 {
+		return customErrors.NewNotFoundError("dishes", "id", nil)
+	}
+
+	if err = r.updateRecipesDishesJunction(ctx, tx, dish); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the dish identified by id and its links to recipes; the recipes themselves are kept.
+func (r *DishRepository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM recipes_dishes_junction WHERE dish_id = ?", id); err != nil {
+		return customErrors.NewInternalError("failed to delete recipes_dishes_junction", err)
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM dishes WHERE id = ?", id)
+	if err != nil {
+		return customErrors.NewInternalError("failed to delete dish", err)
+	}
+
+	deletedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if dish was deleted", err)
+	}
+	if deletedRows == 0 {
+		return customErrors.NewNotFoundError("dishes", "id", nil)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// fetchDishes is a helper method to retrieve multiple dishes, with a summary of their recipes, based on filtering options.
+func (r *DishRepository) fetchDishes(clauses string, values ...any) ([]model.Dish, error) {
+	query := `SELECT
+	dishes.id, dishes.portion, dishes.bought, dishes.cooked, dishes.datetime, dishes.group_id,
+	recipes.id, recipes.name, recipes.image_url, recipes.preparation_time_min, recipes.cooking_time_min, recipes.servings
+	FROM dishes
+	LEFT JOIN recipes_dishes_junction ON recipes_dishes_junction.dish_id = dishes.id
+	LEFT JOIN recipes ON recipes.id = recipes_dishes_junction.recipe_id ` + clauses
+
+	slog.Debug("fetching dishes", "query", query)
+
+	rows, err := r.db.Query(query, values...)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch dishes", err)
+	}
+	defer rows.Close()
+
+	dishes := []model.Dish{}
+	// A dish is returned on as many rows as it has recipes.
+	dishIndexes := make(map[int64]int)
+
+	for rows.Next() {
+		var dish model.Dish
+		var recipe model.Recipe
+		// Recipes are LEFT JOINed, so their columns are NULL for dishes without any.
+		var recipeID sql.NullInt64
+		var recipeName sql.NullString
+
+		err := rows.Scan(
+			&dish.ID,
+			&dish.Portion,
+			&dish.Bought,
+			&dish.Cooked,
+			&dish.Datetime,
+			&dish.GroupID,
+			&recipeID,
+			&recipeName,
+			&recipe.ImageURL,
+			&recipe.PreparationTimeMin,
+			&recipe.CookingTimeMin,
+			&recipe.Servings,
+		)
+
+		if err != nil {
+			return nil, customErrors.NewInternalError("failed to fetch dishes", err)
+		}
+
+		i, exists := dishIndexes[dish.ID]
+		if !exists {
+			dish.Recipes = []model.Recipe{}
+			dishes = append(dishes, dish)
+			i = len(dishes) - 1
+			dishIndexes[dish.ID] = i
+		}
+
+		if recipeID.Valid {
+			recipe.ID = recipeID.Int64
+			recipe.Name = recipeName.String
+			recipe.GroupID = dish.GroupID
+			dishes[i].Recipes = append(dishes[i].Recipes, recipe)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("failed to iterate rows", err)
+	}
+
+	return dishes, nil
+}
+
+// updateRecipesDishesJunction replaces the links between the dish and its recipes.
+func (r *DishRepository) updateRecipesDishesJunction(ctx context.Context, tx *sql.Tx, dish *model.Dish) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM recipes_dishes_junction WHERE dish_id = ?", dish.ID); err != nil {
+		return customErrors.NewInternalError("failed to delete obsolete recipes_dishes_junction", err)
+	}
+
+	// An empty VALUES list is a syntax error, so a dish without recipes only has its junctions deleted.
+	if len(dish.Recipes) == 0 {
+		return nil
+	}
+
+	query := "INSERT INTO recipes_dishes_junction (recipe_id, dish_id) VALUES " +
+		strings.Join(slices.Repeat([]string{"(?, ?)"}, len(dish.Recipes)), ", ") + " " +
+		"ON CONFLICT(recipe_id, dish_id) DO NOTHING"
+
+	values := make([]any, 0, len(dish.Recipes)*2)
+	for _, recipe := range dish.Recipes {
+		values = append(values, recipe.ID, dish.ID)
+	}
+
+	slog.Debug("update recipe dish junctions", "query", query)
+
+	if _, err := tx.ExecContext(ctx, query, values...); err != nil {
+		return dishWriteError(err, "failed to update recipe dish junctions")
+	}
+
+	return nil
+}
+
+// dishWriteError maps the constraint violations of an insert of a dish or of its recipes to application errors.
+func dishWriteError(err error, msg string) error {
+	if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok && sqlerr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+		return customErrors.NewNotFoundError("recipes or groups", "id", sqlerr)
+	}
+	return customErrors.NewInternalError(msg, err)
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+func TestNewDishRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewDishRepository(db)
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+	if repo.db != db {
+		t.Error("expected repository to use the provided database")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetDishByID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewDishRepository(db)
+
+	actual, err := repo.GetByID(3)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	if actual.Portion != 12 || !actual.Bought || actual.GroupID != 1 || actual.Datetime.IsZero() {
+		t.Errorf("unexpected dish %+v", *actual)
+	}
+	if len(actual.Recipes) != 1 || actual.Recipes[0].ID != 2 || actual.Recipes[0].Name != "Chocolate Chip Cookies" {
+		t.Errorf("expected the cookies recipe, got %+v", actual.Recipes)
+	}
+
+	_, err = repo.GetByID(-1)
+	if !utils.CompareErrors(err, customErrors.NewNotFoundError("dishes", "id", nil)) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestGetDishesByGroupIDAndPeriod(t *testing.T) {
+	// The dishes of the test data are scheduled 18, 12 and 9 hours ago.
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		groupID     int64
+		from        time.Time
+		to          time.Time
+		expectedIDs []int64
+	}{
+		{"whole period sorted by date", 1, now.Add(-48 * time.Hour), now, []int64{1, 2, 3}},
+		{"part of the period", 1, now.Add(-15 * time.Hour), now, []int64{2, 3}},
+		{"excluded end of the period", 1, now.Add(-24 * time.Hour), now.Add(-12 * time.Hour).Add(-time.Minute), []int64{1}},
+		{"period in another time zone", 1, now.Add(-15 * time.Hour).In(time.FixedZone("UTC+5", 5*3600)), now, []int64{2, 3}},
+		{"future period", 1, now, now.Add(7 * 24 * time.Hour), []int64{}},
+		{"group without dishes", 2, now.Add(-48 * time.Hour), now, []int64{}},
+	}
+
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewDishRepository(db)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByGroupIDAndPeriod(tt.groupID, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actualIDs := make([]int64, len(actual))
+			for i, dish := range actual {
+				actualIDs[i] = dish.ID
+			}
+
+			if !reflect.DeepEqual(actualIDs, tt.expectedIDs) {
+				t.Errorf("expected dishes %v, got %v", tt.expectedIDs, actualIDs)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestDishRepositoryCreate(t *testing.T) {
+	datetime := time.Date(2026, time.October, 20, 19, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		dish model.Dish
+		err  error
+	}{
+		{"dish of several recipes", model.Dish{Portion: 4, Datetime: datetime, GroupID: 1, Recipes: []model.Recipe{{ID: 1}, {ID: 3}}}, nil},
+		{"dish without recipe", model.Dish{Portion: 2, Datetime: datetime, GroupID: 1, Recipes: []model.Recipe{}}, nil},
+		{"unknown recipe", model.Dish{Portion: 2, Datetime: datetime, GroupID: 1, Recipes: []model.Recipe{{ID: -1}}}, customErrors.NewNotFoundError("recipes or groups", "id", nil)},
+		{"unknown group", model.Dish{Portion: 2, Datetime: datetime, GroupID: -1, Recipes: []model.Recipe{}}, customErrors.NewNotFoundError("recipes or groups", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewDishRepository(db)
+
+			id, err := repo.Create(context.Background(), &tt.dish)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(id)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if actual.Portion != tt.dish.Portion || actual.Bought || !actual.Datetime.Equal(datetime) || len(actual.Recipes) != len(tt.dish.Recipes) {
+				t.Errorf("expected %+v, got %+v", tt.dish, *actual)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestDishRepositoryUpdate(t *testing.T) {
+	datetime := time.Date(2026, time.October, 21, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		dish model.Dish
+		err  error
+	}{
+		{"moved dish with other recipes", model.Dish{ID: 1, Portion: 2, Bought: true, Datetime: datetime, Recipes: []model.Recipe{{ID: 2}, {ID: 3}}}, nil},
+		{"unknown recipe", model.Dish{ID: 1, Portion: 2, Datetime: datetime, Recipes: []model.Recipe{{ID: -1}}}, customErrors.NewNotFoundError("recipes or groups", "id", nil)},
+		{"unknown dish", model.Dish{ID: -1, Portion: 2, Datetime: datetime}, customErrors.NewNotFoundError("dishes", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewDishRepository(db)
+
+			err := repo.Update(context.Background(), &tt.dish)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(tt.dish.ID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actualRecipeIDs := make([]int64, len(actual.Recipes))
+			for i, recipe := range actual.Recipes {
+				actualRecipeIDs[i] = recipe.ID
+			}
+
+			if actual.Portion != 2 || !actual.Bought || !actual.Datetime.Equal(datetime) || !reflect.DeepEqual(actualRecipeIDs, []int64{2, 3}) {
+				t.Errorf("unexpected updated dish %+v", *actual)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS ***/
+
+func TestDishRepositoryDelete(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewDishRepository(db)
+
+	if err := repo.Delete(context.Background(), 1); err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	if _, err := repo.GetByID(1); !utils.CompareErrors(err, customErrors.NewNotFoundError("dishes", "id", nil)) {
+		t.Errorf("expected dish to be deleted, got %v", err)
+	}
+
+	// The recipe of the dish is kept.
+	var recipesNb int
+	if err := db.QueryRow("SELECT COUNT(*) FROM recipes WHERE id = 1").Scan(&recipesNb); err != nil || recipesNb != 1 {
+		t.Errorf("expected the recipe of the dish to be kept, got %d (%v)", recipesNb, err)
+	}
+
+	if err := repo.Delete(context.Background(), 1); !utils.CompareErrors(err, customErrors.NewNotFoundError("dishes", "id", nil)) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
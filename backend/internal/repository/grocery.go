@@ -1,13 +1,26 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"log/slog"
 
+	"github.com/mattn/go-sqlite3"
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
 )
 
 type GroceryRepositoryInterface interface {
 	HasItem(id int64) (bool, error)
+	GetByID(id int64) (*model.Grocery, error)
+	GetByGroupID(groupID int64) ([]model.Grocery, error)
+	Create(grocery *model.Grocery) (int64, error)
+	AddFromDishes(ctx context.Context, groceries []model.Grocery, dishIDs []int64) error
+	Update(grocery *model.Grocery) error
+	SetBought(ctx context.Context, grocery *model.Grocery, previousQuantityBought float64) error
+	Delete(id int64) error
+	DeleteBought(groupID int64) (int64, error)
 }
 
 type GroceryRepository struct {
@@ -20,6 +33,8 @@ func NewGroceryRepository(db *sql.DB) GroceryRepositoryInterface {
 	}
 }
 
+/*** READ OPERATIONS ***/
+
 func (r *GroceryRepository) HasItem(itemID int64) (bool, error) {
 	var exists bool
 	query := `
@@ -34,3 +49,312 @@ func (r *GroceryRepository) HasItem(itemID int64) (bool, error) {
 
 	return exists, nil
 }
+
+// GetByID retrieves a grocery line from the database by its ID, with its item, the item category and its unit.
+func (r *GroceryRepository) GetByID(id int64) (*model.Grocery, error) {
+	groceries, err := r.fetchGroceries("WHERE groceries.id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groceries) == 0 {
+		return nil, customErrors.NewNotFoundError("groceries", "id", nil)
+	}
+
+	return &groceries[0], nil
+}
+
+// GetByGroupID retrieves the grocery list of a group, sorted as the store layout:
+// by the position of the item categories, then by item name.
+func (r *GroceryRepository) GetByGroupID(groupID int64) ([]model.Grocery, error) {
+	return r.fetchGroceries(
+		"WHERE groceries.group_id = ? ORDER BY item_categories.position, items.name COLLATE NOCASE, groceries.id",
+		groupID,
+	)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create inserts a new grocery line and returns its ID.
+func (r *GroceryRepository) Create(grocery *model.Grocery) (int64, error) {
+	res, err := r.db.Exec(
+		"INSERT INTO groceries (quantity_bought, user_quantity, item_id, unit_id, group_id) VALUES (?, ?, ?, ?, ?)",
+		grocery.QuantityBought,
+		grocery.UserQuantity,
+		grocery.Item.ID,
+		grocery.Unit.ID,
+		grocery.GroupID,
+	)
+	if err != nil {
+		return 0, groceryWriteError(err, "failed to create grocery")
+	}
+
+	grocery.ID, err = res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve grocery ID", err)
+	}
+
+	return grocery.ID, nil
+}
+
+// AddFromDishes merges the groceries needed by dishes into the grocery list and flags these dishes as bought, in one transaction.
+// Returns a ConflictError, without writing anything, if a dish is already bought, e.g. by a concurrent call.
+// The quantity of a grocery with an ID is added to the wanted quantity of this existing line; the other groceries are inserted.
+func (r *GroceryRepository) AddFromDishes(ctx context.Context, groceries []model.Grocery, dishIDs []int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	for _, dishID := range dishIDs {
+		res, err := tx.ExecContext(ctx, "UPDATE dishes SET bought = 1 WHERE id = ? AND bought = 0", dishID)
+		if err != nil {
+			return customErrors.NewInternalError("failed to flag dish as bought", err)
+		}
+
+		updatedRows, err := res.RowsAffected()
+		if err != nil {
+			return customErrors.NewInternalError("failed to check if dish was flagged as bought", err)
+		}
+		if updatedRows == 0 {
+			return customErrors.NewConflictError("Dish", "dish is already bought", nil)
+		}
+	}
+
+	for _, grocery := range groceries {
+		if grocery.ID == 0 {
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO groceries (quantity_bought, user_quantity, item_id, unit_id, group_id) VALUES (0, ?, ?, ?, ?)",
+				grocery.UserQuantity,
+				grocery.Item.ID,
+				grocery.Unit.ID,
+				grocery.GroupID,
+			)
+			if err != nil {
+				return groceryWriteError(err, "failed to create grocery")
+			}
+			continue
+		}
+
+		res, err := tx.ExecContext(ctx,
+			"UPDATE groceries SET user_quantity = user_quantity + ? WHERE id = ?",
+			grocery.UserQuantity,
+			grocery.ID,
+		)
+		if err != nil {
+			return customErrors.NewInternalError("failed to update grocery", err)
+		}
+
+		updatedRows, err := res.RowsAffected()
+		if err != nil {
+			return customErrors.NewInternalError("failed to check if grocery was updated", err)
+		}
+		if updatedRows == 0 {
+			return customErrors.NewNotFoundError("groceries", "id", nil)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update replaces the quantities, item and unit of the grocery line identified by grocery.ID.
+func (r *GroceryRepository) Update(grocery *model.Grocery) error {
+	res, err := r.db.Exec(
+		"UPDATE groceries SET quantity_bought = ?, user_quantity = ?, item_id = ?, unit_id = ? WHERE id = ?",
+		grocery.QuantityBought,
+		grocery.UserQuantity,
+		grocery.Item.ID,
+		grocery.Unit.ID,
+		grocery.ID,
+	)
+	if err != nil {
+		return groceryWriteError(err, "failed to update grocery")
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if grocery was updated", err)
+	}
+	if updatedRows == 0 {
+		return customErrors.NewNotFoundError("groceries", "id", nil)
+	}
+
+	return nil
+}
+
+// SetBought updates the quantity bought of the grocery line identified by grocery.ID from previousQuantityBought,
+// and adds the difference to the pantry line of its item and unit, in one transaction.
+// A lowered quantity bought is taken back from the pantry, whose quantities never fall below zero.
+// Returns a ConflictError, without writing anything, if the quantity bought is no longer previousQuantityBought,
+// e.g. once updated by a concurrent call.
+func (r *GroceryRepository) SetBought(ctx context.Context, grocery *model.Grocery, previousQuantityBought float64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE groceries SET quantity_bought = ? WHERE id = ? AND quantity_bought = ?",
+		grocery.QuantityBought,
+		grocery.ID,
+		previousQuantityBought,
+	)
+	if err != nil {
+		return customErrors.NewInternalError("failed to update grocery", err)
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if grocery was updated", err)
+	}
+	if updatedRows == 0 {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM groceries WHERE id = ?)", grocery.ID).Scan(&exists); err != nil {
+			return customErrors.NewInternalError("failed to check if grocery exists", err)
+		}
+		if exists {
+			return customErrors.NewConflictError("Grocery", "quantity bought was changed in the meantime", nil)
+		}
+		return customErrors.NewNotFoundError("groceries", "id", nil)
+	}
+
+	stocked := grocery.QuantityBought - previousQuantityBought
+
+	if stocked > 0 {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO pantry (quantity, item_id, unit_id, group_id) VALUES (?1, ?2, ?3, ?4)
+			ON CONFLICT (item_id, unit_id, group_id) DO UPDATE SET quantity = pantry.quantity + ?1`,
+			stocked,
+			grocery.Item.ID,
+			grocery.Unit.ID,
+			grocery.GroupID,
+		)
+	} else if stocked < 0 {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE pantry SET quantity = max(quantity + ?, 0) WHERE item_id = ? AND unit_id = ? AND group_id = ?",
+			stocked,
+			grocery.Item.ID,
+			grocery.Unit.ID,
+			grocery.GroupID,
+		)
+	}
+	if err != nil {
+		return pantryWriteError(err, "failed to stock grocery in the pantry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the grocery line identified by id.
+func (r *GroceryRepository) Delete(id int64) error {
+	res, err := r.db.Exec("DELETE FROM groceries WHERE id = ?", id)
+	if err != nil {
+		return customErrors.NewInternalError("failed to delete grocery", err)
+	}
+
+	deletedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if grocery was deleted", err)
+	}
+	if deletedRows == 0 {
+		return customErrors.NewNotFoundError("groceries", "id", nil)
+	}
+
+	return nil
+}
+
+// DeleteBought removes the grocery lines of a group whose whole quantity has been bought, and returns how many were removed.
+func (r *GroceryRepository) DeleteBought(groupID int64) (int64, error) {
+	res, err := r.db.Exec("DELETE FROM groceries WHERE group_id = ? AND quantity_bought >= user_quantity", groupID)
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to delete bought groceries", err)
+	}
+
+	deletedRows, err := res.RowsAffected()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to check which groceries were deleted", err)
+	}
+
+	return deletedRows, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// fetchGroceries is a helper method to retrieve multiple grocery lines based on filtering options.
+func (r *GroceryRepository) fetchGroceries(clauses string, values ...any) ([]model.Grocery, error) {
+	query := `SELECT
+	groceries.id, groceries.quantity_bought, groceries.user_quantity, groceries.group_id,
+	items.id, items.name, items.average_market_price, items.unit_type, items.group_id,
+	item_categories.id, item_categories.name, item_categories.position,
+	units.id, units.name, units.factor, units.unit_type, units.group_id
+	FROM groceries
+	JOIN items ON items.id = groceries.item_id
+	JOIN item_categories ON item_categories.id = items.item_category_id
+	JOIN units ON units.id = groceries.unit_id ` + clauses
+
+	slog.Debug("fetching groceries", "query", query)
+
+	rows, err := r.db.Query(query, values...)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch groceries", err)
+	}
+	defer rows.Close()
+
+	groceries := []model.Grocery{}
+
+	for rows.Next() {
+		var grocery model.Grocery
+		err := rows.Scan(
+			&grocery.ID,
+			&grocery.QuantityBought,
+			&grocery.UserQuantity,
+			&grocery.GroupID,
+			&grocery.Item.ID,
+			&grocery.Item.Name,
+			&grocery.Item.AverageMarketPrice,
+			&grocery.Item.UnitType,
+			&grocery.Item.GroupID,
+			&grocery.Item.ItemCategory.ID,
+			&grocery.Item.ItemCategory.Name,
+			&grocery.Item.ItemCategory.Position,
+			&grocery.Unit.ID,
+			&grocery.Unit.Name,
+			&grocery.Unit.Factor,
+			&grocery.Unit.UnitType,
+			&grocery.Unit.GroupID,
+		)
+
+		if err != nil {
+			return nil, customErrors.NewInternalError("failed to fetch groceries", err)
+		}
+
+		grocery.Item.ItemCategory.GroupID = grocery.Item.GroupID
+		groceries = append(groceries, grocery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("failed to iterate rows", err)
+	}
+
+	return groceries, nil
+}
+
+// groceryWriteError maps the constraint violations of an insert or update of a grocery line to application errors.
+func groceryWriteError(err error, msg string) error {
+	if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok && sqlerr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+		return customErrors.NewNotFoundError("items, units or groups", "id", sqlerr)
+	}
+	return customErrors.NewInternalError(msg, err)
+}
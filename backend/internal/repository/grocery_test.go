@@ -1,8 +1,13 @@
 package repository
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
 	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
 )
 
@@ -57,3 +62,348 @@ func TestGroceryRepositoryHasItem(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGroceryByID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroceryRepository(db)
+
+	actual, err := repo.GetByID(6)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	if actual.QuantityBought != 2 || actual.UserQuantity != 4 || actual.GroupID != 2 {
+		t.Errorf("unexpected quantities or group %+v", *actual)
+	}
+	if actual.Item.Name != "Tomatoes" || actual.Item.ItemCategory.Name != "VEGETABLES" || actual.Item.ItemCategory.Position != 1 {
+		t.Errorf("unexpected item %+v", actual.Item)
+	}
+	if actual.Unit.Name != "Piece" || actual.Unit.UnitType != enum.Piece {
+		t.Errorf("unexpected unit %+v", actual.Unit)
+	}
+
+	_, err = repo.GetByID(-1)
+	if !utils.CompareErrors(err, customErrors.NewNotFoundError("groceries", "id", nil)) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestGetGroceriesByGroupID(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupID     int64
+		expectedIDs []int64
+	}{
+		// Flour (GRAINS AND PASTA), Sugar (BAKED GOODS), Pepper (SPICES AND CONDIMENTS), Eggs and Milk (DAIRY)
+		{"sorted by item category position then item name", 1, []int64{1, 2, 7, 3, 4}},
+		{"other group", 2, []int64{5, 6, 8}},
+		{"group without groceries", 3, []int64{}},
+	}
+
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewGroceryRepository(db)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByGroupID(tt.groupID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actualIDs := make([]int64, len(actual))
+			for i, grocery := range actual {
+				actualIDs[i] = grocery.ID
+			}
+
+			if !reflect.DeepEqual(actualIDs, tt.expectedIDs) {
+				t.Errorf("expected groceries %v, got %v", tt.expectedIDs, actualIDs)
+			}
+		})
+	}
+}
+
+func TestGroceryRepositoryCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		grocery model.Grocery
+		err     error
+	}{
+		{"valid grocery", model.Grocery{UserQuantity: 500, Item: model.Item{ID: 3}, Unit: model.Unit{ID: 2}, GroupID: 1}, nil},
+		{"custom unit", model.Grocery{UserQuantity: 2, Item: model.Item{ID: 6}, Unit: model.Unit{ID: 22}, GroupID: 1}, nil},
+		{"unknown item", model.Grocery{UserQuantity: 1, Item: model.Item{ID: -1}, Unit: model.Unit{ID: 2}, GroupID: 1}, customErrors.NewNotFoundError("items, units or groups", "id", nil)},
+		{"unknown unit", model.Grocery{UserQuantity: 1, Item: model.Item{ID: 3}, Unit: model.Unit{ID: -1}, GroupID: 1}, customErrors.NewNotFoundError("items, units or groups", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewGroceryRepository(db)
+
+			id, err := repo.Create(&tt.grocery)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(id)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if actual.UserQuantity != tt.grocery.UserQuantity || actual.QuantityBought != 0 ||
+				actual.Item.ID != tt.grocery.Item.ID || actual.Unit.ID != tt.grocery.Unit.ID || actual.GroupID != tt.grocery.GroupID {
+				t.Errorf("expected %+v, got %+v", tt.grocery, *actual)
+			}
+		})
+	}
+}
+
+func TestGroceryRepositoryAddFromDishes(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewGroceryRepository(db)
+	dishRepo := NewDishRepository(db)
+
+	groceries := []model.Grocery{
+		// 0.5 kg of flour added to the existing line of 2 kg
+		{ID: 1, UserQuantity: 0.5, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 1}, GroupID: 1},
+		// 3 cloves of garlic on a new line
+		{UserQuantity: 3, Item: model.Item{ID: 10}, Unit: model.Unit{ID: 8}, GroupID: 1},
+	}
+
+	if err := repo.AddFromDishes(context.Background(), groceries, []int64{1, 2}); err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	flour, err := repo.GetByID(1)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if flour.UserQuantity != 2.5 || flour.QuantityBought != 0 {
+		t.Errorf("expected 2.5 kg of flour to buy, got %+v", *flour)
+	}
+
+	actual, err := repo.GetByGroupID(1)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if len(actual) != 6 {
+		t.Fatalf("expected 6 grocery lines, got %d", len(actual))
+	}
+
+	for _, dishID := range []int64{1, 2} {
+		dish, err := dishRepo.GetByID(dishID)
+		if err != nil {
+			t.Fatalf("didn't expected error, got %v", err)
+		}
+		if !dish.Bought {
+			t.Errorf("expected dish %d to be flagged as bought", dishID)
+		}
+	}
+
+	// Nothing is written when a grocery line can't be merged.
+	err = repo.AddFromDishes(context.Background(), []model.Grocery{
+		{UserQuantity: 1, Item: model.Item{ID: 3}, Unit: model.Unit{ID: 2}, GroupID: 1},
+		{ID: -1, UserQuantity: 1},
+	}, nil)
+	if !utils.CompareErrors(err, customErrors.NewNotFoundError("groceries", "id", nil)) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	actual, err = repo.GetByGroupID(1)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if len(actual) != 6 {
+		t.Errorf("expected the transaction to be rolled back, got %d grocery lines", len(actual))
+	}
+
+	// Nothing is written when a dish was already bought, e.g. by a concurrent call.
+	err = repo.AddFromDishes(context.Background(), groceries, []int64{1})
+	if !utils.CompareErrors(err, customErrors.NewConflictError("Dish", "dish is already bought", nil)) {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+
+	flour, err = repo.GetByID(1)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if flour.UserQuantity != 2.5 {
+		t.Errorf("expected the groceries of a bought dish not to be added twice, got %+v", *flour)
+	}
+}
+
+func TestGroceryRepositoryUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		grocery model.Grocery
+		err     error
+	}{
+		{"bought quantity", model.Grocery{ID: 1, QuantityBought: 2, UserQuantity: 2, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 1}}, nil},
+		{"item, unit and quantity", model.Grocery{ID: 1, UserQuantity: 750, Item: model.Item{ID: 2}, Unit: model.Unit{ID: 2}}, nil},
+		{"unknown unit", model.Grocery{ID: 1, UserQuantity: 2, Item: model.Item{ID: 1}, Unit: model.Unit{ID: -1}}, customErrors.NewNotFoundError("items, units or groups", "id", nil)},
+		{"unknown grocery", model.Grocery{ID: -1, UserQuantity: 2, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 1}}, customErrors.NewNotFoundError("groceries", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewGroceryRepository(db)
+
+			err := repo.Update(&tt.grocery)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(tt.grocery.ID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if actual.UserQuantity != tt.grocery.UserQuantity || actual.QuantityBought != tt.grocery.QuantityBought ||
+				actual.Item.ID != tt.grocery.Item.ID || actual.Unit.ID != tt.grocery.Unit.ID || actual.GroupID != 1 {
+				t.Errorf("expected %+v, got %+v", tt.grocery, *actual)
+			}
+		})
+	}
+}
+
+func TestGroceryRepositorySetBought(t *testing.T) {
+	tests := []struct {
+		name                   string
+		grocery                model.Grocery
+		previousQuantityBought float64
+		expectedPantryQuantity float64
+		err                    error
+	}{
+		// 2 kg of flour checked off, stocked on a new line next to the 500 g already in the pantry
+		{"new pantry line", model.Grocery{ID: 1, QuantityBought: 2, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 1}, GroupID: 1}, 0, 2, nil},
+		// 2 more tomatoes bought, added to the 3 already in the pantry
+		{"existing pantry line", model.Grocery{ID: 6, QuantityBought: 4, Item: model.Item{ID: 8}, Unit: model.Unit{ID: 8}, GroupID: 2}, 2, 5, nil},
+		// 12 eggs unchecked, only 6 of them being left in the pantry
+		{"unchecked grocery", model.Grocery{ID: 3, QuantityBought: 0, Item: model.Item{ID: 4}, Unit: model.Unit{ID: 8}, GroupID: 1}, 12, 0, nil},
+		// 2 tomatoes were bought, not 3: the quantity read is outdated
+		{"changed in the meantime", model.Grocery{ID: 6, QuantityBought: 4, Item: model.Item{ID: 8}, Unit: model.Unit{ID: 8}, GroupID: 2}, 3, 3, customErrors.NewConflictError("Grocery", "quantity bought was changed in the meantime", nil)},
+		{"unknown grocery", model.Grocery{ID: -1, QuantityBought: 1, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 1}, GroupID: 1}, 0, 0, customErrors.NewNotFoundError("groceries", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewGroceryRepository(db)
+
+			err := repo.SetBought(context.Background(), &tt.grocery, tt.previousQuantityBought)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(tt.grocery.ID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+			if actual.QuantityBought != tt.grocery.QuantityBought {
+				t.Errorf("expected %v bought, got %v", tt.grocery.QuantityBought, actual.QuantityBought)
+			}
+
+			var quantity float64
+			err = db.QueryRow("SELECT quantity FROM pantry WHERE item_id = ? AND unit_id = ? AND group_id = ?",
+				tt.grocery.Item.ID, tt.grocery.Unit.ID, tt.grocery.GroupID).Scan(&quantity)
+			if err != nil {
+				t.Fatalf("failed to fetch pantry quantity: %v", err)
+			}
+			if quantity != tt.expectedPantryQuantity {
+				t.Errorf("expected %v in the pantry, got %v", tt.expectedPantryQuantity, quantity)
+			}
+		})
+	}
+}
+
+func TestGroceryRepositoryDelete(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewGroceryRepository(db)
+
+	if err := repo.Delete(1); err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	if _, err := repo.GetByID(1); !utils.CompareErrors(err, customErrors.NewNotFoundError("groceries", "id", nil)) {
+		t.Errorf("expected grocery to be deleted, got %v", err)
+	}
+
+	if err := repo.Delete(1); !utils.CompareErrors(err, customErrors.NewNotFoundError("groceries", "id", nil)) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestGroceryRepositoryDeleteBought(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupID     int64
+		expectedNb  int64
+		expectedIDs []int64
+	}{
+		// Only the eggs are fully bought, the sugar is only half bought.
+		{"group with a bought grocery", 1, 1, []int64{1, 2, 7, 4}},
+		{"group without bought grocery", 2, 0, []int64{5, 6, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewGroceryRepository(db)
+
+			deletedNb, err := repo.DeleteBought(tt.groupID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+			if deletedNb != tt.expectedNb {
+				t.Errorf("expected %d deleted groceries, got %d", tt.expectedNb, deletedNb)
+			}
+
+			groceries, err := repo.GetByGroupID(tt.groupID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actualIDs := make([]int64, len(groceries))
+			for i, grocery := range groceries {
+				actualIDs[i] = grocery.ID
+			}
+
+			if !reflect.DeepEqual(actualIDs, tt.expectedIDs) {
+				t.Errorf("expected remaining groceries %v, got %v", tt.expectedIDs, actualIDs)
+			}
+		})
+	}
+}
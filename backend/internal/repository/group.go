@@ -1,15 +1,29 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
+	"strconv"
 
+	"github.com/mattn/go-sqlite3"
+	"github.com/zouipo/yumsday/backend/internal/constant"
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
 )
 
 type GroupRepositoryInterface interface {
 	GetByID(id int64) (*model.Group, error)
+	GetByUserID(userID int64) ([]model.Group, error)
+	GetMember(groupID, userID int64) (*model.GroupMember, error)
+	Create(ctx context.Context, group *model.Group, adminID int64) (int64, error)
+	Update(group *model.Group) error
+	Delete(ctx context.Context, id int64) error
+	AddMember(member *model.GroupMember) error
+	UpdateMemberAdmin(groupID, userID int64, admin bool) error
+	RemoveMember(groupID, userID int64) error
 }
 
 type GroupRepository struct {
@@ -37,6 +51,252 @@ func (r *GroupRepository) GetByID(id int64) (*model.Group, error) {
 	return &groups[0], nil
 }
 
+// GetByUserID retrieves all the groups the user is a member of, including their members, ordered by name.
+func (r *GroupRepository) GetByUserID(userID int64) ([]model.Group, error) {
+	return r.fetchGroups(
+		"WHERE groups.id IN (SELECT group_id FROM group_members WHERE user_id = ?) ORDER BY groups.name, groups.id",
+		userID,
+	)
+}
+
+// GetMember retrieves the membership of a user in a group.
+func (r *GroupRepository) GetMember(groupID, userID int64) (*model.GroupMember, error) {
+	member := &model.GroupMember{}
+
+	err := r.db.QueryRow(
+		"SELECT user_id, group_id, admin, joined_at FROM group_members WHERE group_id = ? AND user_id = ?",
+		groupID,
+		userID,
+	).Scan(&member.UserID, &member.GroupId, &member.Admin, &member.JoinedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.NewNotFoundError("group_members", "group_id, user_id", err)
+		}
+		return nil, customErrors.NewInternalError("failed to fetch group member", err)
+	}
+
+	return member, nil
+}
+
+// Create inserts a new group and makes the given user its admin, in a single transaction.
+// Returns the inserted group ID.
+func (r *GroupRepository) Create(ctx context.Context, group *model.Group, adminID int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO groups (name, image_url, created_at) VALUES (?, ?, ?)",
+		group.Name,
+		group.ImageURL,
+		group.CreatedAt,
+	)
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to create group", err)
+	}
+
+	group.ID, err = res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve group ID", err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO group_members (user_id, group_id, admin, joined_at) VALUES (?, ?, ?, ?)",
+		adminID,
+		group.ID,
+		true,
+		group.CreatedAt,
+	)
+	if err != nil {
+		if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok && sqlerr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+			return 0, customErrors.NewNotFoundError("users", strconv.FormatInt(adminID, 10), err)
+		}
+		return 0, customErrors.NewInternalError("failed to add group admin", err)
+	}
+
+	// Every group owns an "Uncategorized" item category, receiving its items without category.
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO item_categories (name, group_id, position) VALUES (?, ?, 0)",
+		constant.UNCATEGORIZED_ITEM_CATEGORY,
+		group.ID,
+	)
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to create the uncategorized item category", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, customErrors.NewInternalError("failed to commit group creation", err)
+	}
+
+	return group.ID, nil
+}
+
+// Update modifies the name and the image of an existing group.
+func (r *GroupRepository) Update(group *model.Group) error {
+	result, err := r.db.Exec(
+		"UPDATE groups SET name = ?, image_url = ? WHERE id = ?",
+		group.Name,
+		group.ImageURL,
+		group.ID,
+	)
+	if err != nil {
+		return customErrors.NewInternalError("failed to update group", err)
+	}
+
+	updatedRow, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to retrieve updated group", err)
+	}
+
+	if updatedRow == 0 {
+		return customErrors.NewNotFoundError("groups", "id", nil)
+	}
+
+	return nil
+}
+
+// Delete removes a group and all its dependent data (recipes, items, dishes, groceries, members...)
+// in a single transaction.
+func (r *GroupRepository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	// Order matters: rows referencing other rows of the group have to be deleted first.
+	queries := []string{
+		`DELETE FROM recipes_categories_junction
+		WHERE recipe_id IN (SELECT id FROM recipes WHERE group_id = ?1)
+		OR category_id IN (SELECT id FROM recipe_categories WHERE group_id = ?1)`,
+		`DELETE FROM recipes_dishes_junction
+		WHERE recipe_id IN (SELECT id FROM recipes WHERE group_id = ?1)
+		OR dish_id IN (SELECT id FROM dishes WHERE group_id = ?1)`,
+		`DELETE FROM ingredients
+		WHERE recipe_id IN (SELECT id FROM recipes WHERE group_id = ?1)
+		OR item_id IN (SELECT id FROM items WHERE group_id = ?1)
+		OR unit_id IN (SELECT id FROM units WHERE group_id = ?1)`,
+		`DELETE FROM groceries
+		WHERE group_id = ?1
+		OR item_id IN (SELECT id FROM items WHERE group_id = ?1)
+		OR unit_id IN (SELECT id FROM units WHERE group_id = ?1)`,
+		`DELETE FROM pantry
+		WHERE group_id = ?1
+		OR item_id IN (SELECT id FROM items WHERE group_id = ?1)
+		OR unit_id IN (SELECT id FROM units WHERE group_id = ?1)`,
+		"DELETE FROM dishes WHERE group_id = ?1",
+		"DELETE FROM recipes WHERE group_id = ?1",
+		"DELETE FROM recipe_categories WHERE group_id = ?1",
+		"DELETE FROM items WHERE group_id = ?1",
+		"DELETE FROM item_categories WHERE group_id = ?1",
+		"DELETE FROM units WHERE group_id = ?1",
+		"DELETE FROM group_members WHERE group_id = ?1",
+		"DELETE FROM group_invitations WHERE group_id = ?1",
+		"UPDATE users SET last_visited_group_id = NULL WHERE last_visited_group_id = ?1",
+	}
+
+	for _, query := range queries {
+		slog.Debug("deleting group dependencies", "query", query)
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			return customErrors.NewInternalError(fmt.Sprintf("failed to delete dependencies of group %d", id), err)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM groups WHERE id = ?", id)
+	if err != nil {
+		return customErrors.NewInternalError("failed to delete group", err)
+	}
+
+	deletedRow, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to retrieve deleted group", err)
+	}
+
+	if deletedRow == 0 {
+		return customErrors.NewNotFoundError("groups", "id", nil)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit group deletion", err)
+	}
+
+	return nil
+}
+
+// AddMember adds a user to a group.
+// Returns a ConflictError if the user is already a member of the group.
+func (r *GroupRepository) AddMember(member *model.GroupMember) error {
+	_, err := r.db.Exec(
+		"INSERT INTO group_members (user_id, group_id, admin, joined_at) VALUES (?, ?, ?, ?)",
+		member.UserID,
+		member.GroupId,
+		member.Admin,
+		member.JoinedAt,
+	)
+	if err != nil {
+		if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok {
+			switch sqlerr.ExtendedCode {
+			case sqlite3.ErrConstraintPrimaryKey, sqlite3.ErrConstraintUnique:
+				return customErrors.NewConflictError("GroupMember", "user is already a member of the group", sqlerr)
+			case sqlite3.ErrConstraintForeignKey:
+				return customErrors.NewNotFoundError("users", strconv.FormatInt(member.UserID, 10), sqlerr)
+			}
+		}
+		return customErrors.NewInternalError("failed to add group member", err)
+	}
+
+	return nil
+}
+
+// UpdateMemberAdmin sets or clears the group admin flag of a member.
+func (r *GroupRepository) UpdateMemberAdmin(groupID, userID int64, admin bool) error {
+	result, err := r.db.Exec(
+		"UPDATE group_members SET admin = ? WHERE group_id = ? AND user_id = ?",
+		admin,
+		groupID,
+		userID,
+	)
+	if err != nil {
+		return customErrors.NewInternalError("failed to update group member", err)
+	}
+
+	updatedRow, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to retrieve updated group member", err)
+	}
+
+	if updatedRow == 0 {
+		return customErrors.NewNotFoundError("group_members", "group_id, user_id", nil)
+	}
+
+	return nil
+}
+
+// RemoveMember removes a user from a group.
+func (r *GroupRepository) RemoveMember(groupID, userID int64) error {
+	result, err := r.db.Exec("DELETE FROM group_members WHERE group_id = ? AND user_id = ?", groupID, userID)
+	if err != nil {
+		return customErrors.NewInternalError("failed to remove group member", err)
+	}
+
+	deletedRow, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to retrieve removed group member", err)
+	}
+
+	if deletedRow == 0 {
+		return customErrors.NewNotFoundError("group_members", "group_id, user_id", nil)
+	}
+
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
 func (r *GroupRepository) fetchGroups(clauses string, values ...any) ([]model.Group, error) {
 	query := `SELECT
 	groups.id, groups.name, groups.image_url, groups.created_at,
@@ -61,16 +321,21 @@ func (r *GroupRepository) fetchGroups(clauses string, values ...any) ([]model.Gr
 
 	for rows.Next() {
 		tmpGroup := &model.Group{}
-		tmpUser := &model.GroupMember{}
+		// Member columns are NULL for groups without any member because of the LEFT JOIN.
+		var (
+			userID   sql.NullInt64
+			admin    sql.NullBool
+			joinedAt sql.NullTime
+		)
 
 		err := rows.Scan(
 			&tmpGroup.ID,
 			&tmpGroup.Name,
 			&tmpGroup.ImageURL,
 			&tmpGroup.CreatedAt,
-			&tmpUser.UserID,
-			&tmpUser.Admin,
-			&tmpUser.JoinedAt,
+			&userID,
+			&admin,
+			&joinedAt,
 		)
 
 		if err != nil {
@@ -79,6 +344,7 @@ func (r *GroupRepository) fetchGroups(clauses string, values ...any) ([]model.Gr
 
 		id := tmpGroup.ID
 		if _, exists := stateMap[id]; !exists {
+			tmpGroup.Members = []model.GroupMember{}
 			ret = append(ret, *tmpGroup)
 			stateMap[id] = state{
 				retIndex:  int64(len(ret) - 1),
@@ -86,11 +352,20 @@ func (r *GroupRepository) fetchGroups(clauses string, values ...any) ([]model.Gr
 			}
 		}
 
+		if !userID.Valid {
+			continue
+		}
+
 		i := stateMap[id].retIndex
 
-		if !stateMap[id].seenUsers[tmpUser.UserID] {
-			ret[i].Members = append(ret[i].Members, *tmpUser)
-			stateMap[id].seenUsers[tmpUser.UserID] = true
+		if !stateMap[id].seenUsers[userID.Int64] {
+			ret[i].Members = append(ret[i].Members, model.GroupMember{
+				UserID:   userID.Int64,
+				GroupId:  id,
+				Admin:    admin.Bool,
+				JoinedAt: joinedAt.Time,
+			})
+			stateMap[id].seenUsers[userID.Int64] = true
 		}
 	}
 
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/mattn/go-sqlite3"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type GroupInvitationRepositoryInterface interface {
+	GetByID(id int64) (*model.GroupInvitation, error)
+	GetByToken(token string) (*model.GroupInvitation, error)
+	GetByGroupID(groupID int64) ([]model.GroupInvitation, error)
+	Create(invitation *model.GroupInvitation) (int64, error)
+	Revoke(id int64) error
+	Use(ctx context.Context, id int64, member *model.GroupMember) error
+}
+
+type GroupInvitationRepository struct {
+	db *sql.DB
+}
+
+// NewGroupInvitationRepository constructs a new GroupInvitationRepository using the provided database.
+func NewGroupInvitationRepository(db *sql.DB) *GroupInvitationRepository {
+	return &GroupInvitationRepository{
+		db: db,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID retrieves an invitation by its ID.
+func (r *GroupInvitationRepository) GetByID(id int64) (*model.GroupInvitation, error) {
+	invitations, err := r.fetchInvitations("WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invitations) == 0 {
+		return nil, customErrors.NewNotFoundError("group_invitations", "id", nil)
+	}
+
+	return &invitations[0], nil
+}
+
+// GetByToken retrieves an invitation by its token.
+func (r *GroupInvitationRepository) GetByToken(token string) (*model.GroupInvitation, error) {
+	invitations, err := r.fetchInvitations("WHERE token = ?", token)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invitations) == 0 {
+		return nil, customErrors.NewNotFoundError("group_invitations", "token", nil)
+	}
+
+	return &invitations[0], nil
+}
+
+// GetByGroupID retrieves all the invitations of a group, the most recent first.
+func (r *GroupInvitationRepository) GetByGroupID(groupID int64) ([]model.GroupInvitation, error) {
+	return r.fetchInvitations("WHERE group_id = ? ORDER BY created_at DESC, id DESC", groupID)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create inserts a new invitation and returns its ID.
+func (r *GroupInvitationRepository) Create(invitation *model.GroupInvitation) (int64, error) {
+	res, err := r.db.Exec(
+		`INSERT INTO group_invitations (token, group_id, created_by, created_at, expires_at, max_uses, uses, revoked)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		invitation.Token,
+		invitation.GroupID,
+		invitation.CreatedBy,
+		invitation.CreatedAt,
+		invitation.ExpiresAt,
+		invitation.MaxUses,
+		invitation.Uses,
+		invitation.Revoked,
+	)
+	if err != nil {
+		if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok && sqlerr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+			return 0, customErrors.NewNotFoundError("groups", "id", sqlerr)
+		}
+		return 0, customErrors.NewInternalError("failed to create group invitation", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve group invitation ID", err)
+	}
+
+	return id, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Revoke marks an invitation as revoked so that it can no longer be used.
+func (r *GroupInvitationRepository) Revoke(id int64) error {
+	result, err := r.db.Exec("UPDATE group_invitations SET revoked = TRUE WHERE id = ?", id)
+	if err != nil {
+		return customErrors.NewInternalError("failed to revoke group invitation", err)
+	}
+
+	updatedRow, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to retrieve revoked group invitation", err)
+	}
+
+	if updatedRow == 0 {
+		return customErrors.NewNotFoundError("group_invitations", "id", nil)
+	}
+
+	return nil
+}
+
+// Use consumes one use of the invitation and adds the member to its group, in a single transaction.
+// Returns a ConflictError if the invitation has been revoked or has no use left,
+// or if the user is already a member of the group.
+func (r *GroupInvitationRepository) Use(ctx context.Context, id int64, member *model.GroupMember) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	// The conditions are checked in the UPDATE itself so that concurrent acceptances can't exceed max_uses.
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE group_invitations SET uses = uses + 1
+		WHERE id = ? AND revoked = FALSE AND (max_uses IS NULL OR uses < max_uses)`,
+		id,
+	)
+	if err != nil {
+		return customErrors.NewInternalError("failed to use group invitation", err)
+	}
+
+	updatedRow, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to retrieve used group invitation", err)
+	}
+
+	if updatedRow == 0 {
+		return customErrors.NewConflictError("GroupInvitation", "invitation is no longer valid", nil)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO group_members (user_id, group_id, admin, joined_at) VALUES (?, ?, ?, ?)",
+		member.UserID,
+		member.GroupId,
+		member.Admin,
+		member.JoinedAt,
+	)
+	if err != nil {
+		if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok &&
+			(sqlerr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey || sqlerr.ExtendedCode == sqlite3.ErrConstraintUnique) {
+			return customErrors.NewConflictError("GroupMember", "user is already a member of the group", sqlerr)
+		}
+		return customErrors.NewInternalError("failed to add group member", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit group invitation use", err)
+	}
+
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
+func (r *GroupInvitationRepository) fetchInvitations(clauses string, values ...any) ([]model.GroupInvitation, error) {
+	query := `SELECT id, token, group_id, created_by, created_at, expires_at, max_uses, uses, revoked
+	FROM group_invitations ` + clauses
+
+	slog.Debug("fetching group invitations", "query", query)
+
+	rows, err := r.db.Query(query, values...)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch group invitations", err)
+	}
+	defer rows.Close()
+
+	invitations := []model.GroupInvitation{}
+
+	for rows.Next() {
+		var invitation model.GroupInvitation
+		err := rows.Scan(
+			&invitation.ID,
+			&invitation.Token,
+			&invitation.GroupID,
+			&invitation.CreatedBy,
+			&invitation.CreatedAt,
+			&invitation.ExpiresAt,
+			&invitation.MaxUses,
+			&invitation.Uses,
+			&invitation.Revoked,
+		)
+		if err != nil {
+			return nil, customErrors.NewInternalError("failed to fetch group invitations", err)
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch group invitations", err)
+	}
+
+	return invitations, nil
+}
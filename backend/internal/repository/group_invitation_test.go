@@ -0,0 +1,351 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+var invalidGroupInvitationID = int64(-1)
+
+func TestNewGroupInvitationRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupInvitationRepository(db)
+
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+
+	if repo.db != db {
+		t.Error("expected repository db to match the provided db")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetGroupInvitationByID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupInvitationRepository(db)
+
+	tests := []struct {
+		name          string
+		id            int64
+		expectedToken string
+		expectErr     error
+	}{
+		{name: "Existing invitation", id: 2, expectedToken: "familysingleuse"},
+		{
+			name:      "Unknown invitation",
+			id:        invalidGroupInvitationID,
+			expectErr: customErrors.NewNotFoundError("group_invitations", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitation, err := repo.GetByID(tt.id)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if invitation.Token != tt.expectedToken {
+				t.Errorf("expected token %s, got %s", tt.expectedToken, invitation.Token)
+			}
+		})
+	}
+}
+
+func TestGetGroupInvitationByToken(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupInvitationRepository(db)
+
+	tests := []struct {
+		name      string
+		token     string
+		expected  *model.GroupInvitation
+		expectErr error
+	}{
+		{
+			name:  "Unlimited invitation",
+			token: "familyinvitetoken",
+			expected: &model.GroupInvitation{
+				ID: 1, Token: "familyinvitetoken", GroupID: 1, CreatedBy: new(int64(2)),
+				CreatedAt: time.Unix(0, 0), ExpiresAt: time.Now().Add(7 * 24 * time.Hour), MaxUses: nil, Uses: 2,
+			},
+		},
+		{
+			name:  "Revoked invitation",
+			token: "familyrevoked",
+			expected: &model.GroupInvitation{
+				ID: 5, Token: "familyrevoked", GroupID: 1, CreatedBy: new(int64(2)),
+				CreatedAt: time.Unix(0, 0), ExpiresAt: time.Now().Add(7 * 24 * time.Hour), MaxUses: new(int64(5)), Revoked: true,
+			},
+		},
+		{
+			name:      "Unknown token",
+			token:     "unknown",
+			expectErr: customErrors.NewNotFoundError("group_invitations", "token", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitation, err := repo.GetByToken(tt.token)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if invitation.ID != tt.expected.ID || invitation.GroupID != tt.expected.GroupID {
+				t.Errorf("expected invitation %d of group %d, got %d of group %d", tt.expected.ID, tt.expected.GroupID, invitation.ID, invitation.GroupID)
+			}
+			if *invitation.CreatedBy != *tt.expected.CreatedBy {
+				t.Errorf("expected CreatedBy %d, got %d", *tt.expected.CreatedBy, *invitation.CreatedBy)
+			}
+			if !utils.TimesApproximatelyEqual(invitation.ExpiresAt, tt.expected.ExpiresAt, time.Minute) {
+				t.Errorf("expected ExpiresAt around %v, got %v", tt.expected.ExpiresAt, invitation.ExpiresAt)
+			}
+			if (invitation.MaxUses == nil) != (tt.expected.MaxUses == nil) ||
+				(invitation.MaxUses != nil && *invitation.MaxUses != *tt.expected.MaxUses) {
+				t.Errorf("expected MaxUses %v, got %v", tt.expected.MaxUses, invitation.MaxUses)
+			}
+			if invitation.Uses != tt.expected.Uses {
+				t.Errorf("expected Uses %d, got %d", tt.expected.Uses, invitation.Uses)
+			}
+			if invitation.Revoked != tt.expected.Revoked {
+				t.Errorf("expected Revoked %v, got %v", tt.expected.Revoked, invitation.Revoked)
+			}
+		})
+	}
+}
+
+func TestGetGroupInvitationsByGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupInvitationRepository(db)
+
+	tests := []struct {
+		name        string
+		groupID     int64
+		expectedIDs []int64
+	}{
+		{name: "Group with several invitations", groupID: 1, expectedIDs: []int64{5, 4, 3, 2, 1}},
+		{name: "Group with one invitation", groupID: 2, expectedIDs: []int64{6}},
+		{name: "Group without invitation", groupID: 3, expectedIDs: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitations, err := repo.GetByGroupID(tt.groupID)
+			if err != nil {
+				t.Fatalf("GetByGroupID() unexpected error = %v", err)
+			}
+
+			if len(invitations) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d invitations, got %d", len(tt.expectedIDs), len(invitations))
+			}
+
+			for i := range invitations {
+				if invitations[i].ID != tt.expectedIDs[i] {
+					t.Errorf("expected invitation %d, got %d", tt.expectedIDs[i], invitations[i].ID)
+				}
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreateGroupInvitation(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupInvitationRepository(db)
+
+	tests := []struct {
+		name       string
+		invitation *model.GroupInvitation
+		expectErr  error
+	}{
+		{
+			name: "Single use invitation",
+			invitation: &model.GroupInvitation{
+				Token: "newtoken", GroupID: 2, CreatedBy: new(int64(4)),
+				CreatedAt: time.Now().UTC(), ExpiresAt: time.Now().UTC().Add(time.Hour), MaxUses: new(int64(1)),
+			},
+		},
+		{
+			name: "Unknown group",
+			invitation: &model.GroupInvitation{
+				Token: "othertoken", GroupID: invalidGroupRepositoryID,
+				CreatedAt: time.Now().UTC(), ExpiresAt: time.Now().UTC().Add(time.Hour),
+			},
+			expectErr: customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := repo.Create(tt.invitation)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			created, err := repo.GetByToken(tt.invitation.Token)
+			if err != nil {
+				t.Fatalf("GetByToken() unexpected error = %v", err)
+			}
+			if created.ID != id {
+				t.Errorf("expected ID %d, got %d", id, created.ID)
+			}
+			if created.GroupID != tt.invitation.GroupID {
+				t.Errorf("expected GroupID %d, got %d", tt.invitation.GroupID, created.GroupID)
+			}
+			if !utils.TimesApproximatelyEqual(created.ExpiresAt, tt.invitation.ExpiresAt, time.Second) {
+				t.Errorf("expected ExpiresAt %v, got %v", tt.invitation.ExpiresAt, created.ExpiresAt)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestRevokeGroupInvitation(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupInvitationRepository(db)
+
+	tests := []struct {
+		name      string
+		id        int64
+		expectErr error
+	}{
+		{name: "Active invitation", id: 1},
+		{name: "Already revoked invitation", id: 5},
+		{
+			name:      "Unknown invitation",
+			id:        invalidGroupInvitationID,
+			expectErr: customErrors.NewNotFoundError("group_invitations", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.Revoke(tt.id)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			invitation, _ := repo.GetByID(tt.id)
+			if !invitation.Revoked {
+				t.Errorf("expected invitation %d to be revoked", tt.id)
+			}
+		})
+	}
+}
+
+func TestUseGroupInvitation(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           int64
+		member       *model.GroupMember
+		expectedUses int64
+		expectErr    error
+	}{
+		{
+			name:         "Unlimited invitation",
+			id:           1,
+			member:       &model.GroupMember{UserID: 5, GroupId: 1, JoinedAt: time.Now().UTC()},
+			expectedUses: 3,
+		},
+		{
+			name:         "Last use of a single use invitation",
+			id:           2,
+			member:       &model.GroupMember{UserID: 5, GroupId: 1, JoinedAt: time.Now().UTC()},
+			expectedUses: 1,
+		},
+		{
+			name:         "Exhausted invitation",
+			id:           3,
+			member:       &model.GroupMember{UserID: 5, GroupId: 1, JoinedAt: time.Now().UTC()},
+			expectedUses: 1,
+			expectErr:    customErrors.NewConflictError("GroupInvitation", "invitation is no longer valid", nil),
+		},
+		{
+			name:         "Revoked invitation",
+			id:           5,
+			member:       &model.GroupMember{UserID: 5, GroupId: 1, JoinedAt: time.Now().UTC()},
+			expectedUses: 0,
+			expectErr:    customErrors.NewConflictError("GroupInvitation", "invitation is no longer valid", nil),
+		},
+		{
+			name:         "User already member of the group",
+			id:           1,
+			member:       &model.GroupMember{UserID: 2, GroupId: 1, JoinedAt: time.Now().UTC()},
+			expectedUses: 2,
+			expectErr:    customErrors.NewConflictError("GroupMember", "user is already a member of the group", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+
+			repo := NewGroupInvitationRepository(db)
+			groupRepo := NewGroupRepository(db)
+
+			err := repo.Use(t.Context(), tt.id, tt.member)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+
+			// The use must be rolled back when the member can't be added.
+			invitation, _ := repo.GetByID(tt.id)
+			if invitation.Uses != tt.expectedUses {
+				t.Errorf("expected %d uses, got %d", tt.expectedUses, invitation.Uses)
+			}
+
+			if tt.expectErr != nil {
+				return
+			}
+
+			member, err := groupRepo.GetMember(tt.member.GroupId, tt.member.UserID)
+			if err != nil {
+				t.Fatalf("GetMember() unexpected error = %v", err)
+			}
+			if member.Admin {
+				t.Error("expected new member not to be admin")
+			}
+		})
+	}
+}
@@ -161,3 +161,435 @@ func TestGetGroupByID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGroupsByUserID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+
+	tests := []struct {
+		name     string
+		userID   int64
+		expected []model.Group
+	}{
+		{
+			name:     "User member of several groups",
+			userID:   2,
+			expected: []model.Group{testGroups[0], testGroups[1]},
+		},
+		{
+			name:     "User member of one group",
+			userID:   3,
+			expected: []model.Group{testGroups[0]},
+		},
+		{
+			name:     "User member of no group",
+			userID:   5,
+			expected: []model.Group{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := repo.GetByUserID(tt.userID)
+			if err != nil {
+				t.Fatalf("GetByUserID() unexpected error = %v", err)
+			}
+
+			if len(groups) != len(tt.expected) {
+				t.Fatalf("expected %d groups, got %d", len(tt.expected), len(groups))
+			}
+
+			for i := range groups {
+				if err := compareGroup(&groups[i], &tt.expected[i]); err != nil {
+					t.Errorf("GetByUserID() group does not match expected: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetGroupWithoutMembers(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+
+	group, err := repo.GetByID(3)
+	if err != nil {
+		t.Fatalf("GetByID() unexpected error = %v", err)
+	}
+
+	expected := newTestGroups()[2]
+	if err := compareGroup(group, &expected); err != nil {
+		t.Errorf("GetByID() group does not match expected: %v", err)
+	}
+}
+
+func TestGetGroupMember(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+
+	tests := []struct {
+		name      string
+		groupID   int64
+		userID    int64
+		expected  *model.GroupMember
+		expectErr error
+	}{
+		{
+			name:     "Admin member",
+			groupID:  1,
+			userID:   2,
+			expected: &model.GroupMember{UserID: 2, GroupId: 1, Admin: true, JoinedAt: time.Unix(0, 0)},
+		},
+		{
+			name:     "Simple member",
+			groupID:  1,
+			userID:   4,
+			expected: &model.GroupMember{UserID: 4, GroupId: 1, Admin: false, JoinedAt: time.Unix(0, 0)},
+		},
+		{
+			name:      "User not member of the group",
+			groupID:   2,
+			userID:    3,
+			expectErr: customErrors.NewNotFoundError("group_members", "group_id, user_id", sql.ErrNoRows),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member, err := repo.GetMember(tt.groupID, tt.userID)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if err := compareGroupMembers([]model.GroupMember{*member}, []model.GroupMember{*tt.expected}); err != nil {
+				t.Errorf("GetMember() member does not match expected: %v", err)
+			}
+			if member.GroupId != tt.groupID {
+				t.Errorf("expected GroupId %d, got %d", tt.groupID, member.GroupId)
+			}
+		})
+	}
+}
+
+func TestCreateGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		group     *model.Group
+		adminID   int64
+		expectErr error
+	}{
+		{
+			name:    "Valid group",
+			group:   &model.Group{Name: "Neighbours", ImageURL: new("/static/images/neighbours.jpg"), CreatedAt: time.Now().UTC()},
+			adminID: 5,
+		},
+		{
+			name:      "Unknown admin",
+			group:     &model.Group{Name: "Neighbours", CreatedAt: time.Now().UTC()},
+			adminID:   invalidGroupRepositoryID,
+			expectErr: customErrors.NewNotFoundError("users", fmt.Sprint(invalidGroupRepositoryID), nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+
+			repo := NewGroupRepository(db)
+
+			id, err := repo.Create(t.Context(), tt.group, tt.adminID)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				var count int
+				db.QueryRow("SELECT COUNT(*) FROM groups WHERE name = ?", tt.group.Name).Scan(&count)
+				if count != 0 {
+					t.Errorf("expected group creation to be rolled back, found %d groups", count)
+				}
+				return
+			}
+
+			created, err := repo.GetByID(id)
+			if err != nil {
+				t.Fatalf("GetByID() unexpected error = %v", err)
+			}
+
+			expected := *tt.group
+			expected.ID = id
+			expected.Members = []model.GroupMember{{UserID: tt.adminID, GroupId: id, Admin: true, JoinedAt: tt.group.CreatedAt}}
+			if err := compareGroup(created, &expected); err != nil {
+				t.Errorf("Create() group does not match expected: %v", err)
+			}
+
+			if _, err := NewItemCategoryRepository(db).GetByNameAndGroupID("Uncategorized", id); err != nil {
+				t.Errorf("expected the group to have an uncategorized item category, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUpdateGroup(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+
+	tests := []struct {
+		name      string
+		group     *model.Group
+		expectErr error
+	}{
+		{
+			name:  "Rename and change image",
+			group: &model.Group{ID: 1, Name: "Home", ImageURL: new("/static/images/home.jpg")},
+		},
+		{
+			name:  "Remove image",
+			group: &model.Group{ID: 2, Name: "Friends", ImageURL: nil},
+		},
+		{
+			name:      "Unknown group",
+			group:     &model.Group{ID: invalidGroupRepositoryID, Name: "Home"},
+			expectErr: customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.Update(tt.group)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			updated, err := repo.GetByID(tt.group.ID)
+			if err != nil {
+				t.Fatalf("GetByID() unexpected error = %v", err)
+			}
+
+			expected := newTestGroups()[tt.group.ID-1]
+			expected.Name = tt.group.Name
+			expected.ImageURL = tt.group.ImageURL
+			if err := compareGroup(updated, &expected); err != nil {
+				t.Errorf("Update() group does not match expected: %v", err)
+			}
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupID   int64
+		expectErr error
+	}{
+		{name: "Group with recipes, items, dishes and groceries", groupID: 1},
+		{name: "Group with items used by other groups", groupID: 2},
+		{name: "Group without members", groupID: 3},
+		{
+			name:      "Unknown group",
+			groupID:   invalidGroupRepositoryID,
+			expectErr: customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	dependentTables := []string{"recipes", "recipe_categories", "items", "item_categories", "units", "dishes", "groceries", "pantry", "group_members", "group_invitations"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+
+			repo := NewGroupRepository(db)
+
+			err := repo.Delete(t.Context(), tt.groupID)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if _, err := repo.GetByID(tt.groupID); err == nil {
+				t.Errorf("expected group %d to be deleted", tt.groupID)
+			}
+
+			for _, table := range dependentTables {
+				var count int
+				if err := db.QueryRow("SELECT COUNT(*) FROM "+table+" WHERE group_id = ?", tt.groupID).Scan(&count); err != nil {
+					t.Fatalf("failed to count %s: %v", table, err)
+				}
+				if count != 0 {
+					t.Errorf("expected no %s left for group %d, got %d", table, tt.groupID, count)
+				}
+			}
+
+			var count int
+			db.QueryRow("SELECT COUNT(*) FROM users WHERE last_visited_group_id = ?", tt.groupID).Scan(&count)
+			if count != 0 {
+				t.Errorf("expected no user visiting group %d, got %d", tt.groupID, count)
+			}
+		})
+	}
+}
+
+func TestAddGroupMember(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+
+	tests := []struct {
+		name      string
+		member    *model.GroupMember
+		expectErr error
+	}{
+		{
+			name:   "New member",
+			member: &model.GroupMember{UserID: 5, GroupId: 1, Admin: false, JoinedAt: time.Now().UTC()},
+		},
+		{
+			name:   "New admin of a group without members",
+			member: &model.GroupMember{UserID: 3, GroupId: 3, Admin: true, JoinedAt: time.Now().UTC()},
+		},
+		{
+			name:      "Already a member",
+			member:    &model.GroupMember{UserID: 2, GroupId: 1, Admin: false, JoinedAt: time.Now().UTC()},
+			expectErr: customErrors.NewConflictError("GroupMember", "user is already a member of the group", nil),
+		},
+		{
+			name:      "Unknown user",
+			member:    &model.GroupMember{UserID: invalidGroupRepositoryID, GroupId: 1, JoinedAt: time.Now().UTC()},
+			expectErr: customErrors.NewNotFoundError("users", fmt.Sprint(invalidGroupRepositoryID), nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.AddMember(tt.member)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			member, err := repo.GetMember(tt.member.GroupId, tt.member.UserID)
+			if err != nil {
+				t.Fatalf("GetMember() unexpected error = %v", err)
+			}
+			if err := compareGroupMembers([]model.GroupMember{*member}, []model.GroupMember{*tt.member}); err != nil {
+				t.Errorf("AddMember() member does not match expected: %v", err)
+			}
+		})
+	}
+}
+
+func TestUpdateGroupMemberAdmin(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+
+	tests := []struct {
+		name      string
+		groupID   int64
+		userID    int64
+		admin     bool
+		expectErr error
+	}{
+		{name: "Promote a member", groupID: 1, userID: 4, admin: true},
+		{name: "Demote an admin", groupID: 1, userID: 3, admin: false},
+		{
+			name:      "User not member of the group",
+			groupID:   2,
+			userID:    3,
+			admin:     true,
+			expectErr: customErrors.NewNotFoundError("group_members", "group_id, user_id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.UpdateMemberAdmin(tt.groupID, tt.userID, tt.admin)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			member, err := repo.GetMember(tt.groupID, tt.userID)
+			if err != nil {
+				t.Fatalf("GetMember() unexpected error = %v", err)
+			}
+			if member.Admin != tt.admin {
+				t.Errorf("expected Admin %v, got %v", tt.admin, member.Admin)
+			}
+		})
+	}
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+
+	tests := []struct {
+		name      string
+		groupID   int64
+		userID    int64
+		expectErr error
+	}{
+		{name: "Remove a member", groupID: 1, userID: 4},
+		{
+			name:      "Remove a member twice",
+			groupID:   1,
+			userID:    4,
+			expectErr: customErrors.NewNotFoundError("group_members", "group_id, user_id", nil),
+		},
+		{
+			name:      "User not member of the group",
+			groupID:   2,
+			userID:    3,
+			expectErr: customErrors.NewNotFoundError("group_members", "group_id, user_id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.RemoveMember(tt.groupID, tt.userID)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if _, err := repo.GetMember(tt.groupID, tt.userID); err == nil {
+				t.Errorf("expected user %d to be removed from group %d", tt.userID, tt.groupID)
+			}
+		})
+	}
+}
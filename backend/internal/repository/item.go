@@ -14,6 +14,7 @@ type ItemRepositoryInterface interface {
 	GetByGroupID(groupID int64, sort string, desc bool) ([]model.Item, error)
 	GetByID(id int64) (*model.Item, error)
 	GetByName(name string, desc bool) ([]model.Item, error)
+	SearchByNameAndGroupID(name string, groupID int64, desc bool) ([]model.Item, error)
 	Create(item *model.Item) (int64, error)
 	Update(item *model.Item) error
 	Delete(id int64) error
@@ -81,6 +82,22 @@ func (r *ItemRepository) GetByName(name string, desc bool) ([]model.Item, error)
 	return items, nil
 }
 
+// SearchByNameAndGroupID retrieves the items of a group whose name contains the provided one.
+func (r *ItemRepository) SearchByNameAndGroupID(name string, groupID int64, desc bool) ([]model.Item, error) {
+	clauses := "WHERE items.name LIKE concat('%', ?, '%') AND items.group_id = ? ORDER BY items.name"
+
+	if desc {
+		clauses += " DESC"
+	}
+
+	items, err := r.fetchItems(clauses, name, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
 // Create inserts a new item into the database and returns the inserted ID.
 func (r *ItemRepository) Create(item *model.Item) (int64, error) {
 	result, err := r.db.Exec(`
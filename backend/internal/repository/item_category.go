@@ -1,16 +1,25 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 
+	"github.com/mattn/go-sqlite3"
+	"github.com/zouipo/yumsday/backend/internal/constant"
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
 )
 
 type ItemCategoryRepositoryInterface interface {
 	GetByID(id int64) (*model.ItemCategory, error)
+	GetByGroupID(groupID int64) ([]model.ItemCategory, error)
 	GetByNameAndGroupID(name string, groupID int64) (*model.ItemCategory, error)
+	Create(category *model.ItemCategory) (int64, error)
+	Update(category *model.ItemCategory) error
+	Reorder(ctx context.Context, groupID int64, ids []int64) error
+	Delete(ctx context.Context, id int64) error
 }
 
 type ItemCategoryRepository struct {
@@ -23,6 +32,8 @@ func NewItemCategoryRepository(db *sql.DB) *ItemCategoryRepository {
 	}
 }
 
+/*** READ OPERATIONS ***/
+
 // GetByID retrieves an item category from the database by its ID.
 func (r *ItemCategoryRepository) GetByID(id int64) (*model.ItemCategory, error) {
 	itemCategories, err := r.fetchItemCategories("WHERE id = ?", id)
@@ -37,8 +48,27 @@ func (r *ItemCategoryRepository) GetByID(id int64) (*model.ItemCategory, error)
 	return &itemCategories[0], nil
 }
 
-// GetByNameAndGroupID retrieves an item category from the database by its name and group ID.
-func (r *ItemCategoryRepository) GetByNameAndGroupID(name string, groupID int64, descending bool) ([]model.ItemCategory, error) {
+// GetByGroupID retrieves the item categories of a group, sorted by their position.
+func (r *ItemCategoryRepository) GetByGroupID(groupID int64) ([]model.ItemCategory, error) {
+	return r.fetchItemCategories("WHERE group_id = ? ORDER BY position, name", groupID)
+}
+
+// GetByNameAndGroupID retrieves the item category of a group whose name exactly matches the provided one (case insensitive).
+func (r *ItemCategoryRepository) GetByNameAndGroupID(name string, groupID int64) (*model.ItemCategory, error) {
+	itemCategories, err := r.fetchItemCategories("WHERE name = ? COLLATE NOCASE AND group_id = ?", name, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(itemCategories) == 0 {
+		return nil, customErrors.NewNotFoundError("item_categories", "name, group_id", nil)
+	}
+
+	return &itemCategories[0], nil
+}
+
+// SearchByNameAndGroupID retrieves the item categories of a group whose name contains the provided one.
+func (r *ItemCategoryRepository) SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.ItemCategory, error) {
 	clauses := "WHERE name LIKE concat('%', ?, '%') AND group_id = ? ORDER BY name"
 
 	if descending {
@@ -53,10 +83,129 @@ func (r *ItemCategoryRepository) GetByNameAndGroupID(name string, groupID int64,
 	return itemCategories, nil
 }
 
+/*** CREATE OPERATIONS ***/
+
+// Create inserts a new item category after the last one of its group and returns its ID.
+func (r *ItemCategoryRepository) Create(category *model.ItemCategory) (int64, error) {
+	res, err := r.db.Exec(
+		`INSERT INTO item_categories (name, group_id, position)
+		SELECT ?1, ?2, COALESCE(MAX(position) + 1, 0) FROM item_categories WHERE group_id = ?2`,
+		category.Name,
+		category.GroupID,
+	)
+	if err != nil {
+		return 0, itemCategoryWriteError(err, "failed to create item category")
+	}
+
+	category.ID, err = res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve item category ID", err)
+	}
+
+	return category.ID, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update renames the item category identified by category.ID.
+func (r *ItemCategoryRepository) Update(category *model.ItemCategory) error {
+	res, err := r.db.Exec("UPDATE item_categories SET name = ? WHERE id = ?", category.Name, category.ID)
+	if err != nil {
+		return itemCategoryWriteError(err, "failed to update item category")
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if item category was updated", err)
+	}
+	if updatedRows == 0 {
+		return customErrors.NewNotFoundError("item_categories", "id", nil)
+	}
+
+	return nil
+}
+
+// Reorder sets the position of the item categories of a group to their index in ids.
+// A NotFoundError is returned if one of them doesn't belong to the group.
+func (r *ItemCategoryRepository) Reorder(ctx context.Context, groupID int64, ids []int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	for position, id := range ids {
+		res, err := tx.ExecContext(ctx, "UPDATE item_categories SET position = ? WHERE id = ? AND group_id = ?", position, id, groupID)
+		if err != nil {
+			return customErrors.NewInternalError("failed to reorder item categories", err)
+		}
+
+		updatedRows, err := res.RowsAffected()
+		if err != nil {
+			return customErrors.NewInternalError("failed to check if item category was reordered", err)
+		}
+		if updatedRows == 0 {
+			return customErrors.NewNotFoundError("item_categories", "id, group_id", nil)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the item category identified by id, moving its items to the "Uncategorized" category of its group.
+// The "Uncategorized" category itself can't be deleted.
+func (r *ItemCategoryRepository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	var uncategorizedID int64
+	row := tx.QueryRowContext(
+		ctx,
+		`SELECT uncategorized.id FROM item_categories AS deleted
+		JOIN item_categories AS uncategorized ON uncategorized.group_id = deleted.group_id
+		WHERE deleted.id = ? AND uncategorized.name = ? COLLATE NOCASE`,
+		id,
+		constant.UNCATEGORIZED_ITEM_CATEGORY,
+	)
+	if err := row.Scan(&uncategorizedID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return customErrors.NewNotFoundError("item_categories", "id", nil)
+		}
+		return customErrors.NewInternalError("failed to fetch the uncategorized item category", err)
+	}
+
+	if uncategorizedID == id {
+		return customErrors.NewConflictError("ItemCategory", "the uncategorized item category can't be deleted", nil)
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE items SET item_category_id = ? WHERE item_category_id = ?", uncategorizedID, id); err != nil {
+		return customErrors.NewInternalError("failed to move items to the uncategorized item category", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM item_categories WHERE id = ?", id); err != nil {
+		return customErrors.NewInternalError("failed to delete item category", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
 // fetchItemCategories is a helper method to retrieve multiple item categories based on filtering options.
 func (r *ItemCategoryRepository) fetchItemCategories(clauses string, values ...any) ([]model.ItemCategory, error) {
 	query := `SELECT
-	item_categories.*
+	item_categories.id, item_categories.name, item_categories.group_id, item_categories.position
 	FROM item_categories ` + clauses
 
 	slog.Debug("fetching item categories", "query", query)
@@ -65,6 +214,7 @@ func (r *ItemCategoryRepository) fetchItemCategories(clauses string, values ...a
 	if err != nil {
 		return nil, customErrors.NewInternalError("failed to fetch item categories", err)
 	}
+	defer rows.Close()
 
 	itemCategories := []model.ItemCategory{}
 
@@ -74,6 +224,7 @@ func (r *ItemCategoryRepository) fetchItemCategories(clauses string, values ...a
 			&itemCategory.ID,
 			&itemCategory.Name,
 			&itemCategory.GroupID,
+			&itemCategory.Position,
 		)
 
 		if err != nil {
@@ -89,3 +240,16 @@ func (r *ItemCategoryRepository) fetchItemCategories(clauses string, values ...a
 
 	return itemCategories, nil
 }
+
+// itemCategoryWriteError maps the constraint violations of an insert or update of an item category to application errors.
+func itemCategoryWriteError(err error, msg string) error {
+	if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok {
+		switch sqlerr.ExtendedCode {
+		case sqlite3.ErrConstraintUnique:
+			return customErrors.NewConflictError("ItemCategory", "an item category with this name already exists in the group", sqlerr)
+		case sqlite3.ErrConstraintForeignKey:
+			return customErrors.NewNotFoundError("groups", "id", sqlerr)
+		}
+	}
+	return customErrors.NewInternalError(msg, err)
+}
@@ -17,15 +17,19 @@ var (
 )
 
 var testItemCategories = []model.ItemCategory{
-	{ID: 1, Name: "GRAINS AND PASTA", GroupID: 1},
-	{ID: 2, Name: "BAKED GOODS", GroupID: 1},
-	{ID: 3, Name: "SPICES AND CONDIMENTS", GroupID: 1},
-	{ID: 4, Name: "DAIRY", GroupID: 1},
-	{ID: 5, Name: "MEAT", GroupID: 2},
-	{ID: 6, Name: "VEGETABLES", GroupID: 2},
-	{ID: 7, Name: "SNACKS", GroupID: 2},
-	{ID: 8, Name: "CANNED GOODS", GroupID: 1},
-	{ID: 9, Name: "BEVERAGE", GroupID: 2},
+	{ID: 1, Name: "GRAINS AND PASTA", GroupID: 1, Position: 0},
+	{ID: 2, Name: "BAKED GOODS", GroupID: 1, Position: 1},
+	{ID: 3, Name: "SPICES AND CONDIMENTS", GroupID: 1, Position: 2},
+	{ID: 4, Name: "DAIRY", GroupID: 1, Position: 3},
+	{ID: 5, Name: "MEAT", GroupID: 2, Position: 0},
+	{ID: 6, Name: "VEGETABLES", GroupID: 2, Position: 1},
+	{ID: 7, Name: "SNACKS", GroupID: 2, Position: 2},
+	{ID: 8, Name: "CANNED GOODS", GroupID: 1, Position: 4},
+	{ID: 9, Name: "BEVERAGE", GroupID: 2, Position: 3},
+	{ID: 10, Name: "Uncategorized", GroupID: 1, Position: 5},
+	{ID: 11, Name: "Uncategorized", GroupID: 2, Position: 4},
+	{ID: 12, Name: "Uncategorized", GroupID: 3, Position: 0},
+	{ID: 13, Name: "Uncategorized", GroupID: 4, Position: 0},
 }
 
 func compareSlicesItemCategories(s1, s2 []model.ItemCategory) bool {
@@ -111,6 +115,67 @@ func TestGetItemCategoryByNameAndGroupID(t *testing.T) {
 
 	repo := NewItemCategoryRepository(db)
 
+	tests := []struct {
+		name      string
+		icName    string
+		groupID   int64
+		expected  *model.ItemCategory
+		expectErr error
+	}{
+		{
+			name:     "Exact name and group ID",
+			icName:   testItemCategories[0].Name,
+			groupID:  testItemCategories[0].GroupID,
+			expected: &testItemCategories[0],
+		},
+		{
+			name:     "Exact name with different case",
+			icName:   "dairy",
+			groupID:  testItemCategories[3].GroupID,
+			expected: &testItemCategories[3],
+		},
+		{
+			name:      "Partial name",
+			icName:    "GOOD",
+			groupID:   testItemCategories[0].GroupID,
+			expectErr: customErrors.NewNotFoundError("item_categories", "name, group_id", nil),
+		},
+		{
+			name:      "Valid name and other group ID",
+			icName:    testItemCategories[0].Name,
+			groupID:   testItemCategories[4].GroupID,
+			expectErr: customErrors.NewNotFoundError("item_categories", "name, group_id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByNameAndGroupID(tt.icName, tt.groupID)
+
+			if tt.expectErr != nil {
+				if !utils.CompareErrors(err, tt.expectErr) {
+					t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GetByNameAndGroupID() unexpected error = %v", err)
+			}
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("item categories should be equal: expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSearchItemCategoriesByNameAndGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewItemCategoryRepository(db)
+
 	tests := []struct {
 		name       string
 		icName     string
@@ -163,10 +228,10 @@ func TestGetItemCategoryByNameAndGroupID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual, err := repo.GetByNameAndGroupID(tt.icName, tt.groupID, tt.descending)
+			actual, err := repo.SearchByNameAndGroupID(tt.icName, tt.groupID, tt.descending)
 
 			if err != nil {
-				t.Fatalf("GetByNameAndGroupID() unexpected error = %v", err)
+				t.Fatalf("SearchByNameAndGroupID() unexpected error = %v", err)
 			}
 
 			if !compareSlicesItemCategories(actual, tt.expected) {
@@ -175,3 +240,282 @@ func TestGetItemCategoryByNameAndGroupID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetItemCategoriesByGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewItemCategoryRepository(db)
+
+	tests := []struct {
+		name     string
+		groupID  int64
+		expected []model.ItemCategory
+	}{
+		{
+			name:    "Group with several categories, sorted by position",
+			groupID: 2,
+			expected: []model.ItemCategory{
+				testItemCategories[4],
+				testItemCategories[5],
+				testItemCategories[6],
+				testItemCategories[8],
+				testItemCategories[10],
+			},
+		},
+		{
+			name:     "Group with only the uncategorized category",
+			groupID:  3,
+			expected: []model.ItemCategory{testItemCategories[11]},
+		},
+		{
+			name:     "Invalid group ID",
+			groupID:  invalidICGroupID,
+			expected: []model.ItemCategory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByGroupID(tt.groupID)
+
+			if err != nil {
+				t.Fatalf("GetByGroupID() unexpected error = %v", err)
+			}
+
+			if !compareSlicesItemCategories(actual, tt.expected) {
+				t.Errorf("item categories should be equal: expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCreateItemCategory(t *testing.T) {
+	tests := []struct {
+		name      string
+		category  model.ItemCategory
+		expected  model.ItemCategory
+		expectErr error
+	}{
+		{
+			name:     "New category added after the last one of the group",
+			category: model.ItemCategory{Name: "FROZEN", GroupID: 1},
+			expected: model.ItemCategory{Name: "FROZEN", GroupID: 1, Position: 6},
+		},
+		{
+			name:     "Name used by another group",
+			category: model.ItemCategory{Name: "MEAT", GroupID: 1},
+			expected: model.ItemCategory{Name: "MEAT", GroupID: 1, Position: 6},
+		},
+		{
+			name:      "Name already used in the group",
+			category:  model.ItemCategory{Name: "uncategorized", GroupID: 1},
+			expectErr: customErrors.NewConflictError("ItemCategory", "an item category with this name already exists in the group", nil),
+		},
+		{
+			name:      "Invalid group ID",
+			category:  model.ItemCategory{Name: "FROZEN", GroupID: invalidICGroupID},
+			expectErr: customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+
+			repo := NewItemCategoryRepository(db)
+
+			id, err := repo.Create(&tt.category)
+
+			if tt.expectErr != nil {
+				if !utils.CompareErrors(err, tt.expectErr) {
+					t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+
+			actual, err := repo.GetByID(id)
+			if err != nil {
+				t.Fatalf("GetByID() unexpected error = %v", err)
+			}
+
+			tt.expected.ID = id
+			if !reflect.DeepEqual(*actual, tt.expected) {
+				t.Errorf("item categories should be equal: expected %v, got %v", tt.expected, *actual)
+			}
+		})
+	}
+}
+
+func TestUpdateItemCategory(t *testing.T) {
+	tests := []struct {
+		name      string
+		category  model.ItemCategory
+		expectErr error
+	}{
+		{
+			name:     "Rename",
+			category: model.ItemCategory{ID: 4, Name: "DAIRY PRODUCTS", GroupID: 1, Position: 3},
+		},
+		{
+			name:      "Name already used in the group",
+			category:  model.ItemCategory{ID: 4, Name: "Canned Goods", GroupID: 1, Position: 3},
+			expectErr: customErrors.NewConflictError("ItemCategory", "an item category with this name already exists in the group", nil),
+		},
+		{
+			name:      "Invalid ID",
+			category:  model.ItemCategory{ID: invalidICID, Name: "DAIRY PRODUCTS"},
+			expectErr: customErrors.NewNotFoundError("item_categories", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+
+			repo := NewItemCategoryRepository(db)
+
+			err := repo.Update(&tt.category)
+
+			if tt.expectErr != nil {
+				if !utils.CompareErrors(err, tt.expectErr) {
+					t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Update() unexpected error = %v", err)
+			}
+
+			actual, err := repo.GetByID(tt.category.ID)
+			if err != nil {
+				t.Fatalf("GetByID() unexpected error = %v", err)
+			}
+
+			if !reflect.DeepEqual(*actual, tt.category) {
+				t.Errorf("item categories should be equal: expected %v, got %v", tt.category, *actual)
+			}
+		})
+	}
+}
+
+func TestReorderItemCategories(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupID     int64
+		ids         []int64
+		expectedIDs []int64
+		expectErr   error
+	}{
+		{
+			name:        "Reverse the categories of the group",
+			groupID:     2,
+			ids:         []int64{11, 9, 7, 6, 5},
+			expectedIDs: []int64{11, 9, 7, 6, 5},
+		},
+		{
+			name:        "Category of another group",
+			groupID:     2,
+			ids:         []int64{11, 9, 7, 6, 1},
+			expectedIDs: []int64{5, 6, 7, 9, 11},
+			expectErr:   customErrors.NewNotFoundError("item_categories", "id, group_id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+
+			repo := NewItemCategoryRepository(db)
+
+			err := repo.Reorder(t.Context(), tt.groupID, tt.ids)
+
+			if !utils.CompareErrors(err, tt.expectErr) {
+				t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+			}
+
+			// A failed reordering is rolled back.
+			actual, err := repo.GetByGroupID(tt.groupID)
+			if err != nil {
+				t.Fatalf("GetByGroupID() unexpected error = %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d categories, got %d", len(tt.expectedIDs), len(actual))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] || actual[i].Position != i {
+					t.Errorf("expected category %d at position %d, got %d at position %d", tt.expectedIDs[i], i, actual[i].ID, actual[i].Position)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteItemCategory(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        int64
+		expectErr error
+	}{
+		{
+			name: "Category with items",
+			id:   3,
+		},
+		{
+			name:      "Uncategorized category",
+			id:        10,
+			expectErr: customErrors.NewConflictError("ItemCategory", "the uncategorized item category can't be deleted", nil),
+		},
+		{
+			name:      "Invalid ID",
+			id:        invalidICID,
+			expectErr: customErrors.NewNotFoundError("item_categories", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+
+			repo := NewItemCategoryRepository(db)
+
+			var itemsNb int
+			db.QueryRow("SELECT COUNT(*) FROM items WHERE item_category_id = ?", tt.id).Scan(&itemsNb)
+
+			err := repo.Delete(t.Context(), tt.id)
+
+			if tt.expectErr != nil {
+				if !utils.CompareErrors(err, tt.expectErr) {
+					t.Fatalf("expected error '%v', got '%v'", tt.expectErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Delete() unexpected error = %v", err)
+			}
+
+			if _, err := repo.GetByID(tt.id); !utils.CompareErrors(err, customErrors.NewNotFoundError("item_categories", "id", nil)) {
+				t.Fatalf("item category %d should have been deleted", tt.id)
+			}
+
+			// The items of the deleted category are moved to the uncategorized one of the group.
+			var movedNb int
+			db.QueryRow("SELECT COUNT(*) FROM items WHERE item_category_id = 10").Scan(&movedNb)
+			if itemsNb == 0 || movedNb != itemsNb {
+				t.Errorf("expected %d items moved to the uncategorized category, got %d", itemsNb, movedNb)
+			}
+		})
+	}
+}
@@ -462,6 +462,60 @@ func TestGetItemByName(t *testing.T) {
 	}
 }
 
+func TestSearchItemsByNameAndGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewItemRepository(db)
+
+	tests := []struct {
+		name       string
+		search     string
+		groupID    int64
+		descending bool
+		expected   []model.Item
+	}{
+		{
+			name:     "Partial name ascending",
+			search:   "a",
+			groupID:  1,
+			expected: []model.Item{expectedItems[14], expectedItems[2], expectedItems[1]},
+		},
+		{
+			name:       "Partial name descending, whatever its case",
+			search:     "A",
+			groupID:    1,
+			descending: true,
+			expected:   []model.Item{expectedItems[1], expectedItems[2], expectedItems[14]},
+		},
+		{
+			name:     "Items of other groups are ignored",
+			search:   "at",
+			groupID:  1,
+			expected: []model.Item{},
+		},
+		{
+			name:     "Unknown group",
+			search:   "a",
+			groupID:  -1,
+			expected: []model.Item{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := repo.SearchByNameAndGroupID(tt.search, tt.groupID, tt.descending)
+			if err != nil {
+				t.Fatalf("SearchByNameAndGroupID() unexpected error = %v", err)
+			}
+
+			if !compareSlicesItems(items, tt.expected) {
+				t.Errorf("Items should be equal: expected %v, got %v", tt.expected, items)
+			}
+		})
+	}
+}
+
 /*** CREATE OPERATIONS TESTS ***/
 func TestCreateItem(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type LoginThrottleRepositoryInterface interface {
+	Get(kind, key string) (*model.LoginThrottle, error)
+	AddFailure(kind, key string, at time.Time, window time.Duration) (*model.LoginThrottle, error)
+	Block(kind, key string, until time.Time) error
+	Delete(kind, key string) error
+	CleanUp(window time.Duration) int64
+}
+
+type LoginThrottleRepository struct {
+	db *sql.DB
+}
+
+// NewLoginThrottleRepository constructs a new LoginThrottleRepository using the provided database.
+func NewLoginThrottleRepository(db *sql.DB) *LoginThrottleRepository {
+	return &LoginThrottleRepository{
+		db: db,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// Get retrieves the failed login attempts of a key of the given kind.
+func (r *LoginThrottleRepository) Get(kind, key string) (*model.LoginThrottle, error) {
+	throttle := &model.LoginThrottle{}
+
+	err := r.db.QueryRow(
+		"SELECT kind, key, failures, last_failure_at, blocked_until FROM login_throttles WHERE kind = ? AND key = ?",
+		kind, key,
+	).Scan(
+		&throttle.Kind,
+		&throttle.Key,
+		&throttle.Failures,
+		&throttle.LastFailureAt,
+		&throttle.BlockedUntil,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.NewNotFoundError("login_throttles", "key", err)
+		}
+		return nil, customErrors.NewInternalError("failed to fetch login throttle", err)
+	}
+
+	return throttle, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// AddFailure atomically counts a failed login attempt of a key at the given time, and returns its failed attempts.
+// The attempts of a key whose last failure is older than the window are forgotten first, along with its delay.
+func (r *LoginThrottleRepository) AddFailure(kind, key string, at time.Time, window time.Duration) (*model.LoginThrottle, error) {
+	throttle := &model.LoginThrottle{}
+	forgottenBefore := at.Add(-window)
+
+	err := r.db.QueryRow(
+		`INSERT INTO login_throttles (kind, key, failures, last_failure_at)
+		VALUES (?, ?, 1, ?)
+		ON CONFLICT(kind, key) DO UPDATE SET
+		  failures = CASE WHEN last_failure_at < ? THEN 1 ELSE failures + 1 END,
+		  blocked_until = CASE WHEN last_failure_at < ? THEN NULL ELSE blocked_until END,
+		  last_failure_at = excluded.last_failure_at
+		RETURNING kind, key, failures, last_failure_at, blocked_until`,
+		kind, key, at, forgottenBefore, forgottenBefore,
+	).Scan(
+		&throttle.Kind,
+		&throttle.Key,
+		&throttle.Failures,
+		&throttle.LastFailureAt,
+		&throttle.BlockedUntil,
+	)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to count login failure", err)
+	}
+
+	return throttle, nil
+}
+
+// Block delays the logins of a key until the given time.
+// A longer delay already set by a concurrent failure is kept.
+func (r *LoginThrottleRepository) Block(kind, key string, until time.Time) error {
+	_, err := r.db.Exec(
+		"UPDATE login_throttles SET blocked_until = ? WHERE kind = ? AND key = ? AND (blocked_until IS NULL OR blocked_until < ?)",
+		until, kind, key, until,
+	)
+	if err != nil {
+		return customErrors.NewInternalError("failed to block logins", err)
+	}
+	return nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete forgets the failed login attempts of a key, lifting any delay.
+// It doesn't return an error if the key has no failed attempt.
+func (r *LoginThrottleRepository) Delete(kind, key string) error {
+	_, err := r.db.Exec("DELETE FROM login_throttles WHERE kind = ? AND key = ?", kind, key)
+	if err != nil {
+		return customErrors.NewInternalError("failed to delete login throttle", err)
+	}
+	return nil
+}
+
+// CleanUp removes the failed login attempts older than the window, once their delay is over.
+// It returns the number of keys that were removed.
+func (r *LoginThrottleRepository) CleanUp(window time.Duration) int64 {
+	now := time.Now().UTC()
+	result, err := r.db.Exec(
+		"DELETE FROM login_throttles WHERE last_failure_at < ? AND (blocked_until IS NULL OR blocked_until < ?)",
+		now.Add(-window), now,
+	)
+	if err != nil {
+		return 0
+	}
+
+	removedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0
+	}
+	return removedRows
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+func TestNewLoginThrottleRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewLoginThrottleRepository(db)
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+}
+
+func TestLoginThrottleRepositoryAddFailureAndGet(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewLoginThrottleRepository(db)
+
+	_, err := repo.Get("USERNAME", "alice")
+	if !utils.CompareErrors(err, customErrors.NewNotFoundError("login_throttles", "key", nil)) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	throttle, err := repo.AddFailure("USERNAME", "alice", now.Add(-time.Minute), time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if throttle.Failures != 1 || throttle.BlockedUntil != nil {
+		t.Errorf("unexpected throttle %+v", *throttle)
+	}
+
+	// Counted on conflict
+	if err = repo.Block("USERNAME", "alice", now.Add(time.Minute)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	throttle, err = repo.AddFailure("USERNAME", "alice", now, time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if throttle.Failures != 2 || !throttle.LastFailureAt.Equal(now) || throttle.BlockedUntil == nil || !throttle.BlockedUntil.Equal(now.Add(time.Minute)) {
+		t.Errorf("unexpected throttle %+v", *throttle)
+	}
+
+	actual, err := repo.Get("USERNAME", "alice")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if actual.Failures != 2 || !actual.LastFailureAt.Equal(now) || actual.BlockedUntil == nil || !actual.BlockedUntil.Equal(now.Add(time.Minute)) {
+		t.Errorf("unexpected throttle %+v", *actual)
+	}
+
+	// Keys of different kinds are distinct
+	if _, err = repo.Get("IP", "alice"); err == nil {
+		t.Error("expected not found error for another kind, got nil")
+	}
+
+	// Failures older than the window are forgotten, along with their delay
+	throttle, err = repo.AddFailure("USERNAME", "alice", now.Add(2*time.Hour), time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if throttle.Failures != 1 || throttle.BlockedUntil != nil {
+		t.Errorf("expected the old failures to be forgotten, got %+v", *throttle)
+	}
+}
+
+func TestLoginThrottleRepositoryAddFailureConcurrently(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	// Each connection would open its own in-memory database
+	db.SetMaxOpenConns(1)
+	repo := NewLoginThrottleRepository(db)
+
+	const attempts = 20
+	now := time.Now().UTC()
+
+	var wg sync.WaitGroup
+	for range attempts {
+		wg.Go(func() {
+			if _, err := repo.AddFailure("IP", "192.0.2.1", now, time.Hour); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	actual, err := repo.Get("IP", "192.0.2.1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if actual.Failures != attempts {
+		t.Errorf("expected %d failures, got %d", attempts, actual.Failures)
+	}
+}
+
+func TestLoginThrottleRepositoryBlock(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewLoginThrottleRepository(db)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	repo.AddFailure("USERNAME", "alice", now, time.Hour)
+
+	if err := repo.Block("USERNAME", "alice", now.Add(time.Hour)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// A shorter delay doesn't shorten the current one
+	if err := repo.Block("USERNAME", "alice", now.Add(time.Minute)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	actual, err := repo.Get("USERNAME", "alice")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if actual.BlockedUntil == nil || !actual.BlockedUntil.Equal(now.Add(time.Hour)) {
+		t.Errorf("expected logins blocked until %s, got %v", now.Add(time.Hour), actual.BlockedUntil)
+	}
+}
+
+func TestLoginThrottleRepositoryDelete(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewLoginThrottleRepository(db)
+
+	repo.AddFailure("USERNAME", "alice", time.Now().UTC(), time.Hour)
+
+	if err := repo.Delete("USERNAME", "alice"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := repo.Get("USERNAME", "alice"); err == nil {
+		t.Error("expected the throttle to be deleted")
+	}
+	if err := repo.Delete("USERNAME", "alice"); err != nil {
+		t.Errorf("expected no error when deleting a missing throttle, got %v", err)
+	}
+}
+
+func TestLoginThrottleRepositoryCleanUp(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewLoginThrottleRepository(db)
+
+	now := time.Now().UTC()
+	repo.AddFailure("USERNAME", "recent", now, time.Hour)
+	repo.AddFailure("USERNAME", "old", now.Add(-2*time.Hour), time.Hour)
+	repo.AddFailure("IP", "old-but-blocked", now.Add(-2*time.Hour), time.Hour)
+	repo.Block("IP", "old-but-blocked", now.Add(time.Hour))
+
+	if removed := repo.CleanUp(time.Hour); removed != 1 {
+		t.Errorf("expected 1 removed throttle, got %d", removed)
+	}
+	if _, err := repo.Get("USERNAME", "old"); err == nil {
+		t.Error("expected the old throttle to be removed")
+	}
+}
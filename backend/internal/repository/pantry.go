@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/mattn/go-sqlite3"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type PantryRepositoryInterface interface {
+	GetByID(id int64) (*model.PantryItem, error)
+	GetByGroupID(groupID int64) ([]model.PantryItem, error)
+	Create(pantryItem *model.PantryItem) (int64, error)
+	Update(pantryItem *model.PantryItem) error
+	DeductForDish(ctx context.Context, dishID int64, pantryItems []model.PantryItem) error
+	Delete(id int64) error
+}
+
+type PantryRepository struct {
+	db *sql.DB
+}
+
+func NewPantryRepository(db *sql.DB) *PantryRepository {
+	return &PantryRepository{
+		db: db,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID retrieves a pantry line from the database by its ID, with its item, the item category and its unit.
+func (r *PantryRepository) GetByID(id int64) (*model.PantryItem, error) {
+	pantryItems, err := r.fetchPantryItems("WHERE pantry.id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pantryItems) == 0 {
+		return nil, customErrors.NewNotFoundError("pantry", "id", nil)
+	}
+
+	return &pantryItems[0], nil
+}
+
+// GetByGroupID retrieves the pantry of a group, sorted as the grocery list:
+// by the position of the item categories, then by item name.
+func (r *PantryRepository) GetByGroupID(groupID int64) ([]model.PantryItem, error) {
+	return r.fetchPantryItems(
+		"WHERE pantry.group_id = ? ORDER BY item_categories.position, items.name COLLATE NOCASE, pantry.id",
+		groupID,
+	)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create inserts a new pantry line and returns its ID.
+func (r *PantryRepository) Create(pantryItem *model.PantryItem) (int64, error) {
+	res, err := r.db.Exec(
+		"INSERT INTO pantry (quantity, item_id, unit_id, group_id) VALUES (?, ?, ?, ?)",
+		pantryItem.Quantity,
+		pantryItem.Item.ID,
+		pantryItem.Unit.ID,
+		pantryItem.GroupID,
+	)
+	if err != nil {
+		return 0, pantryWriteError(err, "failed to create pantry item")
+	}
+
+	pantryItem.ID, err = res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve pantry item ID", err)
+	}
+
+	return pantryItem.ID, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update replaces the quantity, item and unit of the pantry line identified by pantryItem.ID.
+func (r *PantryRepository) Update(pantryItem *model.PantryItem) error {
+	res, err := r.db.Exec(
+		"UPDATE pantry SET quantity = ?, item_id = ?, unit_id = ? WHERE id = ?",
+		pantryItem.Quantity,
+		pantryItem.Item.ID,
+		pantryItem.Unit.ID,
+		pantryItem.ID,
+	)
+	if err != nil {
+		return pantryWriteError(err, "failed to update pantry item")
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if pantry item was updated", err)
+	}
+	if updatedRows == 0 {
+		return customErrors.NewNotFoundError("pantry", "id", nil)
+	}
+
+	return nil
+}
+
+// DeductForDish flags the dish identified by dishID as cooked and deducts the quantities of the provided pantry lines
+// from the pantry, in one transaction. Stocks never fall below zero, and the emptied lines are removed from the pantry.
+// Returns a ConflictError, without writing anything, if the dish is already cooked, e.g. by a concurrent call.
+func (r *PantryRepository) DeductForDish(ctx context.Context, dishID int64, pantryItems []model.PantryItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "UPDATE dishes SET cooked = 1 WHERE id = ? AND cooked = 0", dishID)
+	if err != nil {
+		return customErrors.NewInternalError("failed to flag dish as cooked", err)
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if dish was flagged as cooked", err)
+	}
+	if updatedRows == 0 {
+		return customErrors.NewConflictError("Dish", "dish is already cooked", nil)
+	}
+
+	// A line removed in the meantime has nothing left to deduct.
+	for _, pantryItem := range pantryItems {
+		if _, err := tx.ExecContext(ctx, "UPDATE pantry SET quantity = max(quantity - ?, 0) WHERE id = ?", pantryItem.Quantity, pantryItem.ID); err != nil {
+			return customErrors.NewInternalError("failed to update pantry item", err)
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM pantry WHERE id = ? AND quantity <= 0", pantryItem.ID); err != nil {
+			return customErrors.NewInternalError("failed to delete pantry item", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the pantry line identified by id.
+func (r *PantryRepository) Delete(id int64) error {
+	res, err := r.db.Exec("DELETE FROM pantry WHERE id = ?", id)
+	if err != nil {
+		return customErrors.NewInternalError("failed to delete pantry item", err)
+	}
+
+	deletedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if pantry item was deleted", err)
+	}
+	if deletedRows == 0 {
+		return customErrors.NewNotFoundError("pantry", "id", nil)
+	}
+
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// fetchPantryItems is a helper method to retrieve multiple pantry lines based on filtering options.
+func (r *PantryRepository) fetchPantryItems(clauses string, values ...any) ([]model.PantryItem, error) {
+	query := `SELECT
+	pantry.id, pantry.quantity, pantry.group_id,
+	items.id, items.name, items.average_market_price, items.unit_type, items.group_id,
+	item_categories.id, item_categories.name, item_categories.position,
+	units.id, units.name, units.factor, units.unit_type, units.group_id
+	FROM pantry
+	JOIN items ON items.id = pantry.item_id
+	JOIN item_categories ON item_categories.id = items.item_category_id
+	JOIN units ON units.id = pantry.unit_id ` + clauses
+
+	slog.Debug("fetching pantry items", "query", query)
+
+	rows, err := r.db.Query(query, values...)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch pantry items", err)
+	}
+	defer rows.Close()
+
+	pantryItems := []model.PantryItem{}
+
+	for rows.Next() {
+		var pantryItem model.PantryItem
+		err := rows.Scan(
+			&pantryItem.ID,
+			&pantryItem.Quantity,
+			&pantryItem.GroupID,
+			&pantryItem.Item.ID,
+			&pantryItem.Item.Name,
+			&pantryItem.Item.AverageMarketPrice,
+			&pantryItem.Item.UnitType,
+			&pantryItem.Item.GroupID,
+			&pantryItem.Item.ItemCategory.ID,
+			&pantryItem.Item.ItemCategory.Name,
+			&pantryItem.Item.ItemCategory.Position,
+			&pantryItem.Unit.ID,
+			&pantryItem.Unit.Name,
+			&pantryItem.Unit.Factor,
+			&pantryItem.Unit.UnitType,
+			&pantryItem.Unit.GroupID,
+		)
+
+		if err != nil {
+			return nil, customErrors.NewInternalError("failed to fetch pantry items", err)
+		}
+
+		pantryItem.Item.ItemCategory.GroupID = pantryItem.Item.GroupID
+		pantryItems = append(pantryItems, pantryItem)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("failed to iterate rows", err)
+	}
+
+	return pantryItems, nil
+}
+
+// pantryWriteError maps the constraint violations of an insert or update of a pantry line to application errors.
+func pantryWriteError(err error, msg string) error {
+	if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok {
+		switch sqlerr.ExtendedCode {
+		case sqlite3.ErrConstraintUnique:
+			return customErrors.NewConflictError("PantryItem", "this item is already stocked in this unit", sqlerr)
+		case sqlite3.ErrConstraintForeignKey:
+			return customErrors.NewNotFoundError("items, units or groups", "id", sqlerr)
+		}
+	}
+	return customErrors.NewInternalError(msg, err)
+}
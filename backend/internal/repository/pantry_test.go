@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+func TestNewPantryRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewPantryRepository(db)
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+}
+
+func TestGetPantryItemByID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewPantryRepository(db)
+
+	actual, err := repo.GetByID(4)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	if actual.Quantity != 3 || actual.GroupID != 2 {
+		t.Errorf("unexpected quantity or group %+v", *actual)
+	}
+	if actual.Item.Name != "Tomatoes" || actual.Item.ItemCategory.Name != "VEGETABLES" || actual.Item.ItemCategory.Position != 1 {
+		t.Errorf("unexpected item %+v", actual.Item)
+	}
+	if actual.Unit.Name != "Piece" || actual.Unit.UnitType != enum.Piece {
+		t.Errorf("unexpected unit %+v", actual.Unit)
+	}
+
+	_, err = repo.GetByID(-1)
+	if !utils.CompareErrors(err, customErrors.NewNotFoundError("pantry", "id", nil)) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestGetPantryItemsByGroupID(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupID     int64
+		expectedIDs []int64
+	}{
+		// Flour (GRAINS AND PASTA), Eggs and Milk (DAIRY)
+		{"sorted by item category position then item name", 1, []int64{1, 2, 3}},
+		{"other group", 2, []int64{4}},
+		{"group with an empty pantry", 3, []int64{}},
+	}
+
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewPantryRepository(db)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByGroupID(tt.groupID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actualIDs := make([]int64, len(actual))
+			for i, pantryItem := range actual {
+				actualIDs[i] = pantryItem.ID
+			}
+
+			if !reflect.DeepEqual(actualIDs, tt.expectedIDs) {
+				t.Errorf("expected pantry items %v, got %v", tt.expectedIDs, actualIDs)
+			}
+		})
+	}
+}
+
+func TestPantryRepositoryCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pantryItem model.PantryItem
+		err        error
+	}{
+		{"valid pantry item", model.PantryItem{Quantity: 250, Item: model.Item{ID: 3}, Unit: model.Unit{ID: 2}, GroupID: 1}, nil},
+		{"stocked item in another unit", model.PantryItem{Quantity: 1, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 1}, GroupID: 1}, nil},
+		{"stocked item in the same unit", model.PantryItem{Quantity: 1, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 2}, GroupID: 1}, customErrors.NewConflictError("PantryItem", "this item is already stocked in this unit", nil)},
+		{"unknown item", model.PantryItem{Quantity: 1, Item: model.Item{ID: -1}, Unit: model.Unit{ID: 2}, GroupID: 1}, customErrors.NewNotFoundError("items, units or groups", "id", nil)},
+		{"unknown unit", model.PantryItem{Quantity: 1, Item: model.Item{ID: 3}, Unit: model.Unit{ID: -1}, GroupID: 1}, customErrors.NewNotFoundError("items, units or groups", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewPantryRepository(db)
+
+			id, err := repo.Create(&tt.pantryItem)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(id)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if actual.Quantity != tt.pantryItem.Quantity || actual.Item.ID != tt.pantryItem.Item.ID ||
+				actual.Unit.ID != tt.pantryItem.Unit.ID || actual.GroupID != tt.pantryItem.GroupID {
+				t.Errorf("expected %+v, got %+v", tt.pantryItem, *actual)
+			}
+		})
+	}
+}
+
+func TestPantryRepositoryUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pantryItem model.PantryItem
+		err        error
+	}{
+		{"quantity", model.PantryItem{ID: 1, Quantity: 750, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 2}}, nil},
+		{"item, unit and quantity", model.PantryItem{ID: 1, Quantity: 1, Item: model.Item{ID: 2}, Unit: model.Unit{ID: 1}}, nil},
+		{"item already stocked in the unit", model.PantryItem{ID: 1, Quantity: 2, Item: model.Item{ID: 4}, Unit: model.Unit{ID: 8}}, customErrors.NewConflictError("PantryItem", "this item is already stocked in this unit", nil)},
+		{"unknown unit", model.PantryItem{ID: 1, Quantity: 2, Item: model.Item{ID: 1}, Unit: model.Unit{ID: -1}}, customErrors.NewNotFoundError("items, units or groups", "id", nil)},
+		{"unknown pantry item", model.PantryItem{ID: -1, Quantity: 2, Item: model.Item{ID: 1}, Unit: model.Unit{ID: 1}}, customErrors.NewNotFoundError("pantry", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewPantryRepository(db)
+
+			err := repo.Update(&tt.pantryItem)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(tt.pantryItem.ID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if actual.Quantity != tt.pantryItem.Quantity || actual.Item.ID != tt.pantryItem.Item.ID ||
+				actual.Unit.ID != tt.pantryItem.Unit.ID || actual.GroupID != 1 {
+				t.Errorf("expected %+v, got %+v", tt.pantryItem, *actual)
+			}
+		})
+	}
+}
+
+func TestPantryRepositoryDeductForDish(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewPantryRepository(db)
+	dishRepo := NewDishRepository(db)
+
+	// The flour was restocked since the pantry was read: quantities are deducted from the current stock.
+	if _, err := db.Exec("UPDATE pantry SET quantity = 1000 WHERE id = 1"); err != nil {
+		t.Fatalf("failed to restock flour: %v", err)
+	}
+
+	err := repo.DeductForDish(context.Background(), 1, []model.PantryItem{
+		{ID: 1, Quantity: 300},
+		// More eggs than left in the pantry
+		{ID: 2, Quantity: 10},
+		// A line removed in the meantime
+		{ID: -1, Quantity: 1},
+	})
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	flour, err := repo.GetByID(1)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if flour.Quantity != 700 {
+		t.Errorf("expected 700 g of flour left, got %v", flour.Quantity)
+	}
+
+	if _, err := repo.GetByID(2); !utils.CompareErrors(err, customErrors.NewNotFoundError("pantry", "id", nil)) {
+		t.Errorf("expected the exhausted pantry item to be removed, got %v", err)
+	}
+
+	dish, err := dishRepo.GetByID(1)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if !dish.Cooked {
+		t.Error("expected the dish to be flagged as cooked")
+	}
+
+	// Nothing is written when the dish was already cooked, e.g. by a concurrent call.
+	err = repo.DeductForDish(context.Background(), 1, []model.PantryItem{{ID: 1, Quantity: 300}})
+	if !utils.CompareErrors(err, customErrors.NewConflictError("Dish", "dish is already cooked", nil)) {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+
+	flour, err = repo.GetByID(1)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if flour.Quantity != 700 {
+		t.Errorf("expected the ingredients of a cooked dish not to be deducted twice, got %v g of flour", flour.Quantity)
+	}
+}
+
+func TestPantryRepositoryDelete(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewPantryRepository(db)
+
+	if err := repo.Delete(1); err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	if _, err := repo.GetByID(1); !utils.CompareErrors(err, customErrors.NewNotFoundError("pantry", "id", nil)) {
+		t.Errorf("expected pantry item to be deleted, got %v", err)
+	}
+
+	if err := repo.Delete(1); !utils.CompareErrors(err, customErrors.NewNotFoundError("pantry", "id", nil)) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
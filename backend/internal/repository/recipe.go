@@ -14,12 +14,17 @@ import (
 )
 
 type RecipeRepositoryInterface interface {
-	GetByID(id int64) (model.Recipe, error)
-	GetByGroupID(groupID int64) ([]model.Recipe, error)
+	GetByID(id int64) (*model.Recipe, error)
+	GetByName(name string, descending bool) ([]model.Recipe, error)
+	SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.Recipe, error)
+	GetByGroupID(groupID int64, descending bool) ([]model.Recipe, error)
 	GetByItemID(itemID int64, descending bool) ([]model.Recipe, error)
-	Create(recipe *model.Recipe) (int64, error)
-	Update(recipe *model.Recipe) error
-	Delete(id int64) error
+	GetByGroupIDAndItemIDs(groupID int64, itemIDs []int64) ([]model.Recipe, error)
+	GetByCategoryID(categoryID int64, descending bool) ([]model.Recipe, error)
+	GetRecipeGroupID(id int64) (int64, error)
+	Create(ctx context.Context, recipe *model.Recipe) (int64, error)
+	Update(ctx context.Context, recipe *model.Recipe) error
+	Delete(ctx context.Context, id int64) error
 }
 
 type RecipeRepository struct {
@@ -59,6 +64,21 @@ func (r *RecipeRepository) GetByName(name string, descending bool) ([]model.Reci
 	return recipes, nil
 }
 
+// SearchByNameAndGroupID retrieves the recipes of a group whose name contains the provided one.
+func (r *RecipeRepository) SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.Recipe, error) {
+	clauses := "WHERE recipes.name LIKE concat('%', ?, '%') AND recipes.group_id = ? ORDER BY recipes.name"
+	if descending {
+		clauses += " DESC"
+	}
+
+	recipes, err := r.fetchRecipes(clauses, name, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
+
 func (r *RecipeRepository) GetByGroupID(groupID int64, descending bool) ([]model.Recipe, error) {
 	clauses := "WHERE recipes.group_id = ? ORDER BY recipes.name"
 	if descending {
@@ -87,6 +107,38 @@ func (r *RecipeRepository) GetByItemID(itemID int64, descending bool) ([]model.R
 	return recipes, nil
 }
 
+// GetByGroupIDAndItemIDs retrieves the recipes of a group using at least one of the provided items, sorted by name.
+func (r *RecipeRepository) GetByGroupIDAndItemIDs(groupID int64, itemIDs []int64) ([]model.Recipe, error) {
+	if len(itemIDs) == 0 {
+		return []model.Recipe{}, nil
+	}
+
+	clauses := "WHERE recipes.group_id = ? AND recipes.id IN (SELECT DISTINCT recipe_id FROM ingredients WHERE item_id IN (" +
+		strings.Join(slices.Repeat([]string{"?"}, len(itemIDs)), ", ") + ")) ORDER BY recipes.name"
+
+	values := []any{groupID}
+	for _, itemID := range itemIDs {
+		values = append(values, itemID)
+	}
+
+	return r.fetchRecipes(clauses, values...)
+}
+
+// GetByCategoryID retrieves the recipes attached to a recipe category, sorted by name.
+func (r *RecipeRepository) GetByCategoryID(categoryID int64, descending bool) ([]model.Recipe, error) {
+	clauses := "WHERE recipes.id IN (SELECT recipe_id FROM recipes_categories_junction WHERE category_id = ?) ORDER BY recipes.name"
+	if descending {
+		clauses += " DESC"
+	}
+
+	recipes, err := r.fetchRecipes(clauses, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
+
 func (r *RecipeRepository) GetRecipeGroupID(id int64) (int64, error) {
 	row := r.db.QueryRow("SELECT group_id from recipes WHERE id = ?", id)
 	var groupID int64
@@ -100,65 +152,31 @@ func (r *RecipeRepository) GetRecipeGroupID(id int64) (int64, error) {
 	return groupID, nil
 }
 
-func (r *RecipeRepository) Create(ctx context.Context, recipe *model.Recipe, testHook func()) (int64, error) {
-	tx, _ := r.db.BeginTx(ctx, nil)
-	defer tx.Rollback()
-
-	res, err := tx.ExecContext(ctx,
-		`INSERT INTO recipes(
-			name,
-			description,
-			image_url,
-			original_link,
-			preparation_time_min,
-			cooking_time_min,
-			servings,
-			instructions,
-			created_at,
-			public,
-			comment,
-			group_id
-		)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`,
-		recipe.Name,
-		recipe.Description,
-		recipe.ImageURL,
-		recipe.OriginalLink,
-		recipe.PreparationTimeMin,
-		recipe.CookingTimeMin,
-		recipe.Servings,
-		recipe.Instructions,
-		recipe.CreatedAt,
-		recipe.Public,
-		recipe.Comment,
-		recipe.GroupID,
-	)
-	if err != nil {
-		return 0, customErrors.NewInternalError("Failed to create recipe", err)
-	}
-
-	recipe.ID, err = res.LastInsertId()
+func (r *RecipeRepository) Create(ctx context.Context, recipe *model.Recipe) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return 0, customErrors.NewInternalError("Failed to retrieve recipe ID", err)
+		return 0, customErrors.NewInternalError("failed to begin transaction", err)
 	}
+	defer tx.Rollback()
 
-	if err = r.updateRecipesCategoriesJunction(ctx, tx, recipe); err != nil {
+	if err = r.create(ctx, tx, recipe); err != nil {
 		return 0, err
 	}
 
-	if err = r.updateIngredients(ctx, tx, recipe); err != nil {
-		return 0, err
+	if err = tx.Commit(); err != nil {
+		return 0, customErrors.NewInternalError("failed to commit transaction", err)
 	}
-
-	tx.Commit()
 	return recipe.ID, nil
 }
 
 func (r *RecipeRepository) Update(ctx context.Context, recipe *model.Recipe) error {
-	tx, _ := r.db.BeginTx(ctx, nil)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
 	defer tx.Rollback()
 
-	tx.ExecContext(
+	res, err := tx.ExecContext(
 		ctx,
 		`UPDATE recipes
 		SET
@@ -187,6 +205,17 @@ func (r *RecipeRepository) Update(ctx context.Context, recipe *model.Recipe) err
 		recipe.Comment,
 		recipe.ID,
 	)
+	if err != nil {
+		return customErrors.NewInternalError("Failed to update recipe", err)
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if recipe was updated", err)
+	}
+	if updatedRows == 0 {
+		return customErrors.NewNotFoundError("recipes", "id", nil)
+	}
 
 	if err := r.updateRecipesCategoriesJunction(ctx, tx, recipe); err != nil {
 		return err
@@ -195,12 +224,17 @@ func (r *RecipeRepository) Update(ctx context.Context, recipe *model.Recipe) err
 		return err
 	}
 
-	tx.Commit()
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
 	return nil
 }
 
 func (r *RecipeRepository) Delete(ctx context.Context, id int64) error {
-	tx, _ := r.db.BeginTx(ctx, nil)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
 	defer tx.Rollback()
 
 	deleteFunc := func(tableName, columnName string) error {
@@ -225,11 +259,60 @@ func (r *RecipeRepository) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
-	tx.Commit()
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
 	return nil
 }
 
 /*** HELPER FUNCTIONS ***/
+// create inserts a recipe with its categories and ingredients within the provided transaction, setting its ID.
+func (r *RecipeRepository) create(ctx context.Context, tx *sql.Tx, recipe *model.Recipe) error {
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO recipes(
+			name,
+			description,
+			image_url,
+			original_link,
+			preparation_time_min,
+			cooking_time_min,
+			servings,
+			instructions,
+			created_at,
+			public,
+			comment,
+			group_id
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`,
+		recipe.Name,
+		recipe.Description,
+		recipe.ImageURL,
+		recipe.OriginalLink,
+		recipe.PreparationTimeMin,
+		recipe.CookingTimeMin,
+		recipe.Servings,
+		recipe.Instructions,
+		recipe.CreatedAt,
+		recipe.Public,
+		recipe.Comment,
+		recipe.GroupID,
+	)
+	if err != nil {
+		return customErrors.NewInternalError("Failed to create recipe", err)
+	}
+
+	recipe.ID, err = res.LastInsertId()
+	if err != nil {
+		return customErrors.NewInternalError("Failed to retrieve recipe ID", err)
+	}
+
+	if err = r.updateRecipesCategoriesJunction(ctx, tx, recipe); err != nil {
+		return err
+	}
+
+	return r.updateIngredients(ctx, tx, recipe)
+}
+
 func (r *RecipeRepository) deleteByColumn(ctx context.Context, tx *sql.Tx, tableName, columnName string, id int64) error {
 	res, err := tx.ExecContext(
 		ctx,
@@ -263,7 +346,7 @@ func (r *RecipeRepository) fetchRecipes(clauses string, values ...any) ([]model.
 	recipe_categories.id, recipe_categories.name,
 	ingredients.id, ingredients.quantity,
 	items.id, items.name,
-	units.id, units.name
+	units.id, units.name, units.factor, units.unit_type
 	FROM recipes
 	LEFT JOIN recipes_categories_junction ON recipes_categories_junction.recipe_id = recipes.id
 	LEFT JOIN recipe_categories ON recipe_categories.id = recipes_categories_junction.category_id
@@ -294,8 +377,11 @@ func (r *RecipeRepository) fetchRecipes(clauses string, values ...any) ([]model.
 
 	for rows.Next() {
 		tmpRecipe := &model.Recipe{}
-		tmpCategory := &model.RecipeCategory{}
 		tmpIngredient := &model.Ingredient{}
+		// Categories and ingredients are LEFT JOINed, so their columns are NULL for recipes without any.
+		var categoryID, ingredientID, itemID, unitID sql.NullInt64
+		var categoryName, itemName, unitName, unitType sql.NullString
+		var unitFactor sql.NullFloat64
 
 		err := rows.Scan(
 			&tmpRecipe.ID,
@@ -311,14 +397,16 @@ func (r *RecipeRepository) fetchRecipes(clauses string, values ...any) ([]model.
 			&tmpRecipe.Public,
 			&tmpRecipe.Comment,
 			&tmpRecipe.GroupID,
-			&tmpCategory.ID,
-			&tmpCategory.Name,
-			&tmpIngredient.ID,
+			&categoryID,
+			&categoryName,
+			&ingredientID,
 			&tmpIngredient.Quantity,
-			&tmpIngredient.Item.ID,
-			&tmpIngredient.Item.Name,
-			&tmpIngredient.Unit.ID,
-			&tmpIngredient.Unit.Name,
+			&itemID,
+			&itemName,
+			&unitID,
+			&unitName,
+			&unitFactor,
+			&unitType,
 		)
 
 		if err != nil {
@@ -337,14 +425,20 @@ func (r *RecipeRepository) fetchRecipes(clauses string, values ...any) ([]model.
 
 		i := stateMap[id].retIndex
 
-		if !stateMap[id].seenCategories[tmpCategory.ID] {
-			ret[i].Categories = append(ret[i].Categories, *tmpCategory)
-			stateMap[id].seenCategories[tmpCategory.ID] = true
+		if categoryID.Valid && !stateMap[id].seenCategories[categoryID.Int64] {
+			ret[i].Categories = append(ret[i].Categories, model.RecipeCategory{ID: categoryID.Int64, Name: categoryName.String})
+			stateMap[id].seenCategories[categoryID.Int64] = true
 		}
 
-		if !stateMap[id].seenIngredients[tmpIngredient.ID] {
+		if ingredientID.Valid && !stateMap[id].seenIngredients[ingredientID.Int64] {
+			tmpIngredient.ID = ingredientID.Int64
+			tmpIngredient.Item = model.Item{ID: itemID.Int64, Name: itemName.String}
+			tmpIngredient.Unit = model.Unit{ID: unitID.Int64, Name: unitName.String, Factor: unitFactor.Float64}
+			if err := tmpIngredient.Unit.UnitType.Scan(unitType.String); err != nil {
+				return nil, customErrors.NewInternalError(customErrors.FETCH_RECIPES_ERROR, err)
+			}
 			ret[i].Ingredients = append(ret[i].Ingredients, *tmpIngredient)
-			stateMap[id].seenIngredients[tmpIngredient.ID] = true
+			stateMap[id].seenIngredients[ingredientID.Int64] = true
 		}
 	}
 
@@ -356,6 +450,14 @@ func (r *RecipeRepository) fetchRecipes(clauses string, values ...any) ([]model.
 }
 
 func (r *RecipeRepository) updateRecipesCategoriesJunction(ctx context.Context, tx *sql.Tx, recipe *model.Recipe) error {
+	// An empty VALUES list is a syntax error, so a recipe without categories only has its junctions deleted.
+	if len(recipe.Categories) == 0 {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM recipes_categories_junction WHERE recipe_id = ?", recipe.ID); err != nil {
+			return customErrors.NewInternalError("failed to delete obsolete recipes_categories_junction", err)
+		}
+		return nil
+	}
+
 	query := "INSERT INTO recipes_categories_junction (recipe_id, category_id) VALUES " +
 		strings.Join(slices.Repeat([]string{"(?, ?)"}, len(recipe.Categories)), ", ") + " " +
 		`ON CONFLICT(recipe_id,category_id) DO NOTHING`
@@ -387,6 +489,14 @@ func (r *RecipeRepository) updateRecipesCategoriesJunction(ctx context.Context,
 }
 
 func (r *RecipeRepository) updateIngredients(ctx context.Context, tx *sql.Tx, recipe *model.Recipe) error {
+	// An empty VALUES list is a syntax error, so a recipe without ingredients only has its ingredients deleted.
+	if len(recipe.Ingredients) == 0 {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM ingredients WHERE recipe_id = ?", recipe.ID); err != nil {
+			return customErrors.NewInternalError("failed to delete obsolete ingredients", err)
+		}
+		return nil
+	}
+
 	upsertValues := make([]any, 0, len(recipe.Ingredients)*5)
 	for _, ing := range recipe.Ingredients {
 		var id any = ing.ID
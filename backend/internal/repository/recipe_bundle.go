@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type RecipeBundleRepositoryInterface interface {
+	Import(ctx context.Context, bundle *model.RecipeBundle, groupID int64) ([]int64, error)
+}
+
+type RecipeBundleRepository struct {
+	db         *sql.DB
+	recipeRepo *RecipeRepository
+}
+
+// NewRecipeBundleRepository constructs a new RecipeBundleRepository using the provided database.
+func NewRecipeBundleRepository(db *sql.DB) *RecipeBundleRepository {
+	return &RecipeBundleRepository{
+		db:         db,
+		recipeRepo: NewRecipeRepository(db),
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Import creates the recipes of a bundle in a group, in a single transaction, and returns their IDs.
+// The units, item categories, items and recipe categories of the bundle are matched by name, regardless of their case,
+// to the ones of the group, units possibly coming from the catalogue; only the missing ones are created.
+// An InvalidParamsError is returned if a matched unit has another factor or unit type, or a matched item another unit type,
+// than in the bundle.
+// Items without item category are put in the "Uncategorized" category of the group.
+// Recipes are created as RecipeRepository.Create does; a NotFoundError is returned if they reference a name missing from the bundle.
+func (r *RecipeBundleRepository) Import(ctx context.Context, bundle *model.RecipeBundle, groupID int64) ([]int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	unitIDs := make(map[string]int64)
+	for _, unit := range bundle.Units {
+		factor, unitType := unit.Factor, unit.UnitType
+		unitIDs[strings.ToLower(unit.Name)], err = findOrInsert(ctx, tx,
+			`SELECT id, factor, unit_type FROM units WHERE (group_id IS NULL OR group_id = ?) AND name = ? COLLATE NOCASE
+			ORDER BY group_id IS NULL LIMIT 1`,
+			[]any{groupID, unit.Name}, []any{&factor, &unitType},
+			"INSERT INTO units (name, factor, unit_type, group_id) VALUES (?, ?, ?, ?)",
+			unit.Name, unit.Factor, unit.UnitType, groupID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if factor != unit.Factor || unitType != unit.UnitType {
+			return nil, customErrors.NewInvalidParamsError([]string{"units"}, nil)
+		}
+	}
+
+	itemCategoryIDs := make(map[string]int64)
+	for _, name := range append([]string{constant.UNCATEGORIZED_ITEM_CATEGORY}, categoryNames(bundle.ItemCategories)...) {
+		itemCategoryIDs[strings.ToLower(name)], err = findOrInsert(ctx, tx,
+			"SELECT id FROM item_categories WHERE group_id = ? AND name = ? COLLATE NOCASE",
+			[]any{groupID, name}, nil,
+			`INSERT INTO item_categories (name, group_id, position)
+			SELECT ?1, ?2, COALESCE(MAX(position) + 1, 0) FROM item_categories WHERE group_id = ?2`,
+			name, groupID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	itemIDs := make(map[string]int64)
+	for _, item := range bundle.Items {
+		categoryName := item.ItemCategory.Name
+		if categoryName == "" {
+			categoryName = constant.UNCATEGORIZED_ITEM_CATEGORY
+		}
+		categoryID, ok := itemCategoryIDs[strings.ToLower(categoryName)]
+		if !ok {
+			return nil, customErrors.NewNotFoundError("item_categories", "name", nil)
+		}
+
+		unitType := item.UnitType
+		itemIDs[strings.ToLower(item.Name)], err = findOrInsert(ctx, tx,
+			"SELECT id, unit_type FROM items WHERE group_id = ? AND name = ? COLLATE NOCASE ORDER BY id LIMIT 1",
+			[]any{groupID, item.Name}, []any{&unitType},
+			`INSERT INTO items (name, description, average_market_price, unit_type, item_category_id, group_id)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			item.Name, item.Description, item.AverageMarketPrice, item.UnitType, categoryID, groupID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if unitType != item.UnitType {
+			return nil, customErrors.NewInvalidParamsError([]string{"items"}, nil)
+		}
+	}
+
+	recipeCategoryIDs := make(map[string]int64)
+	for _, category := range bundle.RecipeCategories {
+		recipeCategoryIDs[strings.ToLower(category.Name)], err = findOrInsert(ctx, tx,
+			"SELECT id FROM recipe_categories WHERE group_id = ? AND name = ? COLLATE NOCASE",
+			[]any{groupID, category.Name}, nil,
+			"INSERT INTO recipe_categories (name, group_id) VALUES (?, ?)",
+			category.Name, groupID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]int64, 0, len(bundle.Recipes))
+	for _, bundleRecipe := range bundle.Recipes {
+		recipe := bundleRecipe
+		recipe.ID = 0
+		recipe.GroupID = groupID
+		recipe.Categories = make([]model.RecipeCategory, len(bundleRecipe.Categories))
+		recipe.Ingredients = make([]model.Ingredient, len(bundleRecipe.Ingredients))
+
+		for i, category := range bundleRecipe.Categories {
+			id, ok := recipeCategoryIDs[strings.ToLower(category.Name)]
+			if !ok {
+				return nil, customErrors.NewNotFoundError("recipe_categories", "name", nil)
+			}
+			recipe.Categories[i] = model.RecipeCategory{ID: id}
+		}
+
+		for i, ing := range bundleRecipe.Ingredients {
+			itemID, ok := itemIDs[strings.ToLower(ing.Item.Name)]
+			if !ok {
+				return nil, customErrors.NewNotFoundError("items", "name", nil)
+			}
+			unitID, ok := unitIDs[strings.ToLower(ing.Unit.Name)]
+			if !ok {
+				return nil, customErrors.NewNotFoundError("units", "name", nil)
+			}
+			recipe.Ingredients[i] = model.Ingredient{Quantity: ing.Quantity, Item: model.Item{ID: itemID}, Unit: model.Unit{ID: unitID}}
+		}
+
+		if err := r.recipeRepo.create(ctx, tx, &recipe); err != nil {
+			return nil, err
+		}
+		ids = append(ids, recipe.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return ids, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// findOrInsert returns the ID of the row selected by query, or inserts a row with insert when there is none.
+// The columns selected after the ID are scanned into existing, which is left untouched when a row is inserted.
+func findOrInsert(ctx context.Context, tx *sql.Tx, query string, queryValues []any, existing []any, insert string, insertValues ...any) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, query, queryValues...).Scan(append([]any{&id}, existing...)...)
+	if err == nil {
+		return id, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, customErrors.NewInternalError("failed to look up recipe bundle reference", err)
+	}
+
+	res, err := tx.ExecContext(ctx, insert, insertValues...)
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to create recipe bundle reference", err)
+	}
+
+	id, err = res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve recipe bundle reference ID", err)
+	}
+
+	return id, nil
+}
+
+// categoryNames returns the names of item categories.
+func categoryNames(categories []model.ItemCategory) []string {
+	names := make([]string, len(categories))
+	for i, category := range categories {
+		names[i] = category.Name
+	}
+	return names
+}
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+func TestNewRecipeBundleRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewRecipeBundleRepository(db)
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+}
+
+func TestRecipeBundleRepositoryImport(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeBundleRepository(db)
+
+	bundle := &model.RecipeBundle{
+		Version: 1,
+		Units: []model.Unit{
+			{Name: "gram", Factor: 1, UnitType: enum.Weight},
+			{Name: "bowl", Factor: 250, UnitType: enum.Volume},
+			{Name: "Pinch", Factor: 0.5, UnitType: enum.Weight},
+		},
+		ItemCategories: []model.ItemCategory{{Name: "Aromas"}},
+		Items: []model.Item{
+			{Name: "flour", UnitType: enum.Weight, ItemCategory: model.ItemCategory{Name: "Aromas"}},
+			{Name: "Vanilla", UnitType: enum.Weight, ItemCategory: model.ItemCategory{Name: "Aromas"}},
+			{Name: "Cream", UnitType: enum.Volume},
+		},
+		RecipeCategories: []model.RecipeCategory{{Name: "dessert"}, {Name: "Pastry"}},
+		Recipes: []model.Recipe{
+			{
+				Name:       "Vanilla Cake",
+				CreatedAt:  time.Now().UTC(),
+				Categories: []model.RecipeCategory{{Name: "Dessert"}, {Name: "pastry"}},
+				Ingredients: []model.Ingredient{
+					{Quantity: new(200.0), Item: model.Item{Name: "Flour"}, Unit: model.Unit{Name: "Gram"}},
+					{Quantity: new(1.0), Item: model.Item{Name: "vanilla"}, Unit: model.Unit{Name: "pinch"}},
+					{Item: model.Item{Name: "Cream"}, Unit: model.Unit{Name: "Bowl"}},
+				},
+			},
+			{Name: "Plain Cream", CreatedAt: time.Now().UTC()},
+		},
+	}
+
+	ids, err := repo.Import(context.Background(), bundle, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("expected 2 recipes, got %d", len(ids))
+	}
+
+	recipe, err := repo.recipeRepo.GetByID(ids[0])
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+	if recipe.Name != "Vanilla Cake" || recipe.GroupID != 1 {
+		t.Errorf("unexpected recipe %+v", *recipe)
+	}
+
+	// The existing DESSERT category is reused, Pastry is created
+	if len(recipe.Categories) != 2 || recipe.Categories[0].ID != 1 || recipe.Categories[1].Name != "Pastry" {
+		t.Errorf("unexpected categories %+v", recipe.Categories)
+	}
+
+	if len(recipe.Ingredients) != 3 {
+		t.Fatalf("expected 3 ingredients, got %+v", recipe.Ingredients)
+	}
+	ingredients := make(map[string]model.Ingredient)
+	for _, ing := range recipe.Ingredients {
+		ingredients[ing.Item.Name] = ing
+	}
+
+	// The existing Flour item and the catalogue Gram unit are reused
+	if flour := ingredients["Flour"]; flour.Item.ID != 1 || flour.Unit.ID != 2 {
+		t.Errorf("unexpected flour ingredient %+v", flour)
+	}
+	unitRepo := NewUnitRepository(db)
+
+	// The custom Bowl unit of the group is reused
+	bowl, err := unitRepo.GetByID(ingredients["Cream"].Unit.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+	if bowl.Name != "Bowl" || bowl.GroupID == nil || *bowl.GroupID != 1 {
+		t.Errorf("unexpected cream unit %+v", *bowl)
+	}
+
+	// The missing Vanilla item and Pinch unit are created in the group
+	vanilla, ok := ingredients["Vanilla"]
+	if !ok {
+		t.Fatalf("expected a vanilla ingredient, got %+v", recipe.Ingredients)
+	}
+	pinch, err := unitRepo.GetByID(vanilla.Unit.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+	if pinch.Name != "Pinch" || pinch.GroupID == nil || *pinch.GroupID != 1 {
+		t.Errorf("unexpected vanilla unit %+v", *pinch)
+	}
+
+	item, err := NewItemRepository(db).GetByID(vanilla.Item.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+	if item.GroupID != 1 || item.ItemCategory.Name != "Aromas" {
+		t.Errorf("unexpected vanilla item %+v", *item)
+	}
+
+	// The created category is put after the last category of the group
+	var position int
+	if err = db.QueryRow("SELECT position FROM item_categories WHERE id = ?", item.ItemCategory.ID).Scan(&position); err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+	if position != 6 {
+		t.Errorf("expected position 6, got %d", position)
+	}
+
+	// Items without category are put in the Uncategorized category of the group
+	cream, err := NewItemRepository(db).GetByID(ingredients["Cream"].Item.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+	if cream.ItemCategory.Name != "Uncategorized" {
+		t.Errorf("unexpected cream item %+v", *cream)
+	}
+}
+
+func TestRecipeBundleRepositoryImportRollback(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeBundleRepository(db)
+
+	bundle := &model.RecipeBundle{
+		Version:        1,
+		Units:          []model.Unit{{Name: "Pinch", Factor: 0.5, UnitType: enum.Weight}},
+		ItemCategories: []model.ItemCategory{},
+		Items:          []model.Item{{Name: "Vanilla", UnitType: enum.Weight}},
+		Recipes: []model.Recipe{
+			{
+				Name:        "Vanilla Cake",
+				Ingredients: []model.Ingredient{{Item: model.Item{Name: "Vanilla"}, Unit: model.Unit{Name: "Spoon"}}},
+			},
+		},
+	}
+
+	if _, err := repo.Import(context.Background(), bundle, 1); err == nil {
+		t.Fatal("expected an error for the undefined unit, got nil")
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM units WHERE name = 'Pinch'").Scan(&count); err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+	if count != 0 {
+		t.Errorf("expected the import to be rolled back, got %d units", count)
+	}
+}
+
+func TestRecipeBundleRepositoryImportMismatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		units         []model.Unit
+		items         []model.Item
+		expectedField string
+	}{
+		{
+			name:          "unit with another factor",
+			units:         []model.Unit{{Name: "bowl", Factor: 500, UnitType: enum.Volume}},
+			items:         []model.Item{{Name: "Cream", UnitType: enum.Volume}},
+			expectedField: "units",
+		},
+		{
+			name:          "unit with another unit type",
+			units:         []model.Unit{{Name: "Bowl", Factor: 250, UnitType: enum.Weight}},
+			items:         []model.Item{{Name: "Cream", UnitType: enum.Weight}},
+			expectedField: "units",
+		},
+		{
+			name:          "catalogue unit with another factor",
+			units:         []model.Unit{{Name: "gram", Factor: 1000, UnitType: enum.Weight}},
+			items:         []model.Item{{Name: "Cream", UnitType: enum.Weight}},
+			expectedField: "units",
+		},
+		{
+			name:          "item with another unit type",
+			units:         []model.Unit{{Name: "Bowl", Factor: 250, UnitType: enum.Volume}},
+			items:         []model.Item{{Name: "flour", UnitType: enum.Volume}},
+			expectedField: "items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewRecipeBundleRepository(db)
+
+			bundle := &model.RecipeBundle{
+				Version:        1,
+				Units:          tt.units,
+				ItemCategories: []model.ItemCategory{},
+				Items:          tt.items,
+				Recipes: []model.Recipe{
+					{
+						Name:        "Cream Bowl",
+						CreatedAt:   time.Now().UTC(),
+						Ingredients: []model.Ingredient{{Item: tt.items[0], Unit: tt.units[0]}},
+					},
+				},
+			}
+
+			_, err := repo.Import(context.Background(), bundle, 1)
+			expectedErr := customErrors.NewInvalidParamsError([]string{tt.expectedField}, nil)
+			if !utils.CompareErrors(err, expectedErr) {
+				t.Fatalf("expected error '%v', got '%v'", expectedErr, err)
+			}
+
+			var count int
+			if err := db.QueryRow("SELECT COUNT(*) FROM recipes WHERE name = 'Cream Bowl'").Scan(&count); err != nil {
+				t.Fatalf("expected no error, got '%s'", err)
+			}
+			if count != 0 {
+				t.Errorf("expected no recipe to be created, got %d", count)
+			}
+		})
+	}
+}
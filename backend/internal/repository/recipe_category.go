@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/mattn/go-sqlite3"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type RecipeCategoryRepositoryInterface interface {
+	GetByID(id int64) (*model.RecipeCategory, error)
+	GetByGroupID(groupID int64, descending bool) ([]model.RecipeCategory, error)
+	GetByNameAndGroupID(name string, groupID int64) (*model.RecipeCategory, error)
+	Create(category *model.RecipeCategory) (int64, error)
+	Update(category *model.RecipeCategory) error
+	Delete(ctx context.Context, id int64, detach bool) error
+}
+
+type RecipeCategoryRepository struct {
+	db *sql.DB
+}
+
+func NewRecipeCategoryRepository(db *sql.DB) *RecipeCategoryRepository {
+	return &RecipeCategoryRepository{
+		db: db,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID retrieves a recipe category from the database by its ID, without its recipes.
+func (r *RecipeCategoryRepository) GetByID(id int64) (*model.RecipeCategory, error) {
+	recipeCategories, err := r.fetchRecipeCategories("WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recipeCategories) == 0 {
+		return nil, customErrors.NewNotFoundError("recipe_categories", "id", nil)
+	}
+
+	return &recipeCategories[0], nil
+}
+
+// GetByGroupID retrieves the recipe categories of a group, sorted by name.
+func (r *RecipeCategoryRepository) GetByGroupID(groupID int64, descending bool) ([]model.RecipeCategory, error) {
+	clauses := "WHERE group_id = ? ORDER BY name"
+	if descending {
+		clauses += " DESC"
+	}
+
+	return r.fetchRecipeCategories(clauses, groupID)
+}
+
+// GetByNameAndGroupID retrieves the recipe category of a group whose name exactly matches the provided one (case insensitive).
+func (r *RecipeCategoryRepository) GetByNameAndGroupID(name string, groupID int64) (*model.RecipeCategory, error) {
+	recipeCategories, err := r.fetchRecipeCategories("WHERE name = ? COLLATE NOCASE AND group_id = ?", name, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recipeCategories) == 0 {
+		return nil, customErrors.NewNotFoundError("recipe_categories", "name, group_id", nil)
+	}
+
+	return &recipeCategories[0], nil
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create inserts a new recipe category and returns its ID.
+func (r *RecipeCategoryRepository) Create(category *model.RecipeCategory) (int64, error) {
+	res, err := r.db.Exec(
+		"INSERT INTO recipe_categories (name, group_id) VALUES (?, ?)",
+		category.Name,
+		category.GroupID,
+	)
+	if err != nil {
+		return 0, recipeCategoryWriteError(err, "failed to create recipe category")
+	}
+
+	category.ID, err = res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve recipe category ID", err)
+	}
+
+	return category.ID, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update renames the recipe category identified by category.ID.
+func (r *RecipeCategoryRepository) Update(category *model.RecipeCategory) error {
+	res, err := r.db.Exec("UPDATE recipe_categories SET name = ? WHERE id = ?", category.Name, category.ID)
+	if err != nil {
+		return recipeCategoryWriteError(err, "failed to update recipe category")
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if recipe category was updated", err)
+	}
+	if updatedRows == 0 {
+		return customErrors.NewNotFoundError("recipe_categories", "id", nil)
+	}
+
+	return nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the recipe category identified by id.
+// If detach is true, the category is first removed from its recipes;
+// otherwise a ConflictError is returned when recipes are still attached to it.
+func (r *RecipeCategoryRepository) Delete(ctx context.Context, id int64, detach bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return customErrors.NewInternalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	if detach {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM recipes_categories_junction WHERE category_id = ?", id); err != nil {
+			return customErrors.NewInternalError("failed to detach recipes from recipe category", err)
+		}
+	} else {
+		var recipesNb int
+		row := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM recipes_categories_junction WHERE category_id = ?", id)
+		if err := row.Scan(&recipesNb); err != nil {
+			return customErrors.NewInternalError("failed to count recipes of recipe category", err)
+		}
+		if recipesNb > 0 {
+			return customErrors.NewConflictError("RecipeCategory", "can't delete recipe category used by recipes", nil)
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM recipe_categories WHERE id = ?", id)
+	if err != nil {
+		return customErrors.NewInternalError("failed to delete recipe category", err)
+	}
+
+	deletedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if recipe category was deleted", err)
+	}
+	if deletedRows == 0 {
+		return customErrors.NewNotFoundError("recipe_categories", "id", nil)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return customErrors.NewInternalError("failed to commit transaction", err)
+	}
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// fetchRecipeCategories is a helper method to retrieve multiple recipe categories based on filtering options.
+func (r *RecipeCategoryRepository) fetchRecipeCategories(clauses string, values ...any) ([]model.RecipeCategory, error) {
+	query := `SELECT
+	recipe_categories.id, recipe_categories.name, recipe_categories.group_id
+	FROM recipe_categories ` + clauses
+
+	slog.Debug("fetching recipe categories", "query", query)
+
+	rows, err := r.db.Query(query, values...)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch recipe categories", err)
+	}
+	defer rows.Close()
+
+	recipeCategories := []model.RecipeCategory{}
+
+	for rows.Next() {
+		var recipeCategory model.RecipeCategory
+		err := rows.Scan(
+			&recipeCategory.ID,
+			&recipeCategory.Name,
+			&recipeCategory.GroupID,
+		)
+
+		if err != nil {
+			return nil, customErrors.NewInternalError("failed to fetch recipe categories", err)
+		}
+
+		recipeCategories = append(recipeCategories, recipeCategory)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("failed to iterate rows", err)
+	}
+
+	return recipeCategories, nil
+}
+
+// recipeCategoryWriteError maps the constraint violations of an insert or update of a recipe category to application errors.
+func recipeCategoryWriteError(err error, msg string) error {
+	if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok {
+		switch sqlerr.ExtendedCode {
+		case sqlite3.ErrConstraintUnique:
+			return customErrors.NewConflictError("RecipeCategory", "a recipe category with this name already exists in the group", sqlerr)
+		case sqlite3.ErrConstraintForeignKey:
+			return customErrors.NewNotFoundError("groups", "id", sqlerr)
+		}
+	}
+	return customErrors.NewInternalError(msg, err)
+}
@@ -0,0 +1,296 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+func TestNewRecipeCategoryRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewRecipeCategoryRepository(db)
+
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+
+	if repo.db == nil {
+		t.Fatal("expected non-nil database connection, got nil")
+	}
+}
+
+func TestGetRecipeCategoryByID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeCategoryRepository(db)
+
+	tests := []struct {
+		name     string
+		id       int64
+		expected *model.RecipeCategory
+		err      error
+	}{
+		{"category of group 1", 2, &model.RecipeCategory{ID: 2, Name: "MAIN COURSE", GroupID: 1}, nil},
+		{"category of group 2", 7, &model.RecipeCategory{ID: 7, Name: "VEGAN", GroupID: 2}, nil},
+		{"unknown category", -1, nil, customErrors.NewNotFoundError("recipe_categories", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByID(tt.id)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("expected %+v, got %+v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetRecipeCategoriesByGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeCategoryRepository(db)
+
+	tests := []struct {
+		name        string
+		groupID     int64
+		descending  bool
+		expectedIDs []int64
+	}{
+		{"ascending", 1, false, []int64{6, 1, 2, 3}},
+		{"descending", 2, true, []int64{4, 7, 5, 8}},
+		{"group without category", 4, false, []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByGroupID(tt.groupID, tt.descending)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d categories, got %d", len(tt.expectedIDs), len(actual))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] || actual[i].GroupID != tt.groupID {
+					t.Errorf("expected category %d of group %d, got %+v", tt.expectedIDs[i], tt.groupID, actual[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetRecipeCategoryByNameAndGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeCategoryRepository(db)
+
+	tests := []struct {
+		name       string
+		search     string
+		groupID    int64
+		expectedID int64
+		err        error
+	}{
+		{"exact name", "DESSERT", 1, 1, nil},
+		{"case insensitive name", "Main Course", 1, 2, nil},
+		{"category of another group", "VEGAN", 1, 0, customErrors.NewNotFoundError("recipe_categories", "name, group_id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByNameAndGroupID(tt.search, tt.groupID)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if actual.ID != tt.expectedID {
+				t.Errorf("expected category %d, got %d", tt.expectedID, actual.ID)
+			}
+		})
+	}
+}
+
+func TestRecipeCategoryRepositoryCreate(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeCategoryRepository(db)
+
+	tests := []struct {
+		name     string
+		category model.RecipeCategory
+		err      error
+	}{
+		{"new category", model.RecipeCategory{Name: "APPETIZER", GroupID: 1}, nil},
+		{"name used by another group", model.RecipeCategory{Name: "VEGAN", GroupID: 1}, nil},
+		{
+			"name already used in the group",
+			model.RecipeCategory{Name: "dessert", GroupID: 1},
+			customErrors.NewConflictError("RecipeCategory", "a recipe category with this name already exists in the group", nil),
+		},
+		{"unknown group", model.RecipeCategory{Name: "APPETIZER", GroupID: -1}, customErrors.NewNotFoundError("groups", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := repo.Create(&tt.category)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(id)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(*actual, tt.category) {
+				t.Errorf("expected %+v, got %+v", tt.category, *actual)
+			}
+		})
+	}
+}
+
+func TestRecipeCategoryRepositoryUpdate(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeCategoryRepository(db)
+
+	tests := []struct {
+		name     string
+		category model.RecipeCategory
+		err      error
+	}{
+		{"rename", model.RecipeCategory{ID: 1, Name: "SWEETS", GroupID: 1}, nil},
+		{"change the case of the name", model.RecipeCategory{ID: 2, Name: "Main Course", GroupID: 1}, nil},
+		{
+			"name already used in the group",
+			model.RecipeCategory{ID: 3, Name: "BREAKFAST", GroupID: 1},
+			customErrors.NewConflictError("RecipeCategory", "a recipe category with this name already exists in the group", nil),
+		},
+		{"unknown category", model.RecipeCategory{ID: -1, Name: "SWEETS"}, customErrors.NewNotFoundError("recipe_categories", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.Update(&tt.category)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(tt.category.ID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(*actual, tt.category) {
+				t.Errorf("expected %+v, got %+v", tt.category, *actual)
+			}
+		})
+	}
+}
+
+func TestRecipeCategoryRepositoryDelete(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     int64
+		detach bool
+		err    error
+	}{
+		{"unused category", 6, false, nil},
+		{"used category", 4, false, customErrors.NewConflictError("RecipeCategory", "can't delete recipe category used by recipes", nil)},
+		{"used category detached from its recipes", 4, true, nil},
+		{"unknown category", -1, true, customErrors.NewNotFoundError("recipe_categories", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewRecipeCategoryRepository(db)
+
+			err := repo.Delete(t.Context(), tt.id, tt.detach)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+
+				// A refused deletion leaves the category and its recipes untouched.
+				var count int
+				if err := db.QueryRow("SELECT COUNT(*) FROM recipes_categories_junction WHERE category_id = ?", tt.id).Scan(&count); err != nil {
+					t.Fatalf("failed to count junctions: %v", err)
+				}
+				if tt.id == 4 && count != 2 {
+					t.Errorf("expected 2 recipes still attached, got %d", count)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if _, err := repo.GetByID(tt.id); !utils.CompareErrors(err, customErrors.NewNotFoundError("recipe_categories", "id", nil)) {
+				t.Fatalf("recipe category %d should have been deleted", tt.id)
+			}
+
+			var count int
+			if err := db.QueryRow("SELECT COUNT(*) FROM recipes_categories_junction WHERE category_id = ?", tt.id).Scan(&count); err != nil {
+				t.Fatalf("failed to count junctions: %v", err)
+			}
+			if count != 0 {
+				t.Errorf("expected no recipe attached, got %d", count)
+			}
+
+			// Detached recipes are kept.
+			if err := db.QueryRow("SELECT COUNT(*) FROM recipes").Scan(&count); err != nil {
+				t.Fatalf("failed to count recipes: %v", err)
+			}
+			if count != 4 {
+				t.Errorf("expected 4 recipes, got %d", count)
+			}
+		})
+	}
+}
@@ -11,16 +11,17 @@ import (
 
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
 	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
 )
 
 var (
 	testUnit = map[int64]model.Unit{
-		1:  {ID: 1, Name: "Kilogram"},
-		2:  {ID: 2, Name: "Gram"},
-		7:  {ID: 7, Name: "Teaspoon"},
-		8:  {ID: 8, Name: "Piece"},
-		11: {ID: 11, Name: "Undefined"},
+		1:  {ID: 1, Name: "Kilogram", Factor: 1000, UnitType: enum.Weight},
+		2:  {ID: 2, Name: "Gram", Factor: 1, UnitType: enum.Weight},
+		7:  {ID: 7, Name: "Teaspoon", Factor: 5, UnitType: enum.Volume},
+		8:  {ID: 8, Name: "Piece", Factor: 1, UnitType: enum.Piece},
+		11: {ID: 11, Name: "Undefined", Factor: 1, UnitType: enum.Undefined},
 	}
 
 	testRecipes = []model.Recipe{
@@ -245,6 +246,67 @@ func TestGetByName(t *testing.T) {
 	}
 }
 
+func TestSearchByNameAndGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeRepository(db)
+
+	tests := []struct {
+		name       string
+		search     string
+		groupID    int64
+		descending bool
+		expected   []model.Recipe
+	}{
+		{
+			"ascending-sorted search",
+			"c",
+			1,
+			false,
+			[]model.Recipe{
+				testRecipes[1], testRecipes[0], testRecipes[3],
+			},
+		},
+		{
+			"descending-sorted search",
+			"ICK",
+			1,
+			true,
+			[]model.Recipe{
+				testRecipes[3], testRecipes[0],
+			},
+		},
+		{
+			"recipes of other groups are ignored",
+			"soup",
+			1,
+			false,
+			[]model.Recipe{},
+		},
+		{
+			"unknown group",
+			"ch",
+			-1,
+			false,
+			[]model.Recipe{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.SearchByNameAndGroupID(tt.search, tt.groupID, tt.descending)
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !areRecipeSlicesEqual(actual, tt.expected) {
+				t.Fatal("recipes should be equal")
+			}
+		})
+	}
+}
+
 func TestGetByGroupID(t *testing.T) {
 	db := utils.SetUpTestDB(t)
 	defer db.Close()
@@ -381,6 +443,68 @@ func TestGetByItemID(t *testing.T) {
 	}
 }
 
+func TestGetByGroupIDAndItemIDs(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeRepository(db)
+
+	tests := []struct {
+		name     string
+		groupID  int64
+		itemIDs  []int64
+		expected []model.Recipe
+	}{
+		{"items in several recipes of the group", 1, []int64{3, 8}, []model.Recipe{testRecipes[0], testRecipes[3]}},
+		{"item shared with another group", 2, []int64{10}, []model.Recipe{testRecipes[2]}},
+		{"items in no recipe", 1, []int64{5, 11}, []model.Recipe{}},
+		{"no item", 1, []int64{}, []model.Recipe{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByGroupIDAndItemIDs(tt.groupID, tt.itemIDs)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !areRecipeSlicesEqual(actual, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetByCategoryID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeRepository(db)
+
+	tests := []struct {
+		name       string
+		categoryID int64
+		descending bool
+		expected   []model.Recipe
+	}{
+		{"category with several recipes", 4, false, []model.Recipe{testRecipes[1], testRecipes[2]}},
+		{"category with several recipes descending", 4, true, []model.Recipe{testRecipes[2], testRecipes[1]}},
+		{"category without recipe", 6, false, []model.Recipe{}},
+		{"unknown category", -1, false, []model.Recipe{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByCategoryID(tt.categoryID, tt.descending)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !areRecipeSlicesEqual(actual, tt.expected) {
+				t.Fatal("recipes should be equal")
+			}
+		})
+	}
+}
+
 func TestGetRecipeGroupID(t *testing.T) {
 	db := utils.SetUpTestDB(t)
 	defer db.Close()
@@ -466,18 +590,18 @@ func TestRecipeRepositoryCreate(t *testing.T) {
 				Quantity: new(3.0),
 				RecipeID: *recipeID,
 				Item:     model.Item{ID: 1, Name: "Flour"},
-				Unit:     model.Unit{ID: 1, Name: "Kilogram"},
+				Unit:     testUnit[1],
 			},
 			{
 				Quantity: new(3.0),
 				RecipeID: *recipeID,
 				Item:     model.Item{ID: 2, Name: "Sugar"},
-				Unit:     model.Unit{ID: 2, Name: "Gram"},
+				Unit:     testUnit[2],
 			},
 		},
 	}
 
-	id, err := repo.Create(context.Background(), newRecipe, nil)
+	id, err := repo.Create(context.Background(), newRecipe)
 	if err != nil {
 		t.Fatalf("expected no error, got '%s'", err)
 	}
@@ -502,6 +626,34 @@ func TestRecipeRepositoryCreate(t *testing.T) {
 	}
 }
 
+func TestRecipeRepositoryCreateWithoutCategoriesNorIngredients(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeRepository(db)
+
+	newRecipe := &model.Recipe{
+		Name:      "empty",
+		CreatedAt: time.Now().UTC(),
+		GroupID:   4,
+	}
+
+	id, err := repo.Create(context.Background(), newRecipe)
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+
+	actual, err := repo.GetByID(id)
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+
+	if !reflect.DeepEqual(actual, newRecipe) {
+		actualJson, _ := json.MarshalIndent(actual, "", "  ")
+		expectedJson, _ := json.MarshalIndent(newRecipe, "", "  ")
+		t.Fatalf("recipes should be equal: %s vs %s", actualJson, expectedJson)
+	}
+}
+
 func TestRecipeRepositoryUpdate(t *testing.T) {
 	db := utils.SetUpTestDB(t)
 	defer db.Close()
@@ -562,6 +714,43 @@ func TestRecipeRepositoryUpdate(t *testing.T) {
 	}
 }
 
+func TestRecipeRepositoryUpdateRemovingCategoriesAndIngredients(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeRepository(db)
+
+	expected := testRecipes[0]
+	expected.Categories = nil
+	expected.Ingredients = nil
+
+	if err := repo.Update(context.Background(), &expected); err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+
+	actual, err := repo.GetByID(expected.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got '%s'", err)
+	}
+
+	if len(actual.Categories) != 0 || len(actual.Ingredients) != 0 {
+		t.Fatalf("expected no category nor ingredient, got %d and %d", len(actual.Categories), len(actual.Ingredients))
+	}
+}
+
+func TestRecipeRepositoryUpdateNotFound(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewRecipeRepository(db)
+
+	recipe := testRecipes[0]
+	recipe.ID = -1
+
+	err := repo.Update(context.Background(), &recipe)
+	if !utils.CompareErrors(err, customErrors.NewNotFoundError("recipes", "id", nil)) {
+		t.Fatalf("expected not found error, got '%v'", err)
+	}
+}
+
 func TestRecipeRepositoryDelete(t *testing.T) {
 	db := utils.SetUpTestDB(t)
 	defer db.Close()
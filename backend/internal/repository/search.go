@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"database/sql"
+	"log/slog"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type SearchRepositoryInterface interface {
+	Search(groupID int64, match string, limit int) ([]model.SearchHit, error)
+}
+
+type SearchRepository struct {
+	db *sql.DB
+}
+
+func NewSearchRepository(db *sql.DB) *SearchRepository {
+	return &SearchRepository{
+		db: db,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// Search retrieves the recipes and items of a group matching the FTS5 query match, from the most to the least relevant,
+// with their name and an excerpt of their best matching text, the matching terms being highlighted.
+// Names weigh more than descriptions, which weigh more than the instructions and comments of the recipes.
+func (r *SearchRepository) Search(groupID int64, match string, limit int) ([]model.SearchHit, error) {
+	query := `SELECT kind, id, name, snippet, rank FROM (
+		SELECT 'recipe' AS kind, recipes.id AS id,
+		highlight(recipes_fts, 0, :start, :end) AS name,
+		snippet(recipes_fts, -1, :start, :end, '…', 16) AS snippet,
+		bm25(recipes_fts, 10.0, 4.0, 1.0, 1.0) AS rank
+		FROM recipes_fts
+		JOIN recipes ON recipes.id = recipes_fts.rowid
+		WHERE recipes_fts MATCH :match AND recipes.group_id = :group_id
+		UNION ALL
+		SELECT 'item', items.id,
+		highlight(items_fts, 0, :start, :end),
+		snippet(items_fts, -1, :start, :end, '…', 16),
+		bm25(items_fts, 10.0, 4.0)
+		FROM items_fts
+		JOIN items ON items.id = items_fts.rowid
+		WHERE items_fts MATCH :match AND items.group_id = :group_id
+	)
+	ORDER BY rank, kind, id
+	LIMIT :limit`
+
+	slog.Debug("searching recipes and items", "query", query, "match", match)
+
+	rows, err := r.db.Query(query,
+		sql.Named("start", model.HighlightStart),
+		sql.Named("end", model.HighlightEnd),
+		sql.Named("match", match),
+		sql.Named("group_id", groupID),
+		sql.Named("limit", limit),
+	)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to search recipes and items", err)
+	}
+	defer rows.Close()
+
+	hits := []model.SearchHit{}
+
+	for rows.Next() {
+		var hit model.SearchHit
+		if err := rows.Scan(&hit.Kind, &hit.ID, &hit.Name, &hit.Snippet, &hit.Rank); err != nil {
+			return nil, customErrors.NewInternalError("failed to search recipes and items", err)
+		}
+
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("failed to iterate rows", err)
+	}
+
+	return hits, nil
+}
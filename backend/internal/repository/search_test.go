@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+func TestNewSearchRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewSearchRepository(db)
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name         string
+		groupID      int64
+		match        string
+		limit        int
+		expectedHits []model.SearchHit
+	}{
+		{
+			name:    "recipe name",
+			groupID: 1,
+			match:   `"chicken"*`,
+			limit:   20,
+			expectedHits: []model.SearchHit{
+				{Kind: model.SearchHitRecipe, ID: 1, Name: "Grilled \x02Chicken\x03"},
+			},
+		},
+		{
+			name:    "recipes and items of the group",
+			groupID: 2,
+			match:   `"tomato"*`,
+			limit:   20,
+			expectedHits: []model.SearchHit{
+				{Kind: model.SearchHitItem, ID: 8, Name: "\x02Tomatoes\x03"},
+				{Kind: model.SearchHitRecipe, ID: 3, Name: "\x02Tomato\x03 Soup"},
+			},
+		},
+		{
+			name:    "limited hits",
+			groupID: 2,
+			match:   `"tomato"*`,
+			limit:   1,
+			expectedHits: []model.SearchHit{
+				{Kind: model.SearchHitItem, ID: 8, Name: "\x02Tomatoes\x03"},
+			},
+		},
+		{
+			name:    "recipe instructions",
+			groupID: 1,
+			match:   `"grill"*`,
+			limit:   20,
+			expectedHits: []model.SearchHit{
+				{Kind: model.SearchHitRecipe, ID: 1, Name: "\x02Grilled\x03 Chicken"},
+			},
+		},
+		{
+			name:         "no hit in the group",
+			groupID:      1,
+			match:        `"tomato"*`,
+			limit:        20,
+			expectedHits: []model.SearchHit{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewSearchRepository(db)
+
+			actual, err := repo.Search(tt.groupID, tt.match, tt.limit)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if len(actual) != len(tt.expectedHits) {
+				t.Fatalf("expected %d hits, got %+v", len(tt.expectedHits), actual)
+			}
+			for i := range actual {
+				expected := tt.expectedHits[i]
+				if actual[i].Kind != expected.Kind || actual[i].ID != expected.ID || actual[i].Name != expected.Name {
+					t.Errorf("expected hit %+v, got %+v", expected, actual[i])
+				}
+				if !strings.Contains(actual[i].Snippet, model.HighlightStart) {
+					t.Errorf("expected a highlighted snippet, got %q", actual[i].Snippet)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchIndexSync(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewSearchRepository(db)
+	recipeRepo := NewRecipeRepository(db)
+
+	recipe, err := recipeRepo.GetByID(4)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	recipe.Name = "Crunchy Salad"
+	if err := recipeRepo.Update(context.Background(), recipe); err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	hits, err := repo.Search(1, `"crunchy"*`, 20)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if len(hits) != 1 || hits[0].ID != 4 {
+		t.Errorf("expected the renamed recipe to be found, got %+v", hits)
+	}
+
+	hits, err = repo.Search(1, `"quick"*`, 20)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if len(hits) != 0 {
+		t.Errorf("expected the former name not to be found, got %+v", hits)
+	}
+
+	if err := recipeRepo.Delete(context.Background(), 4); err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+
+	hits, err = repo.Search(1, `"crunchy"*`, 20)
+	if err != nil {
+		t.Fatalf("didn't expected error, got %v", err)
+	}
+	if len(hits) != 0 {
+		t.Errorf("expected the deleted recipe not to be found, got %+v", hits)
+	}
+}
@@ -11,8 +11,11 @@ import (
 
 type SessionRepositoryInterface interface {
 	GetByID(id string) (*model.Session, error)
-	Write(s *model.Session) error
+	GetByUserID(userID int64, expiration time.Duration) ([]model.Session, error)
+	Create(s *model.Session) error
+	Update(s *model.Session) error
 	Delete(id string) error
+	DeleteByUserID(userID int64, exceptID string) error
 	CleanUp(expiration time.Duration) int64
 }
 
@@ -50,20 +53,76 @@ func (r *SessionRepository) GetByID(id string) (*model.Session, error) {
 	return s, nil
 }
 
-// Write inserts a new session or updates an existing one based on the session ID.
-func (r *SessionRepository) Write(s *model.Session) error {
+// GetByUserID retrieves the sessions of a user that have been active within the specified expiration duration,
+// the most recently active first.
+func (r *SessionRepository) GetByUserID(userID int64, expiration time.Duration) ([]model.Session, error) {
+	rows, err := r.db.Query(
+		`SELECT id, created_at, last_activity, ip_address, user_agent, user_id
+		FROM sessions
+		WHERE user_id = ? AND last_activity >= ?
+		ORDER BY last_activity DESC`,
+		userID, time.Now().Add(-expiration).UTC(),
+	)
+	if err != nil {
+		return nil, customErrors.NewInternalError("Failed to fetch user sessions", err)
+	}
+	defer rows.Close()
+
+	sessions := []model.Session{}
+
+	for rows.Next() {
+		var s model.Session
+		err := rows.Scan(
+			&s.ID,
+			&s.CreatedAt,
+			&s.LastActivity,
+			&s.IPAddress,
+			&s.UserAgent,
+			&s.UserID,
+		)
+		if err != nil {
+			return nil, customErrors.NewInternalError("Failed to fetch user sessions", err)
+		}
+
+		sessions = append(sessions, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("Failed to fetch user sessions", err)
+	}
+
+	return sessions, nil
+}
+
+// Create inserts a new session.
+func (r *SessionRepository) Create(s *model.Session) error {
 	_, err := r.db.Exec(
 		`INSERT INTO sessions (id, created_at, last_activity, ip_address, user_agent, user_id)
-		 VALUES (?, ?, ?, ?, ?, ?)
-		 ON CONFLICT(id) DO UPDATE SET
-		   user_id = excluded.user_id,
-		   last_activity = excluded.last_activity,
-		   ip_address = excluded.ip_address,
-		   user_agent = excluded.user_agent`,
+		 VALUES (?, ?, ?, ?, ?, ?)`,
 		s.ID, s.CreatedAt, s.LastActivity, s.IPAddress, s.UserAgent, s.UserID,
 	)
 	if err != nil {
-		return customErrors.NewInternalError("Failed to write in session", err)
+		return customErrors.NewInternalError("Failed to create session", err)
+	}
+	return nil
+}
+
+// Update updates an existing session based on its ID.
+//
+// NOTE: It does nothing if the session doesn't exist, so that a session deleted (e.g. revoked) while one of its requests
+// was being served isn't brought back when the request ends.
+func (r *SessionRepository) Update(s *model.Session) error {
+	_, err := r.db.Exec(
+		`UPDATE sessions SET
+		   user_id = ?,
+		   last_activity = ?,
+		   ip_address = ?,
+		   user_agent = ?
+		 WHERE id = ?`,
+		s.UserID, s.LastActivity, s.IPAddress, s.UserAgent, s.ID,
+	)
+	if err != nil {
+		return customErrors.NewInternalError("Failed to update session", err)
 	}
 	return nil
 }
@@ -79,6 +138,15 @@ func (r *SessionRepository) Delete(id string) error {
 	return nil
 }
 
+// DeleteByUserID removes every session of a user, except the one identified by exceptID, if any.
+func (r *SessionRepository) DeleteByUserID(userID int64, exceptID string) error {
+	_, err := r.db.Exec("DELETE FROM sessions WHERE user_id = ? AND id != ?", userID, exceptID)
+	if err != nil {
+		return customErrors.NewInternalError("Failed to delete user sessions", err)
+	}
+	return nil
+}
+
 // CleanUp removes sessions that have been inactive for longer than the specified expiration duration.
 // It returns the number of sessions that were removed.
 func (r *SessionRepository) CleanUp(expiration time.Duration) int64 {
@@ -220,58 +220,119 @@ func TestGetBySessionID(t *testing.T) {
 
 /*** WRITE OPERATIONS TESTS ***/
 
-func TestWriteSession(t *testing.T) {
+func TestCreateSession(t *testing.T) {
 	db := setupSessionTestDB(t)
 	defer teardownSessionTestDB(db)
 
 	repo := NewSessionRepository(db)
 
-	tests := []struct {
-		name    string
-		session *model.Session
-	}{
-		{
-			name: "insert new session",
-			session: &model.Session{
-				ID:           "new-session-id",
-				CreatedAt:    now,
-				LastActivity: now,
-				IPAddress:    "10.0.0.1",
-				UserAgent:    "Mozilla/5.0 (iPhone; CPU iPhone OS 14_0 like Mac OS X)",
-				UserID:       expectedSessions[0].UserID,
-			},
-		},
-		{
-			name: "update existing session",
-			session: &model.Session{
-				ID:           expectedSessions[0].ID,
-				CreatedAt:    expectedSessions[0].CreatedAt,
-				LastActivity: now,
-				IPAddress:    "10.0.0.2",
-				UserAgent:    "Updated User Agent",
-				UserID:       expectedSessions[1].UserID,
-			},
-		},
+	session := &model.Session{
+		ID:           "new-session-id",
+		CreatedAt:    now,
+		LastActivity: now,
+		IPAddress:    "10.0.0.1",
+		UserAgent:    "Mozilla/5.0 (iPhone; CPU iPhone OS 14_0 like Mac OS X)",
+		UserID:       expectedSessions[0].UserID,
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := repo.Write(tt.session)
+	if err := repo.Create(session); err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
 
-			if err != nil {
-				t.Fatalf("Write() unexpected error = %v", err)
-			}
+	// Verify the session was actually created
+	createdSession, err := repo.GetByID(session.ID)
+	if err != nil {
+		t.Fatalf("failed to fetch created session: %v", err)
+	}
 
-			// Verify the session was actually written
-			writtenSession, err := repo.GetByID(tt.session.ID)
-			if err != nil {
-				t.Fatalf("failed to fetch written session: %v", err)
-			}
+	if err := compareSessions(createdSession, session); err != nil {
+		t.Errorf("Actual created session does not match expected session: %v", err.Error())
+	}
 
-			if err := compareSessions(writtenSession, tt.session); err != nil {
-				t.Errorf("Actual written session does not match expected session: %v", err.Error())
-			}
-		})
+	if err := repo.Create(session); err == nil {
+		t.Error("Create() expected an error for an existing session ID")
+	}
+}
+
+func TestUpdateSession(t *testing.T) {
+	db := setupSessionTestDB(t)
+	defer teardownSessionTestDB(db)
+
+	repo := NewSessionRepository(db)
+
+	session := &model.Session{
+		ID:           expectedSessions[0].ID,
+		CreatedAt:    expectedSessions[0].CreatedAt,
+		LastActivity: now,
+		IPAddress:    "10.0.0.2",
+		UserAgent:    "Updated User Agent",
+		UserID:       expectedSessions[1].UserID,
+	}
+
+	if err := repo.Update(session); err != nil {
+		t.Fatalf("Update() unexpected error = %v", err)
+	}
+
+	// Verify the session was actually updated
+	updatedSession, err := repo.GetByID(session.ID)
+	if err != nil {
+		t.Fatalf("failed to fetch updated session: %v", err)
+	}
+
+	if err := compareSessions(updatedSession, session); err != nil {
+		t.Errorf("Actual updated session does not match expected session: %v", err.Error())
+	}
+}
+
+// TestUpdateSession_Deleted tests that updating a deleted session, e.g. revoked while one of its requests was served,
+// doesn't bring it back.
+func TestUpdateSession_Deleted(t *testing.T) {
+	db := setupSessionTestDB(t)
+	defer teardownSessionTestDB(db)
+
+	repo := NewSessionRepository(db)
+
+	session := expectedSessions[1]
+	if err := repo.Delete(session.ID); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+
+	session.LastActivity = time.Now().UTC()
+	if err := repo.Update(&session); err != nil {
+		t.Fatalf("Update() unexpected error = %v", err)
+	}
+
+	if _, err := repo.GetByID(session.ID); err == nil {
+		t.Error("Update() brought the deleted session back")
+	}
+}
+
+func TestGetSessionsByUserID(t *testing.T) {
+	db := setupSessionTestDB(t)
+	defer teardownSessionTestDB(db)
+
+	repo := NewSessionRepository(db)
+
+	// session-id-3 belongs to the same user but has been inactive for two days.
+	sessions, err := repo.GetByUserID(*expectedSessions[0].UserID, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("GetByUserID() unexpected error = %v", err)
+	}
+
+	if len(sessions) != 1 {
+		t.Fatalf("GetByUserID() returned %d sessions, want 1", len(sessions))
+	}
+	if err := compareSessions(&sessions[0], &expectedSessions[0]); err != nil {
+		t.Errorf("Actual session does not match expected session: %v", err.Error())
+	}
+
+	sessions, err = repo.GetByUserID(*expectedSessions[0].UserID, 72*time.Hour)
+	if err != nil {
+		t.Fatalf("GetByUserID() unexpected error = %v", err)
+	}
+
+	if len(sessions) != 2 || sessions[0].ID != expectedSessions[0].ID || sessions[1].ID != expectedSessions[2].ID {
+		t.Errorf("GetByUserID() should return both sessions of the user, the most recently active first, got %+v", sessions)
 	}
 }
 
@@ -314,6 +375,53 @@ func TestDeleteSession(t *testing.T) {
 	}
 }
 
+func TestDeleteSessionsByUserID(t *testing.T) {
+	tests := []struct {
+		name      string
+		exceptID  string
+		remaining []string
+		deleted   []string
+	}{
+		{
+			name:      "delete all sessions of the user",
+			exceptID:  "",
+			remaining: []string{expectedSessions[1].ID},
+			deleted:   []string{expectedSessions[0].ID, expectedSessions[2].ID},
+		},
+		{
+			name:      "keep the excepted session",
+			exceptID:  expectedSessions[0].ID,
+			remaining: []string{expectedSessions[0].ID, expectedSessions[1].ID},
+			deleted:   []string{expectedSessions[2].ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupSessionTestDB(t)
+			defer teardownSessionTestDB(db)
+
+			repo := NewSessionRepository(db)
+
+			err := repo.DeleteByUserID(*expectedSessions[0].UserID, tt.exceptID)
+			if err != nil {
+				t.Fatalf("DeleteByUserID() unexpected error = %v", err)
+			}
+
+			for _, id := range tt.remaining {
+				if _, err := repo.GetByID(id); err != nil {
+					t.Errorf("session %q should remain, got error %v", id, err)
+				}
+			}
+			for _, id := range tt.deleted {
+				if _, err := repo.GetByID(id); err == nil {
+					t.Errorf("session %q still exists after deletion", id)
+				}
+			}
+		})
+	}
+}
+
 /*** CLEANUP OPERATIONS TESTS ***/
 
 func TestCleanUp(t *testing.T) {
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/mattn/go-sqlite3"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type UnitRepositoryInterface interface {
+	GetByID(id int64) (*model.Unit, error)
+	GetByGroupID(groupID int64) ([]model.Unit, error)
+	GetByNameAndGroupID(name string, groupID int64) (*model.Unit, error)
+	Create(unit *model.Unit) (int64, error)
+	Update(unit *model.Unit) error
+	Delete(id int64) error
+}
+
+type UnitRepository struct {
+	db *sql.DB
+}
+
+func NewUnitRepository(db *sql.DB) *UnitRepository {
+	return &UnitRepository{
+		db: db,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID retrieves a unit from the database by its ID.
+func (r *UnitRepository) GetByID(id int64) (*model.Unit, error) {
+	units, err := r.fetchUnits("WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(units) == 0 {
+		return nil, customErrors.NewNotFoundError("units", "id", nil)
+	}
+
+	return &units[0], nil
+}
+
+// GetByGroupID retrieves the units available to a group: the catalogue and its custom units,
+// sorted by unit type then from the smallest to the largest.
+func (r *UnitRepository) GetByGroupID(groupID int64) ([]model.Unit, error) {
+	return r.fetchUnits("WHERE group_id IS NULL OR group_id = ? ORDER BY unit_type, factor, name", groupID)
+}
+
+// GetByNameAndGroupID retrieves the unit available to a group whose name exactly matches the provided one (case insensitive),
+// the custom units of the group taking precedence over the catalogue.
+func (r *UnitRepository) GetByNameAndGroupID(name string, groupID int64) (*model.Unit, error) {
+	units, err := r.fetchUnits("WHERE name = ? COLLATE NOCASE AND (group_id IS NULL OR group_id = ?) ORDER BY group_id IS NULL", name, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(units) == 0 {
+		return nil, customErrors.NewNotFoundError("units", "name, group_id", nil)
+	}
+
+	return &units[0], nil
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create inserts a new unit and returns its ID.
+func (r *UnitRepository) Create(unit *model.Unit) (int64, error) {
+	res, err := r.db.Exec(
+		"INSERT INTO units (name, factor, unit_type, group_id) VALUES (?, ?, ?, ?)",
+		unit.Name,
+		unit.Factor,
+		unit.UnitType,
+		unit.GroupID,
+	)
+	if err != nil {
+		return 0, unitWriteError(err, "failed to create unit")
+	}
+
+	unit.ID, err = res.LastInsertId()
+	if err != nil {
+		return 0, customErrors.NewInternalError("failed to retrieve unit ID", err)
+	}
+
+	return unit.ID, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update modifies the name, factor and unit type of the custom unit identified by unit.ID.
+// The units of the catalogue are never updated and reported as not found.
+func (r *UnitRepository) Update(unit *model.Unit) error {
+	res, err := r.db.Exec(
+		"UPDATE units SET name = ?, factor = ?, unit_type = ? WHERE id = ? AND group_id IS NOT NULL",
+		unit.Name,
+		unit.Factor,
+		unit.UnitType,
+		unit.ID,
+	)
+	if err != nil {
+		return unitWriteError(err, "failed to update unit")
+	}
+
+	updatedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if unit was updated", err)
+	}
+	if updatedRows == 0 {
+		return customErrors.NewNotFoundError("units", "id", nil)
+	}
+
+	return nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the custom unit identified by id.
+// A ConflictError is returned while ingredients, groceries or pantry items use it, and the units of the catalogue are reported as not found.
+func (r *UnitRepository) Delete(id int64) error {
+	res, err := r.db.Exec("DELETE FROM units WHERE id = ? AND group_id IS NOT NULL", id)
+	if err != nil {
+		if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok && sqlerr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+			return customErrors.NewConflictError("Unit", "can't delete unit used by ingredients, groceries or the pantry", sqlerr)
+		}
+		return customErrors.NewInternalError("failed to delete unit", err)
+	}
+
+	deletedRows, err := res.RowsAffected()
+	if err != nil {
+		return customErrors.NewInternalError("failed to check if unit was deleted", err)
+	}
+	if deletedRows == 0 {
+		return customErrors.NewNotFoundError("units", "id", nil)
+	}
+
+	return nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// fetchUnits is a helper method to retrieve multiple units based on filtering options.
+func (r *UnitRepository) fetchUnits(clauses string, values ...any) ([]model.Unit, error) {
+	query := `SELECT
+	units.id, units.name, units.factor, units.unit_type, units.group_id
+	FROM units ` + clauses
+
+	slog.Debug("fetching units", "query", query)
+
+	rows, err := r.db.Query(query, values...)
+	if err != nil {
+		return nil, customErrors.NewInternalError("failed to fetch units", err)
+	}
+	defer rows.Close()
+
+	units := []model.Unit{}
+
+	for rows.Next() {
+		var unit model.Unit
+		err := rows.Scan(
+			&unit.ID,
+			&unit.Name,
+			&unit.Factor,
+			&unit.UnitType,
+			&unit.GroupID,
+		)
+
+		if err != nil {
+			return nil, customErrors.NewInternalError("failed to fetch units", err)
+		}
+
+		units = append(units, unit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customErrors.NewInternalError("failed to iterate rows", err)
+	}
+
+	return units, nil
+}
+
+// unitWriteError maps the constraint violations of an insert or update of a unit to application errors.
+func unitWriteError(err error, msg string) error {
+	if sqlerr, ok := errors.AsType[sqlite3.Error](err); ok {
+		switch sqlerr.ExtendedCode {
+		case sqlite3.ErrConstraintUnique:
+			return customErrors.NewConflictError("Unit", "a unit with this name already exists", sqlerr)
+		case sqlite3.ErrConstraintForeignKey:
+			return customErrors.NewNotFoundError("groups", "id", sqlerr)
+		}
+	}
+	return customErrors.NewInternalError(msg, err)
+}
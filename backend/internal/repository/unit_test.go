@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+func TestNewUnitRepository(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+
+	repo := NewUnitRepository(db)
+
+	if repo == nil {
+		t.Fatal("expected non-nil repository, got nil")
+	}
+
+	if repo.db == nil {
+		t.Fatal("expected non-nil database connection, got nil")
+	}
+}
+
+func TestGetUnitByID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewUnitRepository(db)
+
+	tests := []struct {
+		name     string
+		id       int64
+		expected *model.Unit
+		err      error
+	}{
+		{"weight unit", 1, &model.Unit{ID: 1, Name: "Kilogram", Factor: 1000, UnitType: enum.Weight}, nil},
+		{"volume unit", 6, &model.Unit{ID: 6, Name: "Tablespoon", Factor: 15, UnitType: enum.Volume}, nil},
+		{"unknown unit", -1, nil, customErrors.NewNotFoundError("units", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByID(tt.id)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("expected %+v, got %+v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetUnitsByGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewUnitRepository(db)
+
+	tests := []struct {
+		name          string
+		groupID       int64
+		expectedCount int
+		customUnitID  int64
+	}{
+		{"catalogue and custom units of the group", 1, 22, 22},
+		{"catalogue and custom units of another group", 2, 22, 23},
+		{"catalogue only", 3, 21, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByGroupID(tt.groupID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if len(actual) != tt.expectedCount {
+				t.Fatalf("expected %d units, got %d", tt.expectedCount, len(actual))
+			}
+
+			for i, unit := range actual {
+				if unit.GroupID != nil && (*unit.GroupID != tt.groupID || unit.ID != tt.customUnitID) {
+					t.Errorf("unexpected custom unit %+v", unit)
+				}
+
+				if i > 0 {
+					previous := actual[i-1]
+					if previous.UnitType.String() > unit.UnitType.String() ||
+						(previous.UnitType == unit.UnitType && previous.Factor > unit.Factor) {
+						t.Errorf("units are not sorted: %+v before %+v", previous, unit)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGetUnitByNameAndGroupID(t *testing.T) {
+	db := utils.SetUpTestDB(t)
+	defer db.Close()
+	repo := NewUnitRepository(db)
+
+	tests := []struct {
+		name       string
+		unitName   string
+		groupID    int64
+		expectedID int64
+		err        error
+	}{
+		{"catalogue unit", "gram", 2, 2, nil},
+		{"custom unit of the group", "BOWL", 1, 22, nil},
+		{"custom unit of another group", "Bowl", 2, 0, customErrors.NewNotFoundError("units", "name, group_id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := repo.GetByNameAndGroupID(tt.unitName, tt.groupID)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if actual.ID != tt.expectedID {
+				t.Errorf("expected unit %d, got %d", tt.expectedID, actual.ID)
+			}
+		})
+	}
+}
+
+func TestUnitRepositoryCreate(t *testing.T) {
+	tests := []struct {
+		name string
+		unit model.Unit
+		err  error
+	}{
+		{"custom unit", model.Unit{Name: "Mug", Factor: 300, UnitType: enum.Volume, GroupID: new(int64(1))}, nil},
+		{"name used by another group", model.Unit{Name: "Handful", Factor: 25, UnitType: enum.Weight, GroupID: new(int64(1))}, nil},
+		{
+			"name already used in the group",
+			model.Unit{Name: "bowl", Factor: 300, UnitType: enum.Volume, GroupID: new(int64(1))},
+			customErrors.NewConflictError("Unit", "a unit with this name already exists", nil),
+		},
+		{"unknown group", model.Unit{Name: "Mug", Factor: 300, UnitType: enum.Volume, GroupID: new(int64(-1))}, customErrors.NewNotFoundError("groups", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewUnitRepository(db)
+
+			id, err := repo.Create(&tt.unit)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(id)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(*actual, tt.unit) {
+				t.Errorf("expected %+v, got %+v", tt.unit, *actual)
+			}
+		})
+	}
+}
+
+func TestUnitRepositoryUpdate(t *testing.T) {
+	tests := []struct {
+		name string
+		unit model.Unit
+		err  error
+	}{
+		{"custom unit", model.Unit{ID: 22, Name: "Large bowl", Factor: 500, UnitType: enum.Volume, GroupID: new(int64(1))}, nil},
+		{"catalogue unit", model.Unit{ID: 2, Name: "Grams", Factor: 1, UnitType: enum.Weight}, customErrors.NewNotFoundError("units", "id", nil)},
+		{"unknown unit", model.Unit{ID: -1, Name: "Mug", Factor: 1, UnitType: enum.Volume}, customErrors.NewNotFoundError("units", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewUnitRepository(db)
+
+			err := repo.Update(&tt.unit)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			actual, err := repo.GetByID(tt.unit.ID)
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(*actual, tt.unit) {
+				t.Errorf("expected %+v, got %+v", tt.unit, *actual)
+			}
+		})
+	}
+}
+
+func TestUnitRepositoryDelete(t *testing.T) {
+	tests := []struct {
+		name string
+		id   int64
+		used bool
+		err  error
+	}{
+		{"unused custom unit", 22, false, nil},
+		{"custom unit used by an ingredient", 22, true, customErrors.NewConflictError("Unit", "can't delete unit used by ingredients, groceries or the pantry", nil)},
+		{"catalogue unit", 2, false, customErrors.NewNotFoundError("units", "id", nil)},
+		{"unknown unit", -1, false, customErrors.NewNotFoundError("units", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := utils.SetUpTestDB(t)
+			defer db.Close()
+			repo := NewUnitRepository(db)
+
+			if tt.used {
+				if _, err := db.Exec("UPDATE ingredients SET unit_id = ? WHERE id = 1", tt.id); err != nil {
+					t.Fatalf("failed to use the unit: %v", err)
+				}
+			}
+
+			err := repo.Delete(tt.id)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("didn't expected error, got %v", err)
+			}
+
+			if _, err := repo.GetByID(tt.id); !utils.CompareErrors(err, customErrors.NewNotFoundError("units", "id", nil)) {
+				t.Errorf("unit %d should have been deleted", tt.id)
+			}
+		})
+	}
+}
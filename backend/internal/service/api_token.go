@@ -0,0 +1,123 @@
+package service
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+// APITokenServiceInterface defines the contract for API token service operations.
+type APITokenServiceInterface interface {
+	GetByUserID(userID int64) ([]model.APIToken, error)
+	Create(token *model.APIToken) (string, error)
+	Revoke(userID, id int64) error
+	Authenticate(secret string) (*model.APIToken, error)
+}
+
+type APITokenService struct {
+	repo repository.APITokenRepositoryInterface
+}
+
+// NewAPITokenService creates a new APITokenService using the provided APITokenRepository.
+func NewAPITokenService(repo repository.APITokenRepositoryInterface) *APITokenService {
+	return &APITokenService{
+		repo: repo,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByUserID returns all the API tokens of a user, including the expired ones.
+func (s *APITokenService) GetByUserID(userID int64) ([]model.APIToken, error) {
+	return s.repo.GetByUserID(userID)
+}
+
+// Authenticate returns the API token matching the secret sent by a client, and records its use.
+// Returns an UnauthorizedError if the token is unknown or expired.
+func (s *APITokenService) Authenticate(secret string) (*model.APIToken, error) {
+	token, err := s.repo.GetByHash(utils.HashToken(secret))
+	if err != nil {
+		if appErr, ok := errors.AsType[customErrors.AppError](err); ok && appErr.HTTPStatus() == http.StatusNotFound {
+			return nil, customErrors.NewUnauthorizedError("invalid API token", nil)
+		}
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(now) {
+		return nil, customErrors.NewUnauthorizedError("API token has expired", nil)
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= constant.API_TOKEN_LAST_USE_PRECISION {
+		// Failing to record the use doesn't prevent it.
+		if err := s.repo.UpdateLastUsed(token.ID, now); err != nil {
+			slog.Error("Failed to record the use of API token", "id", token.ID, "error", err)
+		} else {
+			token.LastUsedAt = &now
+		}
+	}
+
+	return token, nil
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create generates a new API token for the user of the token and stores its digest.
+// A token without scope is read-only; one without expiry date never expires.
+// Returns the secret of the token, which can't be retrieved afterwards.
+func (s *APITokenService) Create(token *model.APIToken) (string, error) {
+	now := time.Now().UTC()
+
+	token.Name = strings.TrimSpace(token.Name)
+	if length := utf8.RuneCountInString(token.Name); length < 1 || length > constant.API_TOKEN_NAME_MAX_LENGTH {
+		return "", customErrors.NewValidationError("name", customErrors.API_TOKEN_NAME_FIELD_ERROR, nil)
+	}
+
+	if token.Scope == (enum.TokenScope{}) {
+		token.Scope = enum.ReadScope
+	}
+
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(now) {
+		return "", customErrors.NewValidationError("expires_at", "expiry date must be in the future", nil)
+	}
+
+	secret := utils.GenerateAPIToken()
+	token.TokenHash = utils.HashToken(secret)
+	token.CreatedAt = now
+	token.LastUsedAt = nil
+
+	id, err := s.repo.Create(token)
+	if err != nil {
+		return "", err
+	}
+
+	token.ID = id
+
+	return secret, nil
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Revoke deletes the API token, which must belong to the given user.
+func (s *APITokenService) Revoke(userID, id int64) error {
+	token, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if token.UserID != userID {
+		return customErrors.NewNotFoundError("api_tokens", "id", nil)
+	}
+
+	return s.repo.Delete(id)
+}
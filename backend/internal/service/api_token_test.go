@@ -0,0 +1,323 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockAPITokenRepository struct {
+	tokens        []model.APIToken
+	nextID        int64
+	createErr     error
+	lastUsedCalls int
+}
+
+func (m *MockAPITokenRepository) GetByID(id int64) (*model.APIToken, error) {
+	for i := range m.tokens {
+		if m.tokens[i].ID == id {
+			return &m.tokens[i], nil
+		}
+	}
+
+	return nil, customErrors.NewNotFoundError("api_tokens", "id", nil)
+}
+
+func (m *MockAPITokenRepository) GetByHash(tokenHash string) (*model.APIToken, error) {
+	for i := range m.tokens {
+		if m.tokens[i].TokenHash == tokenHash {
+			// A copy, as the real repository returns a fresh token on each call.
+			token := m.tokens[i]
+			return &token, nil
+		}
+	}
+
+	return nil, customErrors.NewNotFoundError("api_tokens", "token", nil)
+}
+
+func (m *MockAPITokenRepository) GetByUserID(userID int64) ([]model.APIToken, error) {
+	tokens := []model.APIToken{}
+	for _, token := range m.tokens {
+		if token.UserID == userID {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens, nil
+}
+
+func (m *MockAPITokenRepository) Create(token *model.APIToken) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	token.ID = m.nextID
+	m.tokens = append(m.tokens, *token)
+	m.nextID++
+
+	return token.ID, nil
+}
+
+func (m *MockAPITokenRepository) UpdateLastUsed(id int64, lastUsedAt time.Time) error {
+	token, err := m.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	m.lastUsedCalls++
+	token.LastUsedAt = &lastUsedAt
+
+	return nil
+}
+
+func (m *MockAPITokenRepository) Delete(id int64) error {
+	for i := range m.tokens {
+		if m.tokens[i].ID == id {
+			m.tokens = append(m.tokens[:i], m.tokens[i+1:]...)
+			return nil
+		}
+	}
+
+	return customErrors.NewNotFoundError("api_tokens", "id", nil)
+}
+
+func (m *MockAPITokenRepository) DeleteByUserID(userID int64) error {
+	tokens := []model.APIToken{}
+	for _, token := range m.tokens {
+		if token.UserID != userID {
+			tokens = append(tokens, token)
+		}
+	}
+	m.tokens = tokens
+
+	return nil
+}
+
+func setUpDataTestAPIToken() *MockAPITokenRepository {
+	now := time.Now().UTC()
+
+	return &MockAPITokenRepository{
+		tokens: []model.APIToken{
+			{ID: 1, UserID: 1, Name: "Unused", TokenHash: utils.HashToken("unused"), Scope: enum.ReadScope},
+			{ID: 2, UserID: 1, Name: "Recently used", TokenHash: utils.HashToken("recent"), Scope: enum.WriteScope, LastUsedAt: new(now.Add(-time.Second))},
+			{ID: 3, UserID: 1, Name: "Expired", TokenHash: utils.HashToken("expired"), Scope: enum.ReadScope, ExpiresAt: new(now.Add(-time.Hour))},
+			{ID: 4, UserID: 2, Name: "Other user", TokenHash: utils.HashToken("other"), Scope: enum.WriteScope, ExpiresAt: new(now.Add(time.Hour))},
+		},
+		nextID: 5,
+	}
+}
+
+func TestNewAPITokenService(t *testing.T) {
+	mockRepo := &MockAPITokenRepository{}
+
+	service := NewAPITokenService(mockRepo)
+
+	if service == nil {
+		t.Fatal("NewAPITokenService returned nil")
+	}
+
+	if service.repo == nil {
+		t.Fatal("NewAPITokenService repo is nil")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetAPITokensByUserID(t *testing.T) {
+	s := NewAPITokenService(setUpDataTestAPIToken())
+
+	tokens, err := s.GetByUserID(1)
+	if err != nil {
+		t.Fatalf("GetByUserID() unexpected error = %v", err)
+	}
+
+	if len(tokens) != 3 {
+		t.Errorf("GetByUserID() expected 3 tokens, got %d", len(tokens))
+	}
+}
+
+func TestAuthenticateAPIToken(t *testing.T) {
+	tests := []struct {
+		name                  string
+		secret                string
+		expectedID            int64
+		expectedLastUsedCalls int
+		expectedErr           error
+	}{
+		{name: "Unused token", secret: "unused", expectedID: 1, expectedLastUsedCalls: 1},
+		{name: "Recently used token", secret: "recent", expectedID: 2, expectedLastUsedCalls: 0},
+		{name: "Token expiring later", secret: "other", expectedID: 4, expectedLastUsedCalls: 1},
+		{
+			name:        "Expired token",
+			secret:      "expired",
+			expectedErr: customErrors.NewUnauthorizedError("API token has expired", nil),
+		},
+		{
+			name:        "Unknown token",
+			secret:      "unknown",
+			expectedErr: customErrors.NewUnauthorizedError("invalid API token", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestAPIToken()
+			s := NewAPITokenService(m)
+
+			token, err := s.Authenticate(tt.secret)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if token.ID != tt.expectedID {
+				t.Errorf("Authenticate() expected token %d, got %d", tt.expectedID, token.ID)
+			}
+			if m.lastUsedCalls != tt.expectedLastUsedCalls {
+				t.Errorf("Authenticate() expected %d update of the last use, got %d", tt.expectedLastUsedCalls, m.lastUsedCalls)
+			}
+			if token.LastUsedAt == nil || !utils.TimesApproximatelyEqual(*token.LastUsedAt, time.Now(), constant.API_TOKEN_LAST_USE_PRECISION) {
+				t.Errorf("Authenticate() expected LastUsedAt around now, got %v", token.LastUsedAt)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreateAPIToken(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name          string
+		token         *model.APIToken
+		err           error
+		expectedName  string
+		expectedScope enum.TokenScope
+		expectedErr   error
+	}{
+		{
+			name:          "Write token with expiry",
+			token:         &model.APIToken{UserID: 1, Name: "  Meal planner ", Scope: enum.WriteScope, ExpiresAt: new(now.Add(time.Hour))},
+			expectedName:  "Meal planner",
+			expectedScope: enum.WriteScope,
+		},
+		{
+			name:          "Default scope",
+			token:         &model.APIToken{UserID: 1, Name: "Export", LastUsedAt: new(now)},
+			expectedName:  "Export",
+			expectedScope: enum.ReadScope,
+		},
+		{
+			name:        "Blank name",
+			token:       &model.APIToken{UserID: 1, Name: "   "},
+			expectedErr: customErrors.NewValidationError("name", customErrors.API_TOKEN_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:        "Name too long",
+			token:       &model.APIToken{UserID: 1, Name: strings.Repeat("a", constant.API_TOKEN_NAME_MAX_LENGTH+1)},
+			expectedErr: customErrors.NewValidationError("name", customErrors.API_TOKEN_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:        "Expiry in the past",
+			token:       &model.APIToken{UserID: 1, Name: "Export", ExpiresAt: new(now.Add(-time.Minute))},
+			expectedErr: customErrors.NewValidationError("expires_at", "expiry date must be in the future", nil),
+		},
+		{
+			name:        "Repository error",
+			token:       &model.APIToken{UserID: -1, Name: "Export"},
+			err:         customErrors.NewNotFoundError("users", "id", nil),
+			expectedErr: customErrors.NewNotFoundError("users", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestAPIToken()
+			m.createErr = tt.err
+			s := NewAPITokenService(m)
+
+			secret, err := s.Create(tt.token)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if tt.token.ID != 5 {
+				t.Errorf("Create() expected ID 5, got %d", tt.token.ID)
+			}
+			if !strings.HasPrefix(secret, "yum_") {
+				t.Errorf("Create() expected a generated secret, got %q", secret)
+			}
+			if tt.token.TokenHash != utils.HashToken(secret) {
+				t.Error("Create() should store the digest of the secret")
+			}
+			if tt.token.Name != tt.expectedName || tt.token.Scope != tt.expectedScope {
+				t.Errorf("Create() expected name %q and scope %v, got %q and %v", tt.expectedName, tt.expectedScope, tt.token.Name, tt.token.Scope)
+			}
+			if tt.token.LastUsedAt != nil {
+				t.Errorf("Create() expected a token never used, got LastUsedAt %v", tt.token.LastUsedAt)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS ***/
+
+func TestRevokeAPIToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      int64
+		id          int64
+		expectedErr error
+	}{
+		{name: "Token of the user", userID: 1, id: 1},
+		{
+			name:        "Token of another user",
+			userID:      1,
+			id:          4,
+			expectedErr: customErrors.NewNotFoundError("api_tokens", "id", nil),
+		},
+		{
+			name:        "Unknown token",
+			userID:      1,
+			id:          -1,
+			expectedErr: customErrors.NewNotFoundError("api_tokens", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestAPIToken()
+			s := NewAPITokenService(m)
+
+			err := s.Revoke(tt.userID, tt.id)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Revoke() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				if len(m.tokens) != 4 {
+					t.Error("Revoke() shouldn't delete any token on error")
+				}
+				return
+			}
+
+			if _, err := m.GetByID(tt.id); err == nil {
+				t.Error("Revoke() did not delete the token")
+			}
+		})
+	}
+}
@@ -10,43 +10,57 @@ import (
 )
 
 type AuthServiceInterface interface {
-	Authenticate(session *model.Session, username, password string) (*model.User, error)
+	Authenticate(session *model.Session, username, password, ipAddress string) (*model.User, error)
 	Logout(session *model.Session) error
+	Unlock(userID int64) error
 }
 
 type AuthService struct {
-	sessionService SessionServiceInterface
-	userService    UserServiceInterface
+	sessionService       SessionServiceInterface
+	userService          UserServiceInterface
+	loginThrottleService LoginThrottleServiceInterface
 }
 
-func NewAuthService(sessionService SessionServiceInterface, userService UserServiceInterface) *AuthService {
+func NewAuthService(sessionService SessionServiceInterface, userService UserServiceInterface, loginThrottleService LoginThrottleServiceInterface) *AuthService {
 	return &AuthService{
-		sessionService: sessionService,
-		userService:    userService,
+		sessionService:       sessionService,
+		userService:          userService,
+		loginThrottleService: loginThrottleService,
 	}
 }
 
 // Checks if the password is valid for this username.
 // Assigns the user carrying this username to the session.
-func (s *AuthService) Authenticate(session *model.Session, username, password string) (*model.User, error) {
+// Failed attempts are counted per username and per client IP of the login request; once there are too many of them,
+// a TooManyRequestsError is returned without checking the credentials until the delay is over.
+func (s *AuthService) Authenticate(session *model.Session, username, password, ipAddress string) (*model.User, error) {
+	if err := s.loginThrottleService.Check(username, ipAddress); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userService.GetByUsername(username)
 	if err != nil {
 		if _, ok := errors.AsType[*customErrors.NotFoundError](err); !ok {
 			return nil, err
 		}
-		return nil, customErrors.NewUnauthorizedError("invalid credentials", err)
+		return nil, s.failLogin(username, ipAddress, err)
 	}
 
 	slog.Debug("Checking password", "username", username)
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			return nil, customErrors.NewUnauthorizedError("invalid credentials", err)
+			return nil, s.failLogin(username, ipAddress, err)
 		}
 		return nil, customErrors.NewInternalError("an error occurred while checking credentials", err)
 	}
 
+	if err = s.loginThrottleService.Reset(username); err != nil {
+		return nil, err
+	}
+
+	// A fresh session ID is issued, so that an ID known before the login can't be used to impersonate the user.
 	session.UserID = &user.ID
-	err = s.sessionService.Save(session)
+	err = s.sessionService.Rotate(session)
 	if err != nil {
 		return nil, err
 	}
@@ -55,11 +69,38 @@ func (s *AuthService) Authenticate(session *model.Session, username, password st
 }
 
 // Logout removes the session from the session store, effectively logging out the user.
+// The session is then replaced by an anonymous one under a fresh ID.
 func (s *AuthService) Logout(session *model.Session) error {
 	err := s.sessionService.Remove(session)
 	if err != nil {
 		return err
 	}
 	slog.Debug("User logged out successfully", "sessionID", session.ID)
+
+	session.UserID = nil
+	return s.sessionService.Rotate(session)
+}
+
+// Unlock forgets the failed login attempts on the username of a user, lifting the lockout of their account.
+func (s *AuthService) Unlock(userID int64) error {
+	user, err := s.userService.GetByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.loginThrottleService.Reset(user.Username); err != nil {
+		return err
+	}
+	slog.Info("User account unlocked", "username", user.Username)
 	return nil
 }
+
+/*** PRIVATE METHODS ***/
+
+// failLogin counts a failed login attempt from the client IP and returns the error of invalid credentials.
+func (s *AuthService) failLogin(username, ipAddress string, err error) error {
+	if recordErr := s.loginThrottleService.RecordFailure(username, ipAddress); recordErr != nil {
+		return recordErr
+	}
+	return customErrors.NewUnauthorizedError("invalid credentials", err)
+}
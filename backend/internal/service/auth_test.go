@@ -21,6 +21,7 @@ var (
 type MockSessionService struct {
 	savedSessions   []*model.Session
 	removedSessions []*model.Session
+	rotatedSessions []*model.Session
 	removeErr       error
 }
 
@@ -49,6 +50,49 @@ func (m *MockSessionService) Remove(session *model.Session) error {
 	return nil
 }
 
+// Rotate records the session and gives it a fresh ID, as the real service does.
+func (m *MockSessionService) Rotate(session *model.Session) error {
+	m.rotatedSessions = append(m.rotatedSessions, session)
+	session.ID = utils.GenerateSessionID()
+	return nil
+}
+
+func (m *MockSessionService) Invalidate(_ int64, _ *model.Session) error {
+	return nil
+}
+
+func (m *MockSessionService) GetByUserID(_ int64) ([]model.Session, error) {
+	return nil, nil
+}
+
+func (m *MockSessionService) Revoke(_ int64, _ string, _ *model.Session) error {
+	return nil
+}
+
+func (m *MockSessionService) RevokeOthers(_ int64, _ *model.Session) error {
+	return nil
+}
+
+type MockLoginThrottleService struct {
+	checkErr error
+	failures []string
+	resets   []string
+}
+
+func (m *MockLoginThrottleService) Check(_, _ string) error {
+	return m.checkErr
+}
+
+func (m *MockLoginThrottleService) RecordFailure(username, ipAddress string) error {
+	m.failures = append(m.failures, username+"@"+ipAddress)
+	return nil
+}
+
+func (m *MockLoginThrottleService) Reset(username string) error {
+	m.resets = append(m.resets, username)
+	return nil
+}
+
 type MockUserService struct {
 	user             *model.User
 	getByUsernameErr error
@@ -59,7 +103,10 @@ func (m *MockUserService) GetAll() ([]model.User, error) {
 }
 
 func (m *MockUserService) GetByID(_ int64) (*model.User, error) {
-	return nil, nil
+	if m.getByUsernameErr != nil {
+		return nil, m.getByUsernameErr
+	}
+	return m.user, nil
 }
 
 func (m *MockUserService) GetByUsername(_ string) (*model.User, error) {
@@ -112,13 +159,18 @@ func createAuthTestUser(t *testing.T, id int64, username, password string) *mode
 func TestNewAuthService(t *testing.T) {
 	mockSessionService := &MockSessionService{}
 	mockUserService := &MockUserService{}
+	mockLoginThrottleService := &MockLoginThrottleService{}
 
-	service := NewAuthService(mockSessionService, mockUserService)
+	service := NewAuthService(mockSessionService, mockUserService, mockLoginThrottleService)
 
 	if service == nil {
 		t.Fatal("NewAuthService() returned nil")
 	}
 
+	if service.loginThrottleService != mockLoginThrottleService {
+		t.Error("NewAuthService() loginThrottleService does not match the provided service")
+	}
+
 	if service.sessionService == nil {
 		t.Error("NewAuthService() sessionService is nil")
 	}
@@ -132,10 +184,11 @@ func TestAuthenticate_Success(t *testing.T) {
 	testUser := createAuthTestUser(t, userID, username, ValidPassword)
 	mockUserService := &MockUserService{user: testUser}
 	mockSessionService := &MockSessionService{}
-	service := NewAuthService(mockSessionService, mockUserService)
+	service := NewAuthService(mockSessionService, mockUserService, &MockLoginThrottleService{})
 
 	session := model.NewSession("", "")
-	authenticatedUser, err := service.Authenticate(session, username, ValidPassword)
+	oldID := session.ID
+	authenticatedUser, err := service.Authenticate(session, username, ValidPassword, "")
 
 	if err != nil {
 		t.Fatalf("Authenticate() error = %v, want nil", err)
@@ -149,12 +202,16 @@ func TestAuthenticate_Success(t *testing.T) {
 		t.Errorf("Authenticate() session UserID = %d, want %d", session.UserID, testUser.ID)
 	}
 
-	if len(mockSessionService.savedSessions) != 1 {
-		t.Fatalf("Authenticate() save calls = %d, want 1", len(mockSessionService.savedSessions))
+	if len(mockSessionService.rotatedSessions) != 1 {
+		t.Fatalf("Authenticate() rotate calls = %d, want 1", len(mockSessionService.rotatedSessions))
 	}
 
-	if mockSessionService.savedSessions[0] != session {
-		t.Error("Authenticate() saved session pointer does not match input session")
+	if mockSessionService.rotatedSessions[0] != session {
+		t.Error("Authenticate() rotated session pointer does not match input session")
+	}
+
+	if session.ID == oldID {
+		t.Error("Authenticate() should issue a fresh session ID")
 	}
 }
 
@@ -162,10 +219,10 @@ func TestAuthenticate_UserServiceError(t *testing.T) {
 	expectedErr := customErrors.NewInternalError("user lookup failed", nil)
 	mockUserService := &MockUserService{getByUsernameErr: expectedErr}
 	mockSessionService := &MockSessionService{}
-	service := NewAuthService(mockSessionService, mockUserService)
+	service := NewAuthService(mockSessionService, mockUserService, &MockLoginThrottleService{})
 
 	session := model.NewSession("", "")
-	authenticatedUser, err := service.Authenticate(session, username, "irrelevant")
+	authenticatedUser, err := service.Authenticate(session, username, "irrelevant", "")
 
 	if authenticatedUser != nil {
 		t.Error("Authenticate() returned non-nil user when user retrieval fails")
@@ -185,10 +242,10 @@ func TestAuthenticate_BadUsername_ReturnsUnauthorizedError(t *testing.T) {
 	repoErr := customErrors.NewNotFoundError("users", badUsername, nil)
 	mockUserService := &MockUserService{getByUsernameErr: repoErr}
 	mockSessionService := &MockSessionService{}
-	service := NewAuthService(mockSessionService, mockUserService)
+	service := NewAuthService(mockSessionService, mockUserService, &MockLoginThrottleService{})
 
 	session := model.NewSession("", "")
-	authenticatedUser, err := service.Authenticate(session, badUsername, "anything")
+	authenticatedUser, err := service.Authenticate(session, badUsername, "anything", "")
 
 	if authenticatedUser != nil {
 		t.Error("Authenticate() returned non-nil user when username does not exist")
@@ -222,10 +279,10 @@ func TestAuthenticate_WrongPassword(t *testing.T) {
 		user: createAuthTestUser(t, userID, username, ValidPassword),
 	}
 	mockSessionService := &MockSessionService{}
-	service := NewAuthService(mockSessionService, mockUserService)
+	service := NewAuthService(mockSessionService, mockUserService, &MockLoginThrottleService{})
 
 	session := model.NewSession("", "")
-	authenticatedUser, err := service.Authenticate(session, username, InvalidPassword)
+	authenticatedUser, err := service.Authenticate(session, username, InvalidPassword, "")
 
 	if authenticatedUser != nil {
 		t.Error("Authenticate() returned non-nil user when credentials are invalid")
@@ -259,10 +316,10 @@ func TestAuthenticate_InvalidPasswordHash_ReturnsInternalServerError(t *testing.
 		},
 	}
 	mockSessionService := &MockSessionService{}
-	service := NewAuthService(mockSessionService, mockUserService)
+	service := NewAuthService(mockSessionService, mockUserService, &MockLoginThrottleService{})
 
 	session := model.NewSession("", "")
-	authenticatedUser, err := service.Authenticate(session, username, "any-password")
+	authenticatedUser, err := service.Authenticate(session, username, "any-password", "")
 
 	if authenticatedUser != nil {
 		t.Error("Authenticate() returned non-nil user for invalid password hash")
@@ -294,12 +351,88 @@ func TestAuthenticate_InvalidPasswordHash_ReturnsInternalServerError(t *testing.
 	}
 }
 
+func TestAuthenticate_CountsFailures(t *testing.T) {
+	mockUserService := &MockUserService{
+		user: createAuthTestUser(t, userID, username, ValidPassword),
+	}
+	mockLoginThrottleService := &MockLoginThrottleService{}
+	service := NewAuthService(&MockSessionService{}, mockUserService, mockLoginThrottleService)
+
+	// The IP of the login request is counted rather than the one the session was created from
+	session := model.NewSession("198.51.100.1:1234", "")
+	if _, err := service.Authenticate(session, username, InvalidPassword, "192.0.2.1:4321"); err == nil {
+		t.Fatal("Authenticate() error = nil, want non-nil")
+	}
+	if _, err := service.Authenticate(session, username, ValidPassword, "192.0.2.1:4321"); err != nil {
+		t.Fatalf("Authenticate() error = %v, want nil", err)
+	}
+
+	if len(mockLoginThrottleService.failures) != 1 || mockLoginThrottleService.failures[0] != username+"@192.0.2.1:4321" {
+		t.Errorf("Authenticate() failures = %v, want the failure of the wrong password", mockLoginThrottleService.failures)
+	}
+	if len(mockLoginThrottleService.resets) != 1 || mockLoginThrottleService.resets[0] != username {
+		t.Errorf("Authenticate() resets = %v, want the reset of the successful login", mockLoginThrottleService.resets)
+	}
+}
+
+func TestAuthenticate_Throttled(t *testing.T) {
+	mockUserService := &MockUserService{
+		user: createAuthTestUser(t, userID, username, ValidPassword),
+	}
+	mockSessionService := &MockSessionService{}
+	throttleErr := customErrors.NewTooManyRequestsError(time.Minute, nil)
+	service := NewAuthService(mockSessionService, mockUserService, &MockLoginThrottleService{checkErr: throttleErr})
+
+	session := model.NewSession("", "")
+	authenticatedUser, err := service.Authenticate(session, username, ValidPassword, "")
+
+	if authenticatedUser != nil || session.UserID != nil {
+		t.Error("Authenticate() shouldn't authenticate a throttled login, even with valid credentials")
+	}
+
+	if !utils.CompareErrors(err, throttleErr) {
+		t.Errorf("Authenticate() error = %v, want %v", err, throttleErr)
+	}
+
+	if len(mockSessionService.savedSessions) != 0 {
+		t.Error("Authenticate() should not save session when the login is throttled")
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	mockLoginThrottleService := &MockLoginThrottleService{}
+	service := NewAuthService(&MockSessionService{}, &MockUserService{user: &model.User{ID: userID, Username: username}}, mockLoginThrottleService)
+
+	if err := service.Unlock(userID); err != nil {
+		t.Fatalf("Unlock() error = %v, want nil", err)
+	}
+
+	if len(mockLoginThrottleService.resets) != 1 || mockLoginThrottleService.resets[0] != username {
+		t.Errorf("Unlock() resets = %v, want [%s]", mockLoginThrottleService.resets, username)
+	}
+}
+
+func TestUnlock_UserNotFound(t *testing.T) {
+	notFoundErr := customErrors.NewNotFoundError("users", "id", nil)
+	mockLoginThrottleService := &MockLoginThrottleService{}
+	service := NewAuthService(&MockSessionService{}, &MockUserService{getByUsernameErr: notFoundErr}, mockLoginThrottleService)
+
+	if err := service.Unlock(userID); !utils.CompareErrors(err, notFoundErr) {
+		t.Errorf("Unlock() error = %v, want %v", err, notFoundErr)
+	}
+
+	if len(mockLoginThrottleService.resets) != 0 {
+		t.Error("Unlock() shouldn't reset anything for a missing user")
+	}
+}
+
 func TestLogout_RemovesSession(t *testing.T) {
 	mockUserService := &MockUserService{}
 	mockSessionService := &MockSessionService{}
-	service := NewAuthService(mockSessionService, mockUserService)
+	service := NewAuthService(mockSessionService, mockUserService, &MockLoginThrottleService{})
 
 	session := model.NewSession("", "")
+	session.UserID = new(userID)
 	err := service.Logout(session)
 
 	if err != nil {
@@ -313,6 +446,14 @@ func TestLogout_RemovesSession(t *testing.T) {
 	if mockSessionService.removedSessions[0] != session {
 		t.Error("Logout() removed session pointer does not match input session")
 	}
+
+	if len(mockSessionService.rotatedSessions) != 1 {
+		t.Fatalf("Logout() rotate calls = %d, want 1", len(mockSessionService.rotatedSessions))
+	}
+
+	if session.UserID != nil {
+		t.Errorf("Logout() session UserID = %d, want nil", *session.UserID)
+	}
 }
 
 func TestLogout_RepositoryError(t *testing.T) {
@@ -320,7 +461,7 @@ func TestLogout_RepositoryError(t *testing.T) {
 	mockSessionService := &MockSessionService{
 		removeErr: customErrors.NewInternalError("failed to remove session", nil),
 	}
-	service := NewAuthService(mockSessionService, mockUserService)
+	service := NewAuthService(mockSessionService, mockUserService, &MockLoginThrottleService{})
 
 	session := model.NewSession("", "")
 	err := service.Logout(session)
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type DishServiceInterface interface {
+	GetByID(id int64) (*model.Dish, error)
+	GetByGroupIDAndPeriod(groupID int64, from, to time.Time) ([]model.Dish, error)
+	EstimateCost(groupID int64, from, to time.Time) (*model.CostEstimate, error)
+	Create(ctx context.Context, dish *model.Dish) (int64, error)
+	Update(ctx context.Context, dish *model.Dish) error
+	Move(ctx context.Context, id int64, datetime time.Time) error
+	Cook(ctx context.Context, id int64) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type DishService struct {
+	repo       repository.DishRepositoryInterface
+	recipeRepo repository.RecipeRepositoryInterface
+	itemRepo   repository.ItemRepositoryInterface
+	pantryRepo repository.PantryRepositoryInterface
+}
+
+// NewDishService creates a new DishService using the provided repositories,
+// the recipe one being used to validate the recipes of the dishes, the item one to estimate their cost
+// and the pantry one to deduct their ingredients once cooked.
+func NewDishService(repo repository.DishRepositoryInterface,
+	recipeRepo repository.RecipeRepositoryInterface,
+	itemRepo repository.ItemRepositoryInterface,
+	pantryRepo repository.PantryRepositoryInterface) *DishService {
+	return &DishService{
+		repo:       repo,
+		recipeRepo: recipeRepo,
+		itemRepo:   itemRepo,
+		pantryRepo: pantryRepo,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID returns the dish identified by id, with a summary of its recipes.
+func (s *DishService) GetByID(id int64) (*model.Dish, error) {
+	return s.repo.GetByID(id)
+}
+
+// GetByGroupIDAndPeriod returns the dishes of a group scheduled from the from date included to the to date excluded,
+// sorted by date.
+func (s *DishService) GetByGroupIDAndPeriod(groupID int64, from, to time.Time) ([]model.Dish, error) {
+	if !to.After(from) {
+		return nil, customErrors.NewValidationError("to", customErrors.DISH_PERIOD_FIELD_ERROR, nil)
+	}
+
+	return s.repo.GetByGroupIDAndPeriod(groupID, from, to)
+}
+
+// EstimateCost estimates the cost of the dishes of a group scheduled from the from date included to the to date excluded,
+// the quantities of each recipe being scaled by the portion of the dish against the servings of the recipe.
+// The servings of the estimate are the portions of these dishes.
+func (s *DishService) EstimateCost(groupID int64, from, to time.Time) (*model.CostEstimate, error) {
+	dishes, err := s.GetByGroupIDAndPeriod(groupID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := &model.CostEstimate{Servings: new(0), UnpricedIngredients: []model.Ingredient{}}
+	recipes := make(map[int64]*model.Recipe)
+	items := make(map[int64]*model.Item)
+
+	for _, dish := range dishes {
+		*estimate.Servings += dish.Portion
+
+		for _, dishRecipe := range dish.Recipes {
+			recipe, ok := recipes[dishRecipe.ID]
+			if !ok {
+				if recipe, err = s.recipeRepo.GetByID(dishRecipe.ID); err != nil {
+					return nil, err
+				}
+				recipes[dishRecipe.ID] = recipe
+			}
+
+			if err := addRecipeCost(estimate, recipe, portionScale(&dish, recipe), s.itemRepo, items); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return estimate, nil
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create validates and schedules a new dish, neither bought nor cooked yet, returning its ID.
+func (s *DishService) Create(ctx context.Context, dish *model.Dish) (int64, error) {
+	dish.Bought = false
+	dish.Cooked = false
+
+	if err := s.validateDish(dish); err != nil {
+		return 0, err
+	}
+
+	return s.repo.Create(ctx, dish)
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update validates and replaces the portion, date and recipes of the dish identified by dish.ID.
+// The group of a dish and whether its groceries were bought can't be updated.
+func (s *DishService) Update(ctx context.Context, dish *model.Dish) error {
+	currentDish, err := s.repo.GetByID(dish.ID)
+	if err != nil {
+		return err
+	}
+
+	dish.GroupID = currentDish.GroupID
+	dish.Bought = currentDish.Bought
+	dish.Cooked = currentDish.Cooked
+
+	if err := s.validateDish(dish); err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, dish)
+}
+
+// Move reschedules the dish identified by id at the given date, keeping its portion and recipes.
+func (s *DishService) Move(ctx context.Context, id int64, datetime time.Time) error {
+	dish, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if datetime.IsZero() {
+		return customErrors.NewInvalidParamsError([]string{"datetime"}, nil)
+	}
+
+	dish.Datetime = datetime
+
+	return s.repo.Update(ctx, dish)
+}
+
+// Cook deducts the ingredients of the dish identified by id from the pantry of its group,
+// their quantities being scaled by the portion of the dish against the servings of each recipe.
+// Ingredients without quantity, and what the pantry lacks, are ignored; the emptied pantry lines are removed.
+// A dish is cooked once: a ConflictError is returned if it already was.
+func (s *DishService) Cook(ctx context.Context, id int64) error {
+	dish, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if dish.Cooked {
+		return customErrors.NewConflictError("Dish", "dish is already cooked", nil)
+	}
+
+	pantryItems, err := s.pantryRepo.GetByGroupID(dish.GroupID)
+	if err != nil {
+		return err
+	}
+	stock := make([]float64, len(pantryItems))
+	for i, pantryItem := range pantryItems {
+		stock[i] = pantryItem.Quantity
+	}
+
+	for _, dishRecipe := range dish.Recipes {
+		recipe, err := s.recipeRepo.GetByID(dishRecipe.ID)
+		if err != nil {
+			return err
+		}
+
+		scale := portionScale(dish, recipe)
+		for _, ingredient := range recipe.Ingredients {
+			if ingredient.Quantity == nil {
+				continue
+			}
+			if err := deductFromPantry(pantryItems, ingredient.Item.ID, *ingredient.Quantity*scale, &ingredient.Unit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The quantities taken from each line are deducted from the current stock, which may have changed since it was read.
+	deducted := []model.PantryItem{}
+	for i, pantryItem := range pantryItems {
+		if pantryItem.Quantity != stock[i] {
+			pantryItem.Quantity = stock[i] - pantryItem.Quantity
+			deducted = append(deducted, pantryItem)
+		}
+	}
+
+	return s.pantryRepo.DeductForDish(ctx, dish.ID, deducted)
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the dish identified by id from the planner; its recipes are kept.
+func (s *DishService) Delete(ctx context.Context, id int64) error {
+	return s.repo.Delete(ctx, id)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// validateDish checks the fields of the dish, removes its duplicated recipes
+// and ensures that every recipe exists and belongs to the group of the dish.
+func (s *DishService) validateDish(dish *model.Dish) error {
+	e := customErrors.NewInvalidParamsError([]string{}, nil).(*customErrors.InvalidParamsError)
+
+	if dish.Portion <= 0 {
+		e.AddInvalidField("portion")
+	}
+	if dish.Datetime.IsZero() {
+		e.AddInvalidField("datetime")
+	}
+	if len(dish.Recipes) == 0 {
+		e.AddInvalidField("recipe_ids")
+	}
+
+	if len(e.Fields) > 0 {
+		return e
+	}
+
+	seenRecipes := make(map[int64]bool, len(dish.Recipes))
+	recipes := make([]model.Recipe, 0, len(dish.Recipes))
+
+	for _, r := range dish.Recipes {
+		if seenRecipes[r.ID] {
+			continue
+		}
+		seenRecipes[r.ID] = true
+
+		recipe, err := s.recipeRepo.GetByID(r.ID)
+		if err != nil {
+			return referenceError(err, "Recipe", "recipe must exists")
+		}
+		if recipe.GroupID != dish.GroupID {
+			return customErrors.NewConflictError("Recipe", "recipe must belongs to the same group as the dish", nil)
+		}
+		recipes = append(recipes, *recipe)
+	}
+
+	dish.Recipes = recipes
+
+	return nil
+}
+
+// portionScale returns the factor scaling the quantities of a recipe to the portion of a dish,
+// the quantities being kept as is when the servings of the recipe are unknown.
+func portionScale(dish *model.Dish, recipe *model.Recipe) float64 {
+	if recipe.Servings == nil || *recipe.Servings <= 0 {
+		return 1
+	}
+	return float64(dish.Portion) / float64(*recipe.Servings)
+}
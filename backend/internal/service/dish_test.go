@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockDishRepository struct {
+	dishes    []model.Dish
+	created   *model.Dish
+	updated   *model.Dish
+	deletedID int64
+}
+
+func (m *MockDishRepository) GetByID(id int64) (*model.Dish, error) {
+	for i := range m.dishes {
+		if m.dishes[i].ID == id {
+			dish := m.dishes[i]
+			return &dish, nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("dishes", "id", nil)
+}
+
+func (m *MockDishRepository) GetByGroupIDAndPeriod(groupID int64, from, to time.Time) ([]model.Dish, error) {
+	dishes := []model.Dish{}
+	for _, dish := range m.dishes {
+		if dish.GroupID == groupID && !dish.Datetime.Before(from) && dish.Datetime.Before(to) {
+			dishes = append(dishes, dish)
+		}
+	}
+	return dishes, nil
+}
+
+func (m *MockDishRepository) Create(_ context.Context, dish *model.Dish) (int64, error) {
+	m.created = dish
+	return 42, nil
+}
+
+func (m *MockDishRepository) Update(_ context.Context, dish *model.Dish) error {
+	m.updated = dish
+	return nil
+}
+
+func (m *MockDishRepository) Delete(_ context.Context, id int64) error {
+	for _, dish := range m.dishes {
+		if dish.ID == id {
+			m.deletedID = id
+			return nil
+		}
+	}
+	return customErrors.NewNotFoundError("dishes", "id", nil)
+}
+
+var dishDatetime = time.Date(2026, time.October, 20, 19, 30, 0, 0, time.UTC)
+
+// setUpDishServiceData builds a DishService whose repository contains a bought dish of the first group,
+// made of the recipe of the first group of setUpRecipeServiceData.
+func setUpDishServiceData() (*DishService, *MockDishRepository) {
+	_, recipeRepo, _ := setUpRecipeServiceData()
+	dishRepo := &MockDishRepository{
+		dishes: []model.Dish{
+			{ID: 1, Portion: 4, Bought: true, Datetime: dishDatetime, GroupID: group1.ID, Recipes: []model.Recipe{{ID: 1}}},
+		},
+	}
+
+	return NewDishService(dishRepo, recipeRepo, setUpDataTestItem(), &MockPantryRepository{}), dishRepo
+}
+
+func TestNewDishService(t *testing.T) {
+	repo := &MockDishRepository{}
+	recipeRepo := &MockRecipeRepository{}
+	itemRepo := NewMockItemRepository()
+
+	pantryRepo := &MockPantryRepository{}
+
+	service := NewDishService(repo, recipeRepo, itemRepo, pantryRepo)
+
+	if service == nil {
+		t.Fatal("NewDishService() returned nil")
+	}
+	if service.repo != repo || service.recipeRepo != recipeRepo || service.itemRepo != itemRepo || service.pantryRepo != pantryRepo {
+		t.Error("NewDishService() repositories do not match the provided ones")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetDishesByGroupIDAndPeriod(t *testing.T) {
+	tests := []struct {
+		name          string
+		from          time.Time
+		to            time.Time
+		expectedCount int
+		err           error
+	}{
+		{"Week of the dish", dishDatetime.Add(-24 * time.Hour), dishDatetime.Add(6 * 24 * time.Hour), 1, nil},
+		{"Week before the dish", dishDatetime.Add(-7 * 24 * time.Hour), dishDatetime, 0, nil},
+		{"Empty period", dishDatetime, dishDatetime, 0, customErrors.NewValidationError("to", customErrors.DISH_PERIOD_FIELD_ERROR, nil)},
+		{"Reversed period", dishDatetime, dishDatetime.Add(-time.Hour), 0, customErrors.NewValidationError("to", customErrors.DISH_PERIOD_FIELD_ERROR, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := setUpDishServiceData()
+
+			actual, err := service.GetByGroupIDAndPeriod(group1.ID, tt.from, tt.to)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("GetByGroupIDAndPeriod() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GetByGroupIDAndPeriod() unexpected error = %v", err)
+			}
+			if len(actual) != tt.expectedCount {
+				t.Errorf("GetByGroupIDAndPeriod() returned %d dishes, want %d", len(actual), tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestEstimateDishesCost(t *testing.T) {
+	unitMilliliter := model.Unit{ID: 4, Name: "Milliliter", Factor: 1, UnitType: enum.Volume}
+	recipes := []model.Recipe{
+		{
+			ID:       10,
+			Name:     "Crepes",
+			Servings: new(2),
+			GroupID:  group1.ID,
+			Ingredients: []model.Ingredient{
+				{ID: 1, Quantity: new(0.5), Item: items[0], Unit: unitKilogram},
+				{ID: 2, Quantity: new(10.0), Item: items[4], Unit: unitMilliliter},
+			},
+		},
+	}
+	dishes := []model.Dish{
+		{ID: 1, Portion: 4, Datetime: dishDatetime, GroupID: group1.ID, Recipes: []model.Recipe{{ID: 10}}},
+		{ID: 2, Portion: 2, Bought: true, Datetime: dishDatetime.Add(24 * time.Hour), GroupID: group1.ID, Recipes: []model.Recipe{{ID: 10}}},
+		{ID: 3, Portion: 2, Datetime: dishDatetime.Add(7 * 24 * time.Hour), GroupID: group1.ID, Recipes: []model.Recipe{{ID: -1}}},
+	}
+	week := dishDatetime.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		expected *model.CostEstimate
+		err      error
+	}{
+		{
+			name: "Dishes of the week",
+			from: week,
+			to:   week.Add(7 * 24 * time.Hour),
+			// 1 kg then 0.5 kg of flour at 2.50 per kilogram, the olive oil having no price
+			expected: &model.CostEstimate{Cost: 3.75, Servings: new(6), UnpricedIngredients: []model.Ingredient{recipes[0].Ingredients[1]}},
+		},
+		{
+			name:     "Week without dishes",
+			from:     week.Add(-7 * 24 * time.Hour),
+			to:       week,
+			expected: &model.CostEstimate{Servings: new(0), UnpricedIngredients: []model.Ingredient{}},
+		},
+		{
+			name: "Unknown recipe",
+			from: week,
+			to:   week.Add(14 * 24 * time.Hour),
+			err:  customErrors.NewNotFoundError("recipes", "id", nil),
+		},
+		{
+			name: "Reversed period",
+			from: week,
+			to:   week.Add(-time.Hour),
+			err:  customErrors.NewValidationError("to", customErrors.DISH_PERIOD_FIELD_ERROR, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewDishService(&MockDishRepository{dishes: dishes}, &MockRecipeRepository{recipes: recipes}, setUpDataTestItem(), &MockPantryRepository{})
+
+			actual, err := service.EstimateCost(group1.ID, tt.from, tt.to)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("EstimateCost() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("EstimateCost() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("EstimateCost() = %+v, want %+v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreateDish(t *testing.T) {
+	tests := []struct {
+		name              string
+		dish              model.Dish
+		expectedRecipeIDs []int64
+		err               error
+	}{
+		{
+			name:              "Valid dish",
+			dish:              model.Dish{Portion: 2, Bought: true, Datetime: dishDatetime, GroupID: group1.ID, Recipes: []model.Recipe{{ID: 1}}},
+			expectedRecipeIDs: []int64{1},
+		},
+		{
+			name:              "Duplicated recipes",
+			dish:              model.Dish{Portion: 2, Datetime: dishDatetime, GroupID: group1.ID, Recipes: []model.Recipe{{ID: 1}, {ID: 1}}},
+			expectedRecipeIDs: []int64{1},
+		},
+		{
+			name: "Invalid fields",
+			dish: model.Dish{Portion: 0, GroupID: group1.ID},
+			err:  customErrors.NewInvalidParamsError([]string{"portion", "datetime", "recipe_ids"}, nil),
+		},
+		{
+			name: "Unknown recipe",
+			dish: model.Dish{Portion: 2, Datetime: dishDatetime, GroupID: group1.ID, Recipes: []model.Recipe{{ID: -1}}},
+			err:  customErrors.NewConflictError("Recipe", "recipe must exists", nil),
+		},
+		{
+			name: "Recipe of another group",
+			dish: model.Dish{Portion: 2, Datetime: dishDatetime, GroupID: group1.ID, Recipes: []model.Recipe{{ID: 1}, {ID: 2}}},
+			err:  customErrors.NewConflictError("Recipe", "recipe must belongs to the same group as the dish", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpDishServiceData()
+
+			id, err := service.Create(context.Background(), &tt.dish)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Create() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+			if id != 42 {
+				t.Errorf("Create() expected id 42, got %d", id)
+			}
+
+			actualRecipeIDs := make([]int64, len(repo.created.Recipes))
+			for i, recipe := range repo.created.Recipes {
+				actualRecipeIDs[i] = recipe.ID
+			}
+
+			// A new dish isn't bought yet, and its recipes are resolved.
+			if repo.created.Bought || !reflect.DeepEqual(actualRecipeIDs, tt.expectedRecipeIDs) || repo.created.Recipes[0].Name != "Pancakes" {
+				t.Errorf("Create() unexpected created dish %+v", *repo.created)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestUpdateDish(t *testing.T) {
+	tests := []struct {
+		name string
+		dish model.Dish
+		err  error
+	}{
+		{
+			name: "Valid update",
+			dish: model.Dish{ID: 1, Portion: 6, Datetime: dishDatetime.Add(24 * time.Hour), GroupID: group2.ID, Recipes: []model.Recipe{{ID: 1}}},
+		},
+		{
+			name: "Unknown dish",
+			dish: model.Dish{ID: -1, Portion: 6, Datetime: dishDatetime, Recipes: []model.Recipe{{ID: 1}}},
+			err:  customErrors.NewNotFoundError("dishes", "id", nil),
+		},
+		{
+			name: "Recipe of another group",
+			dish: model.Dish{ID: 1, Portion: 6, Datetime: dishDatetime, Recipes: []model.Recipe{{ID: 2}}},
+			err:  customErrors.NewConflictError("Recipe", "recipe must belongs to the same group as the dish", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpDishServiceData()
+
+			err := service.Update(context.Background(), &tt.dish)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Update() unexpected error = %v", err)
+			}
+
+			// The group and the bought state are kept.
+			if repo.updated.GroupID != group1.ID || !repo.updated.Bought || repo.updated.Portion != 6 {
+				t.Errorf("Update() unexpected updated dish %+v", *repo.updated)
+			}
+		})
+	}
+}
+
+func TestMoveDish(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       int64
+		datetime time.Time
+		err      error
+	}{
+		{"Next day", 1, dishDatetime.Add(24 * time.Hour), nil},
+		{"Missing date", 1, time.Time{}, customErrors.NewInvalidParamsError([]string{"datetime"}, nil)},
+		{"Unknown dish", -1, dishDatetime, customErrors.NewNotFoundError("dishes", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpDishServiceData()
+
+			err := service.Move(context.Background(), tt.id, tt.datetime)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Move() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Move() unexpected error = %v", err)
+			}
+
+			// Only the date changes.
+			if !repo.updated.Datetime.Equal(tt.datetime) || repo.updated.Portion != 4 || len(repo.updated.Recipes) != 1 {
+				t.Errorf("Move() unexpected updated dish %+v", *repo.updated)
+			}
+		})
+	}
+}
+
+func TestCookDish(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               int64
+		cooked           bool
+		pantryItems      []model.PantryItem
+		expectedDeducted []model.PantryItem
+		expectedCooked   bool
+		err              error
+	}{
+		{
+			name: "Ingredients in stock",
+			id:   1,
+			pantryItems: []model.PantryItem{
+				{ID: 1, Quantity: 100, Item: items[0], Unit: unitGram, GroupID: group1.ID},
+				{ID: 2, Quantity: 1, Item: items[0], Unit: unitKilogram, GroupID: group1.ID},
+				{ID: 3, Quantity: 50, Item: items[3], Unit: unitGram, GroupID: group1.ID},
+			},
+			// 250 g of flour: the 100 g line is emptied and 150 g are taken from the kilogram
+			expectedDeducted: []model.PantryItem{
+				{ID: 1, Quantity: 100, Item: items[0], Unit: unitGram, GroupID: group1.ID},
+				{ID: 2, Quantity: 0.15, Item: items[0], Unit: unitKilogram, GroupID: group1.ID},
+			},
+			expectedCooked: true,
+		},
+		{
+			name: "Ingredients out of stock",
+			id:   1,
+			pantryItems: []model.PantryItem{
+				{ID: 3, Quantity: 50, Item: items[3], Unit: unitGram, GroupID: group1.ID},
+				{ID: 4, Quantity: 500, Item: items[0], Unit: unitGram, GroupID: group2.ID},
+			},
+			expectedDeducted: []model.PantryItem{},
+			expectedCooked:   true,
+		},
+		{
+			name:   "Already cooked",
+			id:     1,
+			cooked: true,
+			pantryItems: []model.PantryItem{
+				{ID: 1, Quantity: 100, Item: items[0], Unit: unitGram, GroupID: group1.ID},
+			},
+			err: customErrors.NewConflictError("Dish", "dish is already cooked", nil),
+		},
+		{
+			name: "Unknown dish",
+			id:   -1,
+			err:  customErrors.NewNotFoundError("dishes", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, dishRepo := setUpDishServiceData()
+			dishRepo.dishes[0].Cooked = tt.cooked
+			pantryRepo := &MockPantryRepository{pantryItems: tt.pantryItems}
+			service.pantryRepo = pantryRepo
+
+			err := service.Cook(context.Background(), tt.id)
+
+			if !utils.CompareErrors(err, tt.err) {
+				t.Fatalf("Cook() error = %v, want %v", err, tt.err)
+			}
+
+			if len(pantryRepo.deducted) != len(tt.expectedDeducted) {
+				t.Fatalf("Cook() deducted %+v, want %+v", pantryRepo.deducted, tt.expectedDeducted)
+			}
+			for i, expected := range tt.expectedDeducted {
+				actual := pantryRepo.deducted[i]
+				// Quantities are compared approximately, being converted between units
+				if actual.ID != expected.ID || math.Abs(actual.Quantity-expected.Quantity) > 1e-9 {
+					t.Errorf("Cook() deducted %+v, want %+v", actual, expected)
+				}
+			}
+			if cooked := pantryRepo.cookedID == tt.id; cooked != tt.expectedCooked {
+				t.Errorf("Cook() flagged the dish as cooked = %v, want %v", cooked, tt.expectedCooked)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS ***/
+
+func TestDeleteDish(t *testing.T) {
+	service, repo := setUpDishServiceData()
+
+	if err := service.Delete(context.Background(), 1); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+	if repo.deletedID != 1 {
+		t.Errorf("Delete() expected dish 1 to be deleted, got %d", repo.deletedID)
+	}
+
+	if err := service.Delete(context.Background(), -1); !utils.CompareErrors(err, customErrors.NewNotFoundError("dishes", "id", nil)) {
+		t.Errorf("Delete() expected not found error, got %v", err)
+	}
+}
@@ -1,21 +1,311 @@
 package service
 
-import "github.com/zouipo/yumsday/backend/internal/repository"
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
 
 type GroceryServiceInterface interface {
 	HasItem(id int64) (bool, error)
+	GetByID(id int64) (*model.Grocery, error)
+	GetByGroupID(groupID int64) ([]model.Grocery, error)
+	Create(grocery *model.Grocery) (int64, error)
+	GenerateFromDishes(ctx context.Context, groupID int64, from, to time.Time) ([]model.Grocery, error)
+	Update(grocery *model.Grocery) error
+	SetBought(ctx context.Context, id int64, quantityBought *float64) error
+	Delete(id int64) error
+	DeleteBought(groupID int64) (int64, error)
 }
 
 type GroceryService struct {
-	repo repository.GroceryRepositoryInterface
+	repo       repository.GroceryRepositoryInterface
+	itemRepo   repository.ItemRepositoryInterface
+	unitRepo   repository.UnitRepositoryInterface
+	dishRepo   repository.DishRepositoryInterface
+	recipeRepo repository.RecipeRepositoryInterface
+	pantryRepo repository.PantryRepositoryInterface
 }
 
-func NewGroceryService(repo repository.GroceryRepositoryInterface) *GroceryService {
+// NewGroceryService creates a new GroceryService using the provided repositories,
+// the item and unit ones being used to validate the references of the grocery lines,
+// the dish, recipe and pantry ones to generate the grocery list from the meal plan and what is already in stock.
+func NewGroceryService(repo repository.GroceryRepositoryInterface,
+	itemRepo repository.ItemRepositoryInterface,
+	unitRepo repository.UnitRepositoryInterface,
+	dishRepo repository.DishRepositoryInterface,
+	recipeRepo repository.RecipeRepositoryInterface,
+	pantryRepo repository.PantryRepositoryInterface) *GroceryService {
 	return &GroceryService{
-		repo: repo,
+		repo:       repo,
+		itemRepo:   itemRepo,
+		unitRepo:   unitRepo,
+		dishRepo:   dishRepo,
+		recipeRepo: recipeRepo,
+		pantryRepo: pantryRepo,
 	}
 }
 
+/*** READ OPERATIONS ***/
+
 func (s *GroceryService) HasItem(itemID int64) (bool, error) {
 	return s.repo.HasItem(itemID)
 }
+
+// GetByID returns the grocery line identified by id, with its item and unit.
+func (s *GroceryService) GetByID(id int64) (*model.Grocery, error) {
+	return s.repo.GetByID(id)
+}
+
+// GetByGroupID returns the grocery list of a group, sorted by the position of the item categories, then by item name.
+func (s *GroceryService) GetByGroupID(groupID int64) ([]model.Grocery, error) {
+	return s.repo.GetByGroupID(groupID)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create validates and adds a new grocery line, nothing being bought yet, returning its ID.
+func (s *GroceryService) Create(grocery *model.Grocery) (int64, error) {
+	grocery.QuantityBought = 0
+
+	if err := s.validateGrocery(grocery); err != nil {
+		return 0, err
+	}
+
+	return s.repo.Create(grocery)
+}
+
+// GenerateFromDishes adds to the grocery list of a group the ingredients of the dishes not bought yet,
+// scheduled from the from date included to the to date excluded, then flags these dishes as bought.
+// The quantities of an ingredient are scaled by the portion of the dish against the servings of its recipe,
+// and merged per item and unit type: into the existing grocery line of the item, or into a new line in the unit of its first ingredient.
+// What is already in the pantry is subtracted from these quantities. Ingredients without quantity, such as salt to taste, are left out.
+// Each dish is added once, even by concurrent generations. It returns the updated grocery list of the group.
+func (s *GroceryService) GenerateFromDishes(ctx context.Context, groupID int64, from, to time.Time) ([]model.Grocery, error) {
+	if !to.After(from) {
+		return nil, customErrors.NewValidationError("to", customErrors.DISH_PERIOD_FIELD_ERROR, nil)
+	}
+
+	for {
+		groceries, dishIDs, err := s.groceriesFromDishes(groupID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if len(dishIDs) == 0 {
+			break
+		}
+
+		err = s.repo.AddFromDishes(ctx, groceries, dishIDs)
+		if _, ok := errors.AsType[*customErrors.ConflictError](err); ok {
+			// Some dishes were flagged as bought by a concurrent generation in the meantime; what is left is computed again.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	return s.repo.GetByGroupID(groupID)
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update validates and replaces the item, unit and wanted quantity of the grocery line identified by grocery.ID.
+// The group and the bought quantity of a grocery line are kept.
+func (s *GroceryService) Update(grocery *model.Grocery) error {
+	currentGrocery, err := s.repo.GetByID(grocery.ID)
+	if err != nil {
+		return err
+	}
+
+	grocery.GroupID = currentGrocery.GroupID
+	grocery.QuantityBought = currentGrocery.QuantityBought
+
+	if err := s.validateGrocery(grocery); err != nil {
+		return err
+	}
+
+	return s.repo.Update(grocery)
+}
+
+// SetBought sets the quantity bought of the grocery line identified by id, moving the newly bought quantity into the pantry.
+// A nil quantity checks the line off, its whole wanted quantity being bought; lowering the quantity bought takes it back from the pantry.
+func (s *GroceryService) SetBought(ctx context.Context, id int64, quantityBought *float64) error {
+	if quantityBought != nil && *quantityBought < 0 {
+		return customErrors.NewInvalidParamsError([]string{"quantity_bought"}, nil)
+	}
+
+	for {
+		grocery, err := s.repo.GetByID(id)
+		if err != nil {
+			return err
+		}
+
+		previousQuantityBought := grocery.QuantityBought
+
+		if quantityBought == nil {
+			grocery.QuantityBought = grocery.UserQuantity
+		} else {
+			grocery.QuantityBought = *quantityBought
+		}
+
+		err = s.repo.SetBought(ctx, grocery, previousQuantityBought)
+		if _, ok := errors.AsType[*customErrors.ConflictError](err); ok {
+			// The quantity bought was changed by a concurrent call in the meantime; the newly bought quantity is computed again.
+			continue
+		}
+		return err
+	}
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the grocery line identified by id.
+func (s *GroceryService) Delete(id int64) error {
+	return s.repo.Delete(id)
+}
+
+// DeleteBought clears the grocery lines of a group whose whole quantity has been bought, returning how many were removed.
+func (s *GroceryService) DeleteBought(groupID int64) (int64, error) {
+	return s.repo.DeleteBought(groupID)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// validateGrocery checks the wanted quantity of the grocery line and ensures that its item belongs to its group,
+// and its unit to the catalogue or to its group.
+func (s *GroceryService) validateGrocery(grocery *model.Grocery) error {
+	if grocery.UserQuantity <= 0 {
+		return customErrors.NewInvalidParamsError([]string{"user_quantity"}, nil)
+	}
+
+	item, err := s.itemRepo.GetByID(grocery.Item.ID)
+	if err != nil {
+		return referenceError(err, "Item", "item must exists")
+	}
+	if item.GroupID != grocery.GroupID {
+		return customErrors.NewConflictError("Item", "item must belongs to the same group as the grocery", nil)
+	}
+
+	unit, err := s.unitRepo.GetByID(grocery.Unit.ID)
+	if err != nil {
+		return referenceError(err, "Unit", "unit must exists")
+	}
+	if unit.GroupID != nil && *unit.GroupID != grocery.GroupID {
+		return customErrors.NewConflictError("Unit", "unit must belongs to the catalogue or to the same group as the grocery", nil)
+	}
+
+	grocery.Item = *item
+	grocery.Unit = *unit
+
+	return nil
+}
+
+// groceriesFromDishes returns the groceries to add to the grocery list of a group for its dishes not bought yet,
+// scheduled from the from date included to the to date excluded, as described by GenerateFromDishes, and the IDs of these dishes.
+func (s *GroceryService) groceriesFromDishes(groupID int64, from, to time.Time) ([]model.Grocery, []int64, error) {
+	dishes, err := s.dishRepo.GetByGroupIDAndPeriod(groupID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currentGroceries, err := s.repo.GetByGroupID(groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Groceries are merged on the item and the unit type, the only units between which quantities can be converted.
+	type groceryKey struct {
+		itemID   int64
+		unitType enum.UnitType
+	}
+
+	existing := make(map[groceryKey]model.Grocery)
+	for _, grocery := range currentGroceries {
+		key := groceryKey{grocery.Item.ID, grocery.Unit.UnitType}
+		if _, ok := existing[key]; !ok {
+			existing[key] = grocery
+		}
+	}
+
+	// Quantities of the merged groceries are the ones to add to the list, in the order the ingredients are met.
+	merged := make(map[groceryKey]int)
+	groceries := []model.Grocery{}
+	dishIDs := []int64{}
+	recipes := make(map[int64]*model.Recipe)
+
+	for _, dish := range dishes {
+		if dish.Bought {
+			continue
+		}
+		dishIDs = append(dishIDs, dish.ID)
+
+		for _, dishRecipe := range dish.Recipes {
+			recipe, ok := recipes[dishRecipe.ID]
+			if !ok {
+				if recipe, err = s.recipeRepo.GetByID(dishRecipe.ID); err != nil {
+					return nil, nil, err
+				}
+				recipes[dishRecipe.ID] = recipe
+			}
+
+			scale := portionScale(&dish, recipe)
+			for _, ingredient := range recipe.Ingredients {
+				if ingredient.Quantity == nil {
+					continue
+				}
+
+				key := groceryKey{ingredient.Item.ID, ingredient.Unit.UnitType}
+				i, ok := merged[key]
+				if !ok {
+					grocery, ok := existing[key]
+					if !ok {
+						grocery = model.Grocery{Item: ingredient.Item, Unit: ingredient.Unit, GroupID: groupID}
+					}
+					grocery.UserQuantity = 0
+
+					groceries = append(groceries, grocery)
+					i = len(groceries) - 1
+					merged[key] = i
+				}
+
+				quantity, err := convertQuantity(*ingredient.Quantity*scale, &ingredient.Unit, &groceries[i].Unit)
+				if err != nil {
+					return nil, nil, err
+				}
+				groceries[i].UserQuantity += quantity
+			}
+		}
+	}
+
+	pantryItems, err := s.pantryRepo.GetByGroupID(groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, pantryItem := range pantryItems {
+		i, ok := merged[groceryKey{pantryItem.Item.ID, pantryItem.Unit.UnitType}]
+		if !ok {
+			continue
+		}
+
+		stock, err := convertQuantity(pantryItem.Quantity, &pantryItem.Unit, &groceries[i].Unit)
+		if err != nil {
+			return nil, nil, err
+		}
+		groceries[i].UserQuantity -= stock
+	}
+
+	groceries = slices.DeleteFunc(groceries, func(grocery model.Grocery) bool {
+		return grocery.UserQuantity <= 0
+	})
+
+	return groceries, dishIDs, nil
+}
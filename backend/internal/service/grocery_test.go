@@ -0,0 +1,455 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockGroceryRepository struct {
+	groceries []model.Grocery
+	created   *model.Grocery
+	updated   *model.Grocery
+	deletedID int64
+	added     []model.Grocery
+	boughtIDs []int64
+	stocked   float64
+	// addErr and setBoughtErr are returned by the next call to AddFromDishes and SetBought only.
+	addErr       error
+	setBoughtErr error
+}
+
+func (m *MockGroceryRepository) HasItem(itemID int64) (bool, error) {
+	for _, grocery := range m.groceries {
+		if grocery.Item.ID == itemID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockGroceryRepository) GetByID(id int64) (*model.Grocery, error) {
+	for i := range m.groceries {
+		if m.groceries[i].ID == id {
+			grocery := m.groceries[i]
+			return &grocery, nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("groceries", "id", nil)
+}
+
+func (m *MockGroceryRepository) GetByGroupID(groupID int64) ([]model.Grocery, error) {
+	groceries := []model.Grocery{}
+	for _, grocery := range m.groceries {
+		if grocery.GroupID == groupID {
+			groceries = append(groceries, grocery)
+		}
+	}
+	return groceries, nil
+}
+
+func (m *MockGroceryRepository) Create(grocery *model.Grocery) (int64, error) {
+	m.created = grocery
+	return 42, nil
+}
+
+func (m *MockGroceryRepository) AddFromDishes(_ context.Context, groceries []model.Grocery, dishIDs []int64) error {
+	if err := m.addErr; err != nil {
+		m.addErr = nil
+		return err
+	}
+	m.added = groceries
+	m.boughtIDs = dishIDs
+	return nil
+}
+
+func (m *MockGroceryRepository) Update(grocery *model.Grocery) error {
+	m.updated = grocery
+	return nil
+}
+
+func (m *MockGroceryRepository) SetBought(_ context.Context, grocery *model.Grocery, previousQuantityBought float64) error {
+	if err := m.setBoughtErr; err != nil {
+		m.setBoughtErr = nil
+		return err
+	}
+	m.updated = grocery
+	m.stocked = grocery.QuantityBought - previousQuantityBought
+	return nil
+}
+
+func (m *MockGroceryRepository) Delete(id int64) error {
+	m.deletedID = id
+	return nil
+}
+
+func (m *MockGroceryRepository) DeleteBought(groupID int64) (int64, error) {
+	var deletedNb int64
+	groceries := []model.Grocery{}
+	for _, grocery := range m.groceries {
+		if grocery.GroupID == groupID && grocery.QuantityBought >= grocery.UserQuantity {
+			deletedNb++
+			continue
+		}
+		groceries = append(groceries, grocery)
+	}
+	m.groceries = groceries
+	return deletedNb, nil
+}
+
+// setUpGroceryServiceData builds a GroceryService whose repository contains a half bought grocery line of the first group.
+func setUpGroceryServiceData() (*GroceryService, *MockGroceryRepository) {
+	groceryRepo := &MockGroceryRepository{
+		groceries: []model.Grocery{
+			{ID: 1, QuantityBought: 250, UserQuantity: 500, Item: items[0], Unit: unitGram, GroupID: group1.ID},
+		},
+	}
+	unitRepo := &MockUnitRepository{units: []model.Unit{unitGram, unitPiece, unitHandful}}
+
+	return NewGroceryService(groceryRepo, setUpDataTestItem(), unitRepo, &MockDishRepository{}, &MockRecipeRepository{}, &MockPantryRepository{}), groceryRepo
+}
+
+func TestNewGroceryService(t *testing.T) {
+	repo := &MockGroceryRepository{}
+	itemRepo := NewMockItemRepository()
+	unitRepo := &MockUnitRepository{}
+	dishRepo := &MockDishRepository{}
+	recipeRepo := &MockRecipeRepository{}
+	pantryRepo := &MockPantryRepository{}
+
+	service := NewGroceryService(repo, itemRepo, unitRepo, dishRepo, recipeRepo, pantryRepo)
+
+	if service == nil {
+		t.Fatal("NewGroceryService() returned nil")
+	}
+	if service.repo != repo || service.itemRepo != itemRepo || service.unitRepo != unitRepo ||
+		service.dishRepo != dishRepo || service.recipeRepo != recipeRepo || service.pantryRepo != pantryRepo {
+		t.Error("NewGroceryService() repositories do not match the provided ones")
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreateGrocery(t *testing.T) {
+	tests := []struct {
+		name    string
+		grocery model.Grocery
+		err     error
+	}{
+		{
+			name:    "Valid grocery",
+			grocery: model.Grocery{QuantityBought: 3, UserQuantity: 3, Item: model.Item{ID: items[3].ID}, Unit: model.Unit{ID: unitPiece.ID}, GroupID: group1.ID},
+		},
+		{
+			name:    "Invalid quantity",
+			grocery: model.Grocery{UserQuantity: 0, Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitGram.ID}, GroupID: group1.ID},
+			err:     customErrors.NewInvalidParamsError([]string{"user_quantity"}, nil),
+		},
+		{
+			name:    "Unknown item",
+			grocery: model.Grocery{UserQuantity: 1, Item: model.Item{ID: invalidItemID}, Unit: model.Unit{ID: unitGram.ID}, GroupID: group1.ID},
+			err:     customErrors.NewConflictError("Item", "item must exists", nil),
+		},
+		{
+			name:    "Item of another group",
+			grocery: model.Grocery{UserQuantity: 1, Item: model.Item{ID: items[2].ID}, Unit: model.Unit{ID: unitGram.ID}, GroupID: group1.ID},
+			err:     customErrors.NewConflictError("Item", "item must belongs to the same group as the grocery", nil),
+		},
+		{
+			name:    "Unknown unit",
+			grocery: model.Grocery{UserQuantity: 1, Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: -1}, GroupID: group1.ID},
+			err:     customErrors.NewConflictError("Unit", "unit must exists", nil),
+		},
+		{
+			name:    "Custom unit of another group",
+			grocery: model.Grocery{UserQuantity: 1, Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitHandful.ID}, GroupID: group1.ID},
+			err:     customErrors.NewConflictError("Unit", "unit must belongs to the catalogue or to the same group as the grocery", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpGroceryServiceData()
+
+			id, err := service.Create(&tt.grocery)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Create() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+			if id != 42 {
+				t.Errorf("Create() expected id 42, got %d", id)
+			}
+
+			// A new grocery line isn't bought yet, and its item and unit are resolved.
+			if repo.created.QuantityBought != 0 || repo.created.Item.Name != items[3].Name || repo.created.Unit.Name != unitPiece.Name {
+				t.Errorf("Create() unexpected created grocery %+v", *repo.created)
+			}
+		})
+	}
+}
+
+func TestGenerateGroceriesFromDishes(t *testing.T) {
+	recipes := []model.Recipe{
+		{
+			ID:       10,
+			Name:     "Crepes",
+			Servings: new(2),
+			GroupID:  group1.ID,
+			Ingredients: []model.Ingredient{
+				{Quantity: new(0.25), Item: items[0], Unit: unitKilogram},
+				{Quantity: new(1.0), Item: items[3], Unit: unitPiece},
+				{Item: items[1], Unit: unitGram},
+			},
+		},
+		{
+			ID:          11,
+			Name:        "Bread",
+			GroupID:     group1.ID,
+			Ingredients: []model.Ingredient{{Quantity: new(100.0), Item: items[0], Unit: unitGram}},
+		},
+	}
+	dishes := []model.Dish{
+		{ID: 1, Portion: 4, Datetime: dishDatetime, GroupID: group1.ID, Recipes: []model.Recipe{{ID: 10}, {ID: 11}}},
+		{ID: 2, Portion: 2, Bought: true, Datetime: dishDatetime, GroupID: group1.ID, Recipes: []model.Recipe{{ID: 10}}},
+		{ID: 3, Portion: 2, Datetime: dishDatetime.Add(7 * 24 * time.Hour), GroupID: group1.ID, Recipes: []model.Recipe{{ID: -1}}},
+	}
+	week := dishDatetime.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name              string
+		from              time.Time
+		to                time.Time
+		pantryItems       []model.PantryItem
+		addErr            error
+		expectedAdded     []model.Grocery
+		expectedBoughtIDs []int64
+		err               error
+	}{
+		{
+			name: "Unbought dishes of the period",
+			from: week,
+			to:   week.Add(7 * 24 * time.Hour),
+			expectedAdded: []model.Grocery{
+				// 0.25 kg for 2 servings, scaled to 4 portions, and 100 g of a recipe without servings,
+				// merged into the existing grocery line in grams
+				{ID: 1, QuantityBought: 250, UserQuantity: 600, Item: items[0], Unit: unitGram, GroupID: group1.ID},
+				{UserQuantity: 2, Item: items[3], Unit: unitPiece, GroupID: group1.ID},
+			},
+			expectedBoughtIDs: []int64{1},
+		},
+		{
+			name: "Ingredients in stock",
+			from: week,
+			to:   week.Add(7 * 24 * time.Hour),
+			pantryItems: []model.PantryItem{
+				{ID: 1, Quantity: 0.2, Item: items[0], Unit: unitKilogram, GroupID: group1.ID},
+				{ID: 2, Quantity: 5, Item: items[3], Unit: unitPiece, GroupID: group1.ID},
+				{ID: 3, Quantity: 1, Item: items[0], Unit: unitGram, GroupID: group2.ID},
+			},
+			// 600 g of flour needed minus the 200 g in stock; the stocked pepper isn't bought
+			expectedAdded: []model.Grocery{
+				{ID: 1, QuantityBought: 250, UserQuantity: 400, Item: items[0], Unit: unitGram, GroupID: group1.ID},
+			},
+			expectedBoughtIDs: []int64{1},
+		},
+		{
+			name:   "Dishes bought by a concurrent generation",
+			from:   week,
+			to:     week.Add(7 * 24 * time.Hour),
+			addErr: customErrors.NewConflictError("Dish", "dish is already bought", nil),
+			// Computed again, then added
+			expectedAdded: []model.Grocery{
+				{ID: 1, QuantityBought: 250, UserQuantity: 600, Item: items[0], Unit: unitGram, GroupID: group1.ID},
+				{UserQuantity: 2, Item: items[3], Unit: unitPiece, GroupID: group1.ID},
+			},
+			expectedBoughtIDs: []int64{1},
+		},
+		{
+			name:   "Repository error",
+			from:   week,
+			to:     week.Add(7 * 24 * time.Hour),
+			addErr: customErrors.NewInternalError("failed to commit transaction", nil),
+			err:    customErrors.NewInternalError("failed to commit transaction", nil),
+		},
+		{
+			name: "Period without unbought dish",
+			from: week.Add(-7 * 24 * time.Hour),
+			to:   week,
+		},
+		{
+			name: "Unknown recipe",
+			from: week,
+			to:   week.Add(14 * 24 * time.Hour),
+			err:  customErrors.NewNotFoundError("recipes", "id", nil),
+		},
+		{
+			name: "Reversed period",
+			from: week,
+			to:   week.Add(-24 * time.Hour),
+			err:  customErrors.NewValidationError("to", customErrors.DISH_PERIOD_FIELD_ERROR, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, groceryRepo := setUpGroceryServiceData()
+			groceryRepo.addErr = tt.addErr
+			service := NewGroceryService(groceryRepo, setUpDataTestItem(), &MockUnitRepository{},
+				&MockDishRepository{dishes: dishes}, &MockRecipeRepository{recipes: recipes}, &MockPantryRepository{pantryItems: tt.pantryItems})
+
+			actual, err := service.GenerateFromDishes(context.Background(), group1.ID, tt.from, tt.to)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("GenerateFromDishes() error = %v, want %v", err, tt.err)
+				}
+				if groceryRepo.added != nil {
+					t.Errorf("GenerateFromDishes() expected nothing to be added, got %v", groceryRepo.added)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GenerateFromDishes() unexpected error = %v", err)
+			}
+
+			if !reflect.DeepEqual(groceryRepo.added, tt.expectedAdded) {
+				t.Errorf("GenerateFromDishes() added %v, want %v", groceryRepo.added, tt.expectedAdded)
+			}
+			if !reflect.DeepEqual(groceryRepo.boughtIDs, tt.expectedBoughtIDs) {
+				t.Errorf("GenerateFromDishes() flagged dishes %v as bought, want %v", groceryRepo.boughtIDs, tt.expectedBoughtIDs)
+			}
+			if len(actual) != 1 {
+				t.Errorf("GenerateFromDishes() expected the grocery list of the group, got %v", actual)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestUpdateGrocery(t *testing.T) {
+	tests := []struct {
+		name    string
+		grocery model.Grocery
+		err     error
+	}{
+		{
+			name:    "Valid update",
+			grocery: model.Grocery{ID: 1, UserQuantity: 1000, Item: model.Item{ID: items[1].ID}, Unit: model.Unit{ID: unitGram.ID}, GroupID: group2.ID},
+		},
+		{
+			name:    "Unknown grocery",
+			grocery: model.Grocery{ID: -1, UserQuantity: 1, Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitGram.ID}},
+			err:     customErrors.NewNotFoundError("groceries", "id", nil),
+		},
+		{
+			name:    "Item of another group",
+			grocery: model.Grocery{ID: 1, UserQuantity: 1, Item: model.Item{ID: items[2].ID}, Unit: model.Unit{ID: unitGram.ID}},
+			err:     customErrors.NewConflictError("Item", "item must belongs to the same group as the grocery", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpGroceryServiceData()
+
+			err := service.Update(&tt.grocery)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Update() unexpected error = %v", err)
+			}
+
+			// The group and the bought quantity are kept.
+			if repo.updated.GroupID != group1.ID || repo.updated.QuantityBought != 250 || repo.updated.UserQuantity != 1000 {
+				t.Errorf("Update() unexpected updated grocery %+v", *repo.updated)
+			}
+		})
+	}
+}
+
+func TestSetGroceryBought(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             int64
+		quantityBought *float64
+		setBoughtErr   error
+		expected       float64
+		// The 250 g already bought are in the pantry.
+		expectedStocked float64
+		err             error
+	}{
+		{"Check off", 1, nil, nil, 500, 250, nil},
+		{"Partial quantity", 1, new(300.0), nil, 300, 50, nil},
+		{"Nothing bought", 1, new(0.0), nil, 0, -250, nil},
+		// Read again, then set
+		{"Changed in the meantime", 1, nil, customErrors.NewConflictError("Grocery", "quantity bought was changed in the meantime", nil), 500, 250, nil},
+		{"Negative quantity", 1, new(-1.0), nil, 0, 0, customErrors.NewInvalidParamsError([]string{"quantity_bought"}, nil)},
+		{"Unknown grocery", -1, nil, nil, 0, 0, customErrors.NewNotFoundError("groceries", "id", nil)},
+		{"Repository error", 1, nil, customErrors.NewInternalError("failed to commit transaction", nil), 0, 0, customErrors.NewInternalError("failed to commit transaction", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpGroceryServiceData()
+			repo.setBoughtErr = tt.setBoughtErr
+
+			err := service.SetBought(context.Background(), tt.id, tt.quantityBought)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("SetBought() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("SetBought() unexpected error = %v", err)
+			}
+			if repo.updated.QuantityBought != tt.expected || repo.updated.UserQuantity != 500 {
+				t.Errorf("SetBought() expected %v bought out of 500, got %+v", tt.expected, *repo.updated)
+			}
+			if repo.stocked != tt.expectedStocked {
+				t.Errorf("SetBought() expected %v to be stocked, got %v", tt.expectedStocked, repo.stocked)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS ***/
+
+func TestDeleteBoughtGroceries(t *testing.T) {
+	service, repo := setUpGroceryServiceData()
+
+	if err := service.SetBought(context.Background(), 1, nil); err != nil {
+		t.Fatalf("SetBought() unexpected error = %v", err)
+	}
+	repo.groceries[0] = *repo.updated
+
+	deletedNb, err := service.DeleteBought(group1.ID)
+	if err != nil {
+		t.Fatalf("DeleteBought() unexpected error = %v", err)
+	}
+	if deletedNb != 1 || len(repo.groceries) != 0 {
+		t.Errorf("DeleteBought() expected the bought grocery to be deleted, got %d deleted and %v", deletedNb, repo.groceries)
+	}
+}
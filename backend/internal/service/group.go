@@ -1,24 +1,160 @@
 package service
 
 import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
 	"github.com/zouipo/yumsday/backend/internal/repository"
 )
 
+// GroupServiceInterface defines the contract for group service operations.
 type GroupServiceInterface interface {
 	GetByID(id int64) (*model.Group, error)
+	GetByUserID(userID int64) ([]model.Group, error)
+	GetMember(groupID, userID int64) (*model.GroupMember, error)
+	Create(ctx context.Context, group *model.Group, creatorID int64) (int64, error)
+	Update(group *model.Group) error
+	Delete(ctx context.Context, id int64) error
+	AddMember(member *model.GroupMember) error
+	UpdateMemberAdmin(groupID, userID int64, admin bool) error
+	RemoveMember(groupID, userID int64) error
 }
 
 type GroupService struct {
 	repo repository.GroupRepositoryInterface
 }
 
+// NewGroupService creates a new GroupService using the provided GroupRepository.
 func NewGroupService(repo repository.GroupRepositoryInterface) *GroupService {
 	return &GroupService{
 		repo: repo,
 	}
 }
 
+/*** READ OPERATIONS ***/
+
+// GetByID returns the group identified by id, with its members, or an error if not found.
 func (s *GroupService) GetByID(id int64) (*model.Group, error) {
 	return s.repo.GetByID(id)
 }
+
+// GetByUserID returns all the groups the user is a member of.
+func (s *GroupService) GetByUserID(userID int64) ([]model.Group, error) {
+	return s.repo.GetByUserID(userID)
+}
+
+// GetMember returns the membership of a user in a group, or a NotFoundError if they are not a member of it.
+func (s *GroupService) GetMember(groupID, userID int64) (*model.GroupMember, error) {
+	return s.repo.GetMember(groupID, userID)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create validates and creates a new group whose creator becomes the admin, returning the new group ID.
+func (s *GroupService) Create(ctx context.Context, group *model.Group, creatorID int64) (int64, error) {
+	group.CreatedAt = time.Now().UTC()
+
+	if err := validateGroup(group); err != nil {
+		return 0, err
+	}
+
+	return s.repo.Create(ctx, group, creatorID)
+}
+
+// AddMember adds a user to an existing group.
+func (s *GroupService) AddMember(member *model.GroupMember) error {
+	if _, err := s.repo.GetByID(member.GroupId); err != nil {
+		return err
+	}
+
+	member.JoinedAt = time.Now().UTC()
+
+	return s.repo.AddMember(member)
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update renames a group and/or changes its image after validation.
+func (s *GroupService) Update(group *model.Group) error {
+	if err := validateGroup(group); err != nil {
+		return err
+	}
+
+	return s.repo.Update(group)
+}
+
+// UpdateMemberAdmin promotes or demotes a member of the group.
+// The last admin of a group cannot be demoted.
+func (s *GroupService) UpdateMemberAdmin(groupID, userID int64, admin bool) error {
+	if !admin {
+		if err := s.checkNotLastAdmin(groupID, userID); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.UpdateMemberAdmin(groupID, userID, admin)
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes a group along with all its dependent data.
+func (s *GroupService) Delete(ctx context.Context, id int64) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// RemoveMember removes a user from a group, whether they leave it or are removed by an admin.
+// The last admin of a group cannot be removed.
+func (s *GroupService) RemoveMember(groupID, userID int64) error {
+	if err := s.checkNotLastAdmin(groupID, userID); err != nil {
+		return err
+	}
+
+	return s.repo.RemoveMember(groupID, userID)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// validateGroup trims the name of the group and checks it is neither empty nor too long.
+func validateGroup(group *model.Group) error {
+	group.Name = strings.TrimSpace(group.Name)
+
+	if group.Name == "" || len(group.Name) > 100 {
+		slog.Debug(customErrors.GROUP_NAME_FIELD_ERROR, "name", group.Name)
+		return customErrors.NewValidationError("name", customErrors.GROUP_NAME_FIELD_ERROR, nil)
+	}
+
+	return nil
+}
+
+// checkNotLastAdmin returns a ConflictError if the user is the only admin left in the group,
+// or a NotFoundError if they are not a member of it.
+func (s *GroupService) checkNotLastAdmin(groupID, userID int64) error {
+	group, err := s.repo.GetByID(groupID)
+	if err != nil {
+		return err
+	}
+
+	isMember, isAdmin, admins := false, false, 0
+	for _, member := range group.Members {
+		if member.Admin {
+			admins++
+		}
+		if member.UserID == userID {
+			isMember, isAdmin = true, member.Admin
+		}
+	}
+
+	if !isMember {
+		return customErrors.NewNotFoundError("group_members", "group_id, user_id", nil)
+	}
+
+	if isAdmin && admins == 1 {
+		return customErrors.NewConflictError("GroupMember", "the last admin of a group cannot leave it or be demoted", nil)
+	}
+
+	return nil
+}
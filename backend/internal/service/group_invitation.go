@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+// defaultInvitationLifetime is the validity of an invitation created without an explicit expiry date.
+const defaultInvitationLifetime = 7 * 24 * time.Hour
+
+// GroupInvitationServiceInterface defines the contract for group invitation service operations.
+type GroupInvitationServiceInterface interface {
+	GetByGroupID(groupID int64) ([]model.GroupInvitation, error)
+	Create(invitation *model.GroupInvitation) (int64, error)
+	Revoke(groupID, id int64) error
+	Accept(ctx context.Context, token string, userID int64) (int64, error)
+}
+
+type GroupInvitationService struct {
+	repo repository.GroupInvitationRepositoryInterface
+}
+
+// NewGroupInvitationService creates a new GroupInvitationService using the provided GroupInvitationRepository.
+func NewGroupInvitationService(repo repository.GroupInvitationRepositoryInterface) *GroupInvitationService {
+	return &GroupInvitationService{
+		repo: repo,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByGroupID returns all the invitations of a group, including the expired and revoked ones.
+func (s *GroupInvitationService) GetByGroupID(groupID int64) ([]model.GroupInvitation, error) {
+	return s.repo.GetByGroupID(groupID)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create generates a new invitation token for the group of the invitation and stores it.
+// An invitation without expiry date is valid for 7 days; one without max uses can be used until it expires.
+func (s *GroupInvitationService) Create(invitation *model.GroupInvitation) (int64, error) {
+	now := time.Now().UTC()
+
+	if invitation.ExpiresAt.IsZero() {
+		invitation.ExpiresAt = now.Add(defaultInvitationLifetime)
+	} else if !invitation.ExpiresAt.After(now) {
+		return 0, customErrors.NewValidationError("expires_at", "expiry date must be in the future", nil)
+	}
+
+	if invitation.MaxUses != nil && *invitation.MaxUses < 1 {
+		return 0, customErrors.NewValidationError("max_uses", "max uses must be at least 1", nil)
+	}
+
+	invitation.Token = utils.GenerateInvitationToken()
+	invitation.CreatedAt = now
+	invitation.Uses = 0
+	invitation.Revoked = false
+
+	id, err := s.repo.Create(invitation)
+	if err != nil {
+		return 0, err
+	}
+
+	invitation.ID = id
+
+	return id, nil
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Revoke prevents any further use of the invitation, which must belong to the given group.
+func (s *GroupInvitationService) Revoke(groupID, id int64) error {
+	invitation, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if invitation.GroupID != groupID {
+		return customErrors.NewNotFoundError("group_invitations", "id", nil)
+	}
+
+	return s.repo.Revoke(id)
+}
+
+// Accept adds the user to the group of the invitation identified by the token, as a simple member.
+// Returns the ID of the joined group.
+func (s *GroupInvitationService) Accept(ctx context.Context, token string, userID int64) (int64, error) {
+	invitation, err := s.repo.GetByToken(token)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+
+	switch {
+	case invitation.Revoked:
+		return 0, customErrors.NewConflictError("GroupInvitation", "invitation has been revoked", nil)
+	case !invitation.ExpiresAt.After(now):
+		return 0, customErrors.NewConflictError("GroupInvitation", "invitation has expired", nil)
+	case invitation.MaxUses != nil && invitation.Uses >= *invitation.MaxUses:
+		return 0, customErrors.NewConflictError("GroupInvitation", "invitation has no use left", nil)
+	}
+
+	member := &model.GroupMember{
+		UserID:   userID,
+		GroupId:  invitation.GroupID,
+		Admin:    false,
+		JoinedAt: now,
+	}
+
+	if err := s.repo.Use(ctx, invitation.ID, member); err != nil {
+		return 0, err
+	}
+
+	return invitation.GroupID, nil
+}
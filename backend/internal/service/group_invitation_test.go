@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockGroupInvitationRepository struct {
+	invitations []model.GroupInvitation
+	members     []model.GroupMember
+	nextID      int64
+	createErr   error
+	useErr      error
+}
+
+func (m *MockGroupInvitationRepository) GetByID(id int64) (*model.GroupInvitation, error) {
+	for i := range m.invitations {
+		if m.invitations[i].ID == id {
+			return &m.invitations[i], nil
+		}
+	}
+
+	return nil, customErrors.NewNotFoundError("group_invitations", "id", nil)
+}
+
+func (m *MockGroupInvitationRepository) GetByToken(token string) (*model.GroupInvitation, error) {
+	for i := range m.invitations {
+		if m.invitations[i].Token == token {
+			return &m.invitations[i], nil
+		}
+	}
+
+	return nil, customErrors.NewNotFoundError("group_invitations", "token", nil)
+}
+
+func (m *MockGroupInvitationRepository) GetByGroupID(groupID int64) ([]model.GroupInvitation, error) {
+	invitations := []model.GroupInvitation{}
+	for _, invitation := range m.invitations {
+		if invitation.GroupID == groupID {
+			invitations = append(invitations, invitation)
+		}
+	}
+
+	return invitations, nil
+}
+
+func (m *MockGroupInvitationRepository) Create(invitation *model.GroupInvitation) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	invitation.ID = m.nextID
+	m.invitations = append(m.invitations, *invitation)
+	m.nextID++
+
+	return invitation.ID, nil
+}
+
+func (m *MockGroupInvitationRepository) Revoke(id int64) error {
+	invitation, err := m.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	invitation.Revoked = true
+
+	return nil
+}
+
+func (m *MockGroupInvitationRepository) Use(_ context.Context, id int64, member *model.GroupMember) error {
+	if m.useErr != nil {
+		return m.useErr
+	}
+
+	invitation, err := m.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	invitation.Uses++
+	m.members = append(m.members, *member)
+
+	return nil
+}
+
+func setUpDataTestGroupInvitation() *MockGroupInvitationRepository {
+	now := time.Now().UTC()
+
+	return &MockGroupInvitationRepository{
+		invitations: []model.GroupInvitation{
+			{ID: 1, Token: "valid", GroupID: 1, ExpiresAt: now.Add(time.Hour), MaxUses: new(int64(2)), Uses: 1},
+			{ID: 2, Token: "unlimited", GroupID: 1, ExpiresAt: now.Add(time.Hour), Uses: 42},
+			{ID: 3, Token: "expired", GroupID: 1, ExpiresAt: now.Add(-time.Hour)},
+			{ID: 4, Token: "revoked", GroupID: 1, ExpiresAt: now.Add(time.Hour), Revoked: true},
+			{ID: 5, Token: "exhausted", GroupID: 2, ExpiresAt: now.Add(time.Hour), MaxUses: new(int64(1)), Uses: 1},
+		},
+		nextID: 6,
+	}
+}
+
+func TestNewGroupInvitationService(t *testing.T) {
+	mockRepo := &MockGroupInvitationRepository{}
+
+	service := NewGroupInvitationService(mockRepo)
+
+	if service == nil {
+		t.Fatal("NewGroupInvitationService returned nil")
+	}
+
+	if service.repo == nil {
+		t.Fatal("NewGroupInvitationService repo is nil")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetGroupInvitationsByGroupID(t *testing.T) {
+	m := setUpDataTestGroupInvitation()
+	s := NewGroupInvitationService(m)
+
+	invitations, err := s.GetByGroupID(1)
+	if err != nil {
+		t.Fatalf("GetByGroupID() unexpected error = %v", err)
+	}
+
+	if len(invitations) != 4 {
+		t.Errorf("GetByGroupID() expected 4 invitations, got %d", len(invitations))
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreateGroupInvitation(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name              string
+		invitation        *model.GroupInvitation
+		err               error
+		expectedExpiresAt time.Time
+		expectedErr       error
+	}{
+		{
+			name:              "Explicit expiry and max uses",
+			invitation:        &model.GroupInvitation{GroupID: 1, ExpiresAt: now.Add(time.Hour), MaxUses: new(int64(1)), Uses: 3, Revoked: true},
+			expectedExpiresAt: now.Add(time.Hour),
+		},
+		{
+			name:              "Default expiry",
+			invitation:        &model.GroupInvitation{GroupID: 1},
+			expectedExpiresAt: now.Add(defaultInvitationLifetime),
+		},
+		{
+			name:        "Expiry in the past",
+			invitation:  &model.GroupInvitation{GroupID: 1, ExpiresAt: now.Add(-time.Minute)},
+			expectedErr: customErrors.NewValidationError("expires_at", "expiry date must be in the future", nil),
+		},
+		{
+			name:        "Max uses lower than 1",
+			invitation:  &model.GroupInvitation{GroupID: 1, MaxUses: new(int64(0))},
+			expectedErr: customErrors.NewValidationError("max_uses", "max uses must be at least 1", nil),
+		},
+		{
+			name:        "Repository error",
+			invitation:  &model.GroupInvitation{GroupID: -1},
+			err:         customErrors.NewNotFoundError("groups", "id", nil),
+			expectedErr: customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroupInvitation()
+			m.createErr = tt.err
+			s := NewGroupInvitationService(m)
+
+			id, err := s.Create(tt.invitation)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if id != 6 || tt.invitation.ID != 6 {
+				t.Errorf("Create() expected ID 6, got %d (invitation %d)", id, tt.invitation.ID)
+			}
+			if len(tt.invitation.Token) != 43 {
+				t.Errorf("Create() expected a generated token, got %q", tt.invitation.Token)
+			}
+			if tt.invitation.Uses != 0 || tt.invitation.Revoked {
+				t.Errorf("Create() expected a fresh invitation, got %d uses and revoked %v", tt.invitation.Uses, tt.invitation.Revoked)
+			}
+			if !utils.TimesApproximatelyEqual(tt.invitation.ExpiresAt, tt.expectedExpiresAt, time.Minute) {
+				t.Errorf("Create() expected ExpiresAt around %v, got %v", tt.expectedExpiresAt, tt.invitation.ExpiresAt)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestRevokeGroupInvitation(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupID     int64
+		id          int64
+		expectedErr error
+	}{
+		{name: "Invitation of the group", groupID: 1, id: 1},
+		{
+			name:        "Invitation of another group",
+			groupID:     2,
+			id:          1,
+			expectedErr: customErrors.NewNotFoundError("group_invitations", "id", nil),
+		},
+		{
+			name:        "Unknown invitation",
+			groupID:     1,
+			id:          -1,
+			expectedErr: customErrors.NewNotFoundError("group_invitations", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroupInvitation()
+			s := NewGroupInvitationService(m)
+
+			err := s.Revoke(tt.groupID, tt.id)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Revoke() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			invitation, _ := m.GetByID(tt.id)
+			if !invitation.Revoked {
+				t.Errorf("Revoke() expected invitation %d to be revoked", tt.id)
+			}
+		})
+	}
+}
+
+func TestAcceptGroupInvitation(t *testing.T) {
+	tests := []struct {
+		name            string
+		token           string
+		useErr          error
+		expectedGroupID int64
+		expectedErr     error
+	}{
+		{name: "Valid invitation", token: "valid", expectedGroupID: 1},
+		{name: "Unlimited invitation", token: "unlimited", expectedGroupID: 1},
+		{
+			name:        "Unknown token",
+			token:       "unknown",
+			expectedErr: customErrors.NewNotFoundError("group_invitations", "token", nil),
+		},
+		{
+			name:        "Expired invitation",
+			token:       "expired",
+			expectedErr: customErrors.NewConflictError("GroupInvitation", "invitation has expired", nil),
+		},
+		{
+			name:        "Revoked invitation",
+			token:       "revoked",
+			expectedErr: customErrors.NewConflictError("GroupInvitation", "invitation has been revoked", nil),
+		},
+		{
+			name:        "Exhausted invitation",
+			token:       "exhausted",
+			expectedErr: customErrors.NewConflictError("GroupInvitation", "invitation has no use left", nil),
+		},
+		{
+			name:        "User already member",
+			token:       "valid",
+			useErr:      customErrors.NewConflictError("GroupMember", "user is already a member of the group", nil),
+			expectedErr: customErrors.NewConflictError("GroupMember", "user is already a member of the group", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroupInvitation()
+			m.useErr = tt.useErr
+			s := NewGroupInvitationService(m)
+
+			groupID, err := s.Accept(context.Background(), tt.token, 7)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Accept() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				if len(m.members) != 0 {
+					t.Errorf("Accept() expected no member added, got %d", len(m.members))
+				}
+				return
+			}
+
+			if groupID != tt.expectedGroupID {
+				t.Errorf("Accept() expected group %d, got %d", tt.expectedGroupID, groupID)
+			}
+			if len(m.members) != 1 {
+				t.Fatalf("Accept() expected 1 member added, got %d", len(m.members))
+			}
+
+			member := m.members[0]
+			if member.UserID != 7 || member.GroupId != tt.expectedGroupID || member.Admin {
+				t.Errorf("Accept() unexpected member %+v", member)
+			}
+		})
+	}
+}
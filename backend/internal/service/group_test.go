@@ -1,7 +1,9 @@
 package service
 
 import (
+	"context"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,8 +18,15 @@ var (
 )
 
 type MockGroupRepository struct {
-	groups     []model.Group
-	getByIDErr error
+	groups         []model.Group
+	nextID         int64
+	getByIDErr     error
+	getByUserIDErr error
+	createErr      error
+	updateErr      error
+	deleteErr      error
+	addMemberErr   error
+	lastAdminID    int64
 }
 
 func NewMockGroupRepository() *MockGroupRepository {
@@ -40,6 +49,131 @@ func (m *MockGroupRepository) GetByID(id int64) (*model.Group, error) {
 	return nil, customErrors.NewNotFoundError("groups", "groups.id", nil)
 }
 
+func (m *MockGroupRepository) GetByUserID(userID int64) ([]model.Group, error) {
+	if m.getByUserIDErr != nil {
+		return nil, m.getByUserIDErr
+	}
+
+	groups := []model.Group{}
+	for _, group := range m.groups {
+		for _, member := range group.Members {
+			if member.UserID == userID {
+				groups = append(groups, group)
+				break
+			}
+		}
+	}
+
+	return groups, nil
+}
+
+func (m *MockGroupRepository) GetMember(groupID, userID int64) (*model.GroupMember, error) {
+	group, err := m.GetByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range group.Members {
+		if group.Members[i].UserID == userID {
+			return &group.Members[i], nil
+		}
+	}
+
+	return nil, customErrors.NewNotFoundError("group_members", "group_id, user_id", nil)
+}
+
+func (m *MockGroupRepository) Create(_ context.Context, group *model.Group, adminID int64) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	m.lastAdminID = adminID
+	group.ID = m.nextID
+	group.Members = []model.GroupMember{{UserID: adminID, GroupId: group.ID, Admin: true, JoinedAt: group.CreatedAt}}
+	m.groups = append(m.groups, *group)
+	m.nextID++
+
+	return group.ID, nil
+}
+
+func (m *MockGroupRepository) Update(group *model.Group) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+
+	for i := range m.groups {
+		if m.groups[i].ID == group.ID {
+			m.groups[i].Name = group.Name
+			m.groups[i].ImageURL = group.ImageURL
+			return nil
+		}
+	}
+
+	return customErrors.NewNotFoundError("groups", "id", nil)
+}
+
+func (m *MockGroupRepository) Delete(_ context.Context, id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	for i := range m.groups {
+		if m.groups[i].ID == id {
+			m.groups = append(m.groups[:i], m.groups[i+1:]...)
+			return nil
+		}
+	}
+
+	return customErrors.NewNotFoundError("groups", "id", nil)
+}
+
+func (m *MockGroupRepository) AddMember(member *model.GroupMember) error {
+	if m.addMemberErr != nil {
+		return m.addMemberErr
+	}
+
+	group, err := m.GetByID(member.GroupId)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range group.Members {
+		if existing.UserID == member.UserID {
+			return customErrors.NewConflictError("GroupMember", "user is already a member of the group", nil)
+		}
+	}
+	group.Members = append(group.Members, *member)
+
+	return nil
+}
+
+func (m *MockGroupRepository) UpdateMemberAdmin(groupID, userID int64, admin bool) error {
+	member, err := m.GetMember(groupID, userID)
+	if err != nil {
+		return err
+	}
+
+	member.Admin = admin
+
+	return nil
+}
+
+func (m *MockGroupRepository) RemoveMember(groupID, userID int64) error {
+	group, err := m.GetByID(groupID)
+	if err != nil {
+		return err
+	}
+
+	for i := range group.Members {
+		if group.Members[i].UserID == userID {
+			group.Members = append(group.Members[:i], group.Members[i+1:]...)
+			return nil
+		}
+	}
+
+	return customErrors.NewNotFoundError("group_members", "group_id, user_id", nil)
+}
+
 func setUpDataTestGroup() *MockGroupRepository {
 	mockRepo := NewMockGroupRepository()
 	mockRepo.groups = append(mockRepo.groups, model.Group{
@@ -67,6 +201,7 @@ func setUpDataTestGroup() *MockGroupRepository {
 		CreatedAt: time.Now(),
 		Members:   []model.GroupMember{},
 	})
+	mockRepo.nextID = int64(groupID + 2)
 
 	return mockRepo
 }
@@ -147,3 +282,460 @@ func TestGetGroupByID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGroupsByUserID(t *testing.T) {
+	m := setUpDataTestGroup()
+	s := NewGroupService(m)
+
+	tests := []struct {
+		name        string
+		userID      int64
+		expectedIDs []int64
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "Member of a group",
+			userID:      2,
+			expectedIDs: []int64{int64(groupID)},
+		},
+		{
+			name:        "Member of no group",
+			userID:      99,
+			expectedIDs: []int64{},
+		},
+		{
+			name:        "Repository error",
+			userID:      2,
+			err:         customErrors.NewInternalError("failed to fetch groups", nil),
+			expectedErr: customErrors.NewInternalError("failed to fetch groups", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m.getByUserIDErr = tt.err
+
+			actual, err := s.GetByUserID(tt.userID)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("GetByUserID() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("GetByUserID() expected %d groups, got %d", len(tt.expectedIDs), len(actual))
+			}
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("GetByUserID() expected group %d, got %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreateGroup(t *testing.T) {
+	tests := []struct {
+		name         string
+		group        *model.Group
+		creatorID    int64
+		err          error
+		expectedID   int64
+		expectedName string
+		expectedErr  error
+	}{
+		{
+			name:         "Valid group",
+			group:        &model.Group{Name: "Neighbours", ImageURL: new("/static/images/neighbours.jpg")},
+			creatorID:    2,
+			expectedID:   int64(groupID + 2),
+			expectedName: "Neighbours",
+		},
+		{
+			name:         "Name is trimmed",
+			group:        &model.Group{Name: "  Neighbours  "},
+			creatorID:    2,
+			expectedID:   int64(groupID + 2),
+			expectedName: "Neighbours",
+		},
+		{
+			name:        "Empty name",
+			group:       &model.Group{Name: "   "},
+			creatorID:   2,
+			expectedErr: customErrors.NewValidationError("name", customErrors.GROUP_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:        "Name too long",
+			group:       &model.Group{Name: strings.Repeat("a", 101)},
+			creatorID:   2,
+			expectedErr: customErrors.NewValidationError("name", customErrors.GROUP_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:        "Unknown creator",
+			group:       &model.Group{Name: "Neighbours"},
+			creatorID:   99,
+			err:         customErrors.NewNotFoundError("users", "99", nil),
+			expectedErr: customErrors.NewNotFoundError("users", "99", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroup()
+			m.createErr = tt.err
+			s := NewGroupService(m)
+
+			id, err := s.Create(context.Background(), tt.group, tt.creatorID)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if id != tt.expectedID {
+				t.Errorf("Create() expected ID %d, got %d", tt.expectedID, id)
+			}
+			if tt.group.Name != tt.expectedName {
+				t.Errorf("Create() expected name %q, got %q", tt.expectedName, tt.group.Name)
+			}
+			if !utils.TimesApproximatelyEqual(tt.group.CreatedAt, time.Now().UTC(), time.Minute) {
+				t.Errorf("Create() expected CreatedAt around now, got %v", tt.group.CreatedAt)
+			}
+			if m.lastAdminID != tt.creatorID {
+				t.Errorf("Create() expected admin %d, got %d", tt.creatorID, m.lastAdminID)
+			}
+		})
+	}
+}
+
+func TestAddGroupMember(t *testing.T) {
+	tests := []struct {
+		name        string
+		member      *model.GroupMember
+		expectedErr error
+	}{
+		{
+			name:   "New member",
+			member: &model.GroupMember{UserID: 3, GroupId: int64(groupID)},
+		},
+		{
+			name:   "New admin",
+			member: &model.GroupMember{UserID: 3, GroupId: int64(groupID), Admin: true},
+		},
+		{
+			name:        "Already a member",
+			member:      &model.GroupMember{UserID: 2, GroupId: int64(groupID)},
+			expectedErr: customErrors.NewConflictError("GroupMember", "user is already a member of the group", nil),
+		},
+		{
+			name:        "Unknown group",
+			member:      &model.GroupMember{UserID: 3, GroupId: int64(invalidGroupID)},
+			expectedErr: customErrors.NewNotFoundError("groups", "groups.id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroup()
+			s := NewGroupService(m)
+
+			err := s.AddMember(tt.member)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("AddMember() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			member, err := m.GetMember(tt.member.GroupId, tt.member.UserID)
+			if err != nil {
+				t.Fatalf("AddMember() member not added: %v", err)
+			}
+			if member.Admin != tt.member.Admin {
+				t.Errorf("AddMember() expected admin %v, got %v", tt.member.Admin, member.Admin)
+			}
+			if !utils.TimesApproximatelyEqual(member.JoinedAt, time.Now().UTC(), time.Minute) {
+				t.Errorf("AddMember() expected JoinedAt around now, got %v", member.JoinedAt)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestUpdateGroup(t *testing.T) {
+	tests := []struct {
+		name        string
+		group       *model.Group
+		expectedErr error
+	}{
+		{
+			name:  "Rename and change image",
+			group: &model.Group{ID: int64(groupID), Name: "Home", ImageURL: new("/static/images/home.jpg")},
+		},
+		{
+			name:  "Remove image",
+			group: &model.Group{ID: int64(groupID), Name: "Family"},
+		},
+		{
+			name:        "Empty name",
+			group:       &model.Group{ID: int64(groupID), Name: ""},
+			expectedErr: customErrors.NewValidationError("name", customErrors.GROUP_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:        "Unknown group",
+			group:       &model.Group{ID: int64(invalidGroupID), Name: "Home"},
+			expectedErr: customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroup()
+			s := NewGroupService(m)
+
+			err := s.Update(tt.group)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			actual, _ := m.GetByID(tt.group.ID)
+			if actual.Name != tt.group.Name {
+				t.Errorf("Update() expected name %q, got %q", tt.group.Name, actual.Name)
+			}
+			if !reflect.DeepEqual(actual.ImageURL, tt.group.ImageURL) {
+				t.Errorf("Update() expected image %v, got %v", tt.group.ImageURL, actual.ImageURL)
+			}
+		})
+	}
+}
+
+func TestUpdateGroupMemberAdmin(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupID     int64
+		userID      int64
+		admin       bool
+		expectedErr error
+	}{
+		{
+			name:    "Promote a member",
+			groupID: int64(groupID),
+			userID:  2,
+			admin:   true,
+		},
+		{
+			name:        "Demote the last admin",
+			groupID:     int64(groupID),
+			userID:      1,
+			admin:       false,
+			expectedErr: customErrors.NewConflictError("GroupMember", "the last admin of a group cannot leave it or be demoted", nil),
+		},
+		{
+			name:    "Demote a member that is not admin",
+			groupID: int64(groupID),
+			userID:  2,
+			admin:   false,
+		},
+		{
+			name:        "Demote a user not member of the group",
+			groupID:     int64(groupID),
+			userID:      99,
+			admin:       false,
+			expectedErr: customErrors.NewNotFoundError("group_members", "group_id, user_id", nil),
+		},
+		{
+			name:        "Unknown group",
+			groupID:     int64(invalidGroupID),
+			userID:      1,
+			admin:       false,
+			expectedErr: customErrors.NewNotFoundError("groups", "groups.id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroup()
+			s := NewGroupService(m)
+
+			err := s.UpdateMemberAdmin(tt.groupID, tt.userID, tt.admin)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("UpdateMemberAdmin() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			member, _ := m.GetMember(tt.groupID, tt.userID)
+			if member.Admin != tt.admin {
+				t.Errorf("UpdateMemberAdmin() expected admin %v, got %v", tt.admin, member.Admin)
+			}
+		})
+	}
+}
+
+func TestDemoteAdminWithAnotherAdmin(t *testing.T) {
+	m := setUpDataTestGroup()
+	s := NewGroupService(m)
+
+	if err := s.UpdateMemberAdmin(int64(groupID), 2, true); err != nil {
+		t.Fatalf("UpdateMemberAdmin() unexpected error = %v", err)
+	}
+
+	if err := s.UpdateMemberAdmin(int64(groupID), 1, false); err != nil {
+		t.Fatalf("UpdateMemberAdmin() unexpected error when another admin remains = %v", err)
+	}
+}
+
+/*** DELETE OPERATIONS ***/
+
+func TestDeleteGroup(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupID     int64
+		err         error
+		expectedErr error
+	}{
+		{
+			name:    "Existing group",
+			groupID: int64(groupID),
+		},
+		{
+			name:        "Unknown group",
+			groupID:     int64(invalidGroupID),
+			expectedErr: customErrors.NewNotFoundError("groups", "id", nil),
+		},
+		{
+			name:        "Repository error",
+			groupID:     int64(groupID),
+			err:         customErrors.NewInternalError("failed to delete group", nil),
+			expectedErr: customErrors.NewInternalError("failed to delete group", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroup()
+			m.deleteErr = tt.err
+			s := NewGroupService(m)
+
+			err := s.Delete(context.Background(), tt.groupID)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Delete() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if _, err := m.GetByID(tt.groupID); err == nil {
+				t.Errorf("Delete() group %d still exists", tt.groupID)
+			}
+		})
+	}
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupID     int64
+		userID      int64
+		expectedErr error
+	}{
+		{
+			name:    "Remove a member",
+			groupID: int64(groupID),
+			userID:  2,
+		},
+		{
+			name:        "Remove the last admin",
+			groupID:     int64(groupID),
+			userID:      1,
+			expectedErr: customErrors.NewConflictError("GroupMember", "the last admin of a group cannot leave it or be demoted", nil),
+		},
+		{
+			name:        "Remove a user not member of the group",
+			groupID:     int64(groupID),
+			userID:      99,
+			expectedErr: customErrors.NewNotFoundError("group_members", "group_id, user_id", nil),
+		},
+		{
+			name:        "Unknown group",
+			groupID:     int64(invalidGroupID),
+			userID:      1,
+			expectedErr: customErrors.NewNotFoundError("groups", "groups.id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestGroup()
+			s := NewGroupService(m)
+
+			err := s.RemoveMember(tt.groupID, tt.userID)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("RemoveMember() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if _, err := m.GetMember(tt.groupID, tt.userID); err == nil {
+				t.Errorf("RemoveMember() user %d still member of group %d", tt.userID, tt.groupID)
+			}
+		})
+	}
+}
+
+func TestGetGroupMember(t *testing.T) {
+	m := setUpDataTestGroup()
+	s := NewGroupService(m)
+
+	tests := []struct {
+		name          string
+		groupID       int64
+		userID        int64
+		expectedAdmin bool
+		expectedErr   error
+	}{
+		{name: "Admin member", groupID: int64(groupID), userID: 1, expectedAdmin: true},
+		{name: "Simple member", groupID: int64(groupID), userID: 2, expectedAdmin: false},
+		{
+			name:        "User not member of the group",
+			groupID:     int64(groupID + 1),
+			userID:      1,
+			expectedErr: customErrors.NewNotFoundError("group_members", "group_id, user_id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member, err := s.GetMember(tt.groupID, tt.userID)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("GetMember() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if member.UserID != tt.userID || member.Admin != tt.expectedAdmin {
+				t.Errorf("GetMember() unexpected member %+v", member)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"errors"
 
+	"github.com/zouipo/yumsday/backend/internal/constant"
 	"github.com/zouipo/yumsday/backend/internal/model"
 	"github.com/zouipo/yumsday/backend/internal/repository"
 
@@ -13,6 +14,7 @@ type ItemServiceInterface interface {
 	GetByGroupID(groupID int64, sort string, descending bool) ([]model.Item, error)
 	GetByID(id int64) (*model.Item, error)
 	GetByName(name string, descending bool) ([]model.Item, error)
+	SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.Item, error)
 	GetRecipesByID(id int64, descending bool) ([]model.Recipe, error)
 	Create(item *model.Item) (int64, error)
 	Update(item *model.Item) error
@@ -66,6 +68,11 @@ func (s *ItemService) GetByName(name string, descending bool) ([]model.Item, err
 	return s.repo.GetByName(name, descending)
 }
 
+// SearchByNameAndGroupID returns the items of a group whose name contains the provided one, sorted by name.
+func (s *ItemService) SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.Item, error) {
+	return s.repo.SearchByNameAndGroupID(name, groupID, descending)
+}
+
 // GetRecipesByID returns the recipes in which the item is used.
 func (s *ItemService) GetRecipesByID(id int64, descending bool) ([]model.Recipe, error) {
 	return s.recipeService.GetByItemID(id, descending)
@@ -76,7 +83,7 @@ func (s *ItemService) GetRecipesByID(id int64, descending bool) ([]model.Recipe,
 func (s *ItemService) Create(item *model.Item) (int64, error) {
 	// if no item category is provided, assign the default one (uncategorized)
 	if item.ItemCategory.ID == 0 {
-		uncategorized, err := s.itemCategoryService.GetByNameAndGroupID("Uncategorized", item.GroupID)
+		uncategorized, err := s.itemCategoryService.GetByNameAndGroupID(constant.UNCATEGORIZED_ITEM_CATEGORY, item.GroupID)
 		if err != nil {
 			return 0, err
 		}
@@ -103,7 +110,7 @@ func (s *ItemService) Update(item *model.Item) error {
 
 	// If no item category is provided, assign the default one (uncategorized)
 	if item.ItemCategory.ID == 0 {
-		uncategorized, err := s.itemCategoryService.GetByNameAndGroupID("Uncategorized", item.GroupID)
+		uncategorized, err := s.itemCategoryService.GetByNameAndGroupID(constant.UNCATEGORIZED_ITEM_CATEGORY, item.GroupID)
 		if err != nil {
 			return err
 		}
@@ -158,18 +165,21 @@ func (s *ItemService) validateItem(item *model.Item) error {
 
 	// If the item is new, we check if the group exists. The group is not updated for existing items.
 	if item.ID == 0 {
-		if err = ensureEntityExists(s.groupService.GetByID, item.GroupID, "Group", "group must exists"); err != nil {
+		if _, err = ensureEntityExists(s.groupService.GetByID, item.GroupID, "Group", "group must exists"); err != nil {
 			return err
 		}
 	}
 
-	if err = ensureEntityExists(s.itemCategoryService.GetByID, item.ItemCategory.ID, "ItemCategory", "item category must exists"); err != nil {
+	// The item only references its category by ID, the category is loaded to check its group.
+	itemCategory, err := ensureEntityExists(s.itemCategoryService.GetByID, item.ItemCategory.ID, "ItemCategory", "item category must exists")
+	if err != nil {
 		return err
 	}
 
-	if item.ItemCategory.GroupID != item.GroupID {
+	if itemCategory.GroupID != item.GroupID {
 		return customErrors.NewConflictError("ItemCategory", "item category must belongs to the same group as the item", nil)
 	}
+	item.ItemCategory = *itemCategory
 
 	return nil
 }
@@ -194,16 +204,17 @@ func checkSimpleFields(item *model.Item) error {
 	return nil
 }
 
-// ensureEntityExists is a generic helper function that checks if an entity exists in the database using the provided getByID function.
-func ensureEntityExists[T any](getByID func(id int64) (T, error), id int64, entityType, errorMessage string) error {
-	_, err := getByID(id)
+// ensureEntityExists is a generic helper function that checks if an entity exists in the database using the provided getByID function,
+// and returns it.
+func ensureEntityExists[T any](getByID func(id int64) (T, error), id int64, entityType, errorMessage string) (T, error) {
+	entity, err := getByID(id)
 
 	if err != nil {
 		if _, isNotFoundError := errors.AsType[*customErrors.NotFoundError](err); isNotFoundError {
-			return customErrors.NewConflictError(entityType, errorMessage, nil)
+			return entity, customErrors.NewConflictError(entityType, errorMessage, nil)
 		}
-		return err
+		return entity, err
 	}
 
-	return nil
+	return entity, nil
 }
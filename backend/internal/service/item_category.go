@@ -1,13 +1,25 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
 	"github.com/zouipo/yumsday/backend/internal/repository"
 )
 
 type ItemCategoryServiceInterface interface {
 	GetByID(id int64) (*model.ItemCategory, error)
+	GetByGroupID(groupID int64) ([]model.ItemCategory, error)
 	GetByNameAndGroupID(name string, groupID int64) (*model.ItemCategory, error)
+	Create(category *model.ItemCategory) (int64, error)
+	Update(category *model.ItemCategory) error
+	Reorder(ctx context.Context, groupID int64, ids []int64) error
+	Delete(ctx context.Context, id int64) error
 }
 
 type ItemCategoryService struct {
@@ -20,10 +32,127 @@ func NewItemCategoryService(repo repository.ItemCategoryRepositoryInterface) *It
 	}
 }
 
+/*** READ OPERATIONS ***/
+
 func (s *ItemCategoryService) GetByID(id int64) (*model.ItemCategory, error) {
 	return s.repo.GetByID(id)
 }
 
+// GetByGroupID returns all the item categories of a group, sorted by their position.
+func (s *ItemCategoryService) GetByGroupID(groupID int64) ([]model.ItemCategory, error) {
+	return s.repo.GetByGroupID(groupID)
+}
+
 func (s *ItemCategoryService) GetByNameAndGroupID(name string, groupID int64) (*model.ItemCategory, error) {
 	return s.repo.GetByNameAndGroupID(name, groupID)
 }
+
+/*** CREATE OPERATIONS ***/
+
+// Create validates and adds a new item category after the last one of its group, returning its ID.
+func (s *ItemCategoryService) Create(category *model.ItemCategory) (int64, error) {
+	if err := s.validateItemCategory(category); err != nil {
+		return 0, err
+	}
+
+	return s.repo.Create(category)
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update validates and renames the item category identified by category.ID.
+// The group and position of a category can't be updated here, and the "Uncategorized" category can't be renamed.
+func (s *ItemCategoryService) Update(category *model.ItemCategory) error {
+	currentCategory, err := s.repo.GetByID(category.ID)
+	if err != nil {
+		return err
+	}
+
+	if isUncategorized(currentCategory) {
+		return customErrors.NewConflictError("ItemCategory", "the uncategorized item category can't be renamed", nil)
+	}
+
+	category.GroupID = currentCategory.GroupID
+	category.Position = currentCategory.Position
+
+	if err := s.validateItemCategory(category); err != nil {
+		return err
+	}
+
+	return s.repo.Update(category)
+}
+
+// Reorder sets the order of the item categories of a group.
+// ids must contain every category of the group exactly once, in their new order.
+func (s *ItemCategoryService) Reorder(ctx context.Context, groupID int64, ids []int64) error {
+	categories, err := s.repo.GetByGroupID(groupID)
+	if err != nil {
+		return err
+	}
+
+	remaining := make(map[int64]bool, len(categories))
+	for _, category := range categories {
+		remaining[category.ID] = true
+	}
+
+	if len(ids) != len(categories) {
+		return customErrors.NewValidationError("item_category_ids", customErrors.ITEM_CATEGORY_ORDER_FIELD_ERROR, nil)
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return customErrors.NewValidationError("item_category_ids", customErrors.ITEM_CATEGORY_ORDER_FIELD_ERROR, nil)
+		}
+		delete(remaining, id)
+	}
+
+	return s.repo.Reorder(ctx, groupID, ids)
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the item category identified by id, its items being moved to the "Uncategorized" category of the group.
+// The "Uncategorized" category itself can't be deleted.
+func (s *ItemCategoryService) Delete(ctx context.Context, id int64) error {
+	category, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if isUncategorized(category) {
+		return customErrors.NewConflictError("ItemCategory", "the uncategorized item category can't be deleted", nil)
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// validateItemCategory trims the name of the category, checks its length
+// and ensures no other category of the group already uses it, whatever its case.
+func (s *ItemCategoryService) validateItemCategory(category *model.ItemCategory) error {
+	category.Name = strings.TrimSpace(category.Name)
+
+	if category.Name == "" || len(category.Name) > 100 {
+		slog.Debug(customErrors.ITEM_CATEGORY_NAME_FIELD_ERROR, "name", category.Name)
+		return customErrors.NewValidationError("name", customErrors.ITEM_CATEGORY_NAME_FIELD_ERROR, nil)
+	}
+
+	existing, err := s.repo.GetByNameAndGroupID(category.Name, category.GroupID)
+	if err != nil {
+		if _, isNotFoundError := errors.AsType[*customErrors.NotFoundError](err); isNotFoundError {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != category.ID {
+		return customErrors.NewConflictError("ItemCategory", "an item category with this name already exists in the group", nil)
+	}
+
+	return nil
+}
+
+// isUncategorized reports whether the category is the "Uncategorized" one of its group.
+func isUncategorized(category *model.ItemCategory) bool {
+	return strings.EqualFold(category.Name, constant.UNCATEGORIZED_ITEM_CATEGORY)
+}
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"context"
 	"reflect"
+	"strings"
 	"testing"
 
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
@@ -20,6 +22,12 @@ type MockItemCategoryRepository struct {
 	itemCategories         []model.ItemCategory
 	getByIDErr             error
 	getByNameAndGroupIDErr error
+	createErr              error
+	reorderErr             error
+	created                *model.ItemCategory
+	updated                *model.ItemCategory
+	reordered              []int64
+	deletedID              int64
 }
 
 func NewMockItemCategoryRepository() *MockItemCategoryRepository {
@@ -48,7 +56,7 @@ func (m *MockItemCategoryRepository) GetByNameAndGroupID(name string, groupID in
 	}
 
 	for i := range m.itemCategories {
-		if m.itemCategories[i].Name == name && m.itemCategories[i].GroupID == groupID {
+		if strings.EqualFold(m.itemCategories[i].Name, name) && m.itemCategories[i].GroupID == groupID {
 			return &m.itemCategories[i], nil
 		}
 	}
@@ -56,6 +64,44 @@ func (m *MockItemCategoryRepository) GetByNameAndGroupID(name string, groupID in
 	return nil, customErrors.NewNotFoundError("item_categories", "items.name, items.group_id", nil)
 }
 
+func (m *MockItemCategoryRepository) GetByGroupID(groupID int64) ([]model.ItemCategory, error) {
+	itemCategories := []model.ItemCategory{}
+	for _, itemCategory := range m.itemCategories {
+		if itemCategory.GroupID == groupID {
+			itemCategories = append(itemCategories, itemCategory)
+		}
+	}
+	return itemCategories, nil
+}
+
+func (m *MockItemCategoryRepository) Create(category *model.ItemCategory) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	m.created = category
+	return 42, nil
+}
+
+func (m *MockItemCategoryRepository) Update(category *model.ItemCategory) error {
+	m.updated = category
+	return nil
+}
+
+func (m *MockItemCategoryRepository) Reorder(_ context.Context, _ int64, ids []int64) error {
+	if m.reorderErr != nil {
+		return m.reorderErr
+	}
+
+	m.reordered = ids
+	return nil
+}
+
+func (m *MockItemCategoryRepository) Delete(_ context.Context, id int64) error {
+	m.deletedID = id
+	return nil
+}
+
 func setUpDataTestIC() *MockItemCategoryRepository {
 	mockRepo := NewMockItemCategoryRepository()
 	mockRepo.itemCategories = append(mockRepo.itemCategories, model.ItemCategory{
@@ -73,6 +119,11 @@ func setUpDataTestIC() *MockItemCategoryRepository {
 		Name:    "DAIRY",
 		GroupID: 1,
 	})
+	mockRepo.itemCategories = append(mockRepo.itemCategories, model.ItemCategory{
+		ID:      int64(icID + 3),
+		Name:    "Uncategorized",
+		GroupID: 1,
+	})
 
 	return mockRepo
 }
@@ -222,3 +273,222 @@ func TestGetItemCategoryByNameAndGroupID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetItemCategoriesByGroupID(t *testing.T) {
+	m := setUpDataTestIC()
+	s := NewItemCategoryService(m)
+
+	actual, err := s.GetByGroupID(1)
+	if err != nil {
+		t.Fatalf("GetByGroupID() unexpected error = %v", err)
+	}
+
+	expected := []model.ItemCategory{m.itemCategories[0], m.itemCategories[2], m.itemCategories[3]}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("GetByGroupID() item categories mismatch: got %v, want %v", actual, expected)
+	}
+}
+
+func TestCreateItemCategory(t *testing.T) {
+	tests := []struct {
+		name         string
+		category     model.ItemCategory
+		expectedName string
+		createErr    error
+		expectedErr  error
+	}{
+		{
+			name:         "Valid category",
+			category:     model.ItemCategory{Name: "  FROZEN ", GroupID: 1},
+			expectedName: "FROZEN",
+		},
+		{
+			name:         "Name used by another group",
+			category:     model.ItemCategory{Name: "VEGETABLES", GroupID: 1},
+			expectedName: "VEGETABLES",
+		},
+		{
+			name:        "Empty name",
+			category:    model.ItemCategory{Name: " ", GroupID: 1},
+			expectedErr: customErrors.NewValidationError("name", customErrors.ITEM_CATEGORY_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:        "Too long name",
+			category:    model.ItemCategory{Name: strings.Repeat("a", 101), GroupID: 1},
+			expectedErr: customErrors.NewValidationError("name", customErrors.ITEM_CATEGORY_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:        "Name already used in the group",
+			category:    model.ItemCategory{Name: "dairy", GroupID: 1},
+			expectedErr: customErrors.NewConflictError("ItemCategory", "an item category with this name already exists in the group", nil),
+		},
+		{
+			name:        "Repository error",
+			category:    model.ItemCategory{Name: "FROZEN", GroupID: invalidICGroupID},
+			createErr:   customErrors.NewNotFoundError("groups", "id", nil),
+			expectedErr: customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestIC()
+			m.createErr = tt.createErr
+			s := NewItemCategoryService(m)
+
+			id, err := s.Create(&tt.category)
+
+			if tt.expectedErr != nil {
+				if !utils.CompareErrors(err, tt.expectedErr) {
+					t.Fatalf("Create() error = %v, want %v", err, tt.expectedErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+
+			if id != 42 || m.created.Name != tt.expectedName {
+				t.Fatalf("Create() created %v with id %d, want name %q", m.created, id, tt.expectedName)
+			}
+		})
+	}
+}
+
+func TestUpdateItemCategory(t *testing.T) {
+	tests := []struct {
+		name        string
+		category    model.ItemCategory
+		expectedErr error
+	}{
+		{
+			name:     "Rename",
+			category: model.ItemCategory{ID: 1, Name: "FRESH FRUITS"},
+		},
+		{
+			name:     "Change the case of its own name",
+			category: model.ItemCategory{ID: 1, Name: "Fruits"},
+		},
+		{
+			name:        "Name already used in the group",
+			category:    model.ItemCategory{ID: 1, Name: "DAIRY"},
+			expectedErr: customErrors.NewConflictError("ItemCategory", "an item category with this name already exists in the group", nil),
+		},
+		{
+			name:        "Rename the uncategorized category",
+			category:    model.ItemCategory{ID: 4, Name: "OTHERS"},
+			expectedErr: customErrors.NewConflictError("ItemCategory", "the uncategorized item category can't be renamed", nil),
+		},
+		{
+			name:        "Non existing ID",
+			category:    model.ItemCategory{ID: int64(invalidIcID), Name: "OTHERS"},
+			expectedErr: customErrors.NewNotFoundError("item_categories", "items.id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestIC()
+			s := NewItemCategoryService(m)
+
+			err := s.Update(&tt.category)
+
+			if tt.expectedErr != nil {
+				if !utils.CompareErrors(err, tt.expectedErr) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.expectedErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Update() unexpected error = %v", err)
+			}
+
+			if m.updated.GroupID != 1 || m.updated.Name != tt.category.Name {
+				t.Fatalf("Update() updated %v, want %q in group 1", m.updated, tt.category.Name)
+			}
+		})
+	}
+}
+
+func TestReorderItemCategories(t *testing.T) {
+	orderErr := customErrors.NewValidationError("item_category_ids", customErrors.ITEM_CATEGORY_ORDER_FIELD_ERROR, nil)
+
+	tests := []struct {
+		name        string
+		ids         []int64
+		expectedErr error
+	}{
+		{"Every category of the group", []int64{4, 3, 1}, nil},
+		{"Missing category", []int64{4, 3}, orderErr},
+		{"Duplicated category", []int64{4, 3, 3}, orderErr},
+		{"Category of another group", []int64{4, 3, 2}, orderErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestIC()
+			s := NewItemCategoryService(m)
+
+			err := s.Reorder(context.Background(), 1, tt.ids)
+
+			if tt.expectedErr != nil {
+				if !utils.CompareErrors(err, tt.expectedErr) {
+					t.Fatalf("Reorder() error = %v, want %v", err, tt.expectedErr)
+				}
+				if m.reordered != nil {
+					t.Fatal("Reorder() should not reach the repository on invalid order")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Reorder() unexpected error = %v", err)
+			}
+
+			if !reflect.DeepEqual(m.reordered, tt.ids) {
+				t.Fatalf("Reorder() reordered %v, want %v", m.reordered, tt.ids)
+			}
+		})
+	}
+}
+
+func TestDeleteItemCategory(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		expectedErr error
+	}{
+		{"Existing category", 1, nil},
+		{"Uncategorized category", 4, customErrors.NewConflictError("ItemCategory", "the uncategorized item category can't be deleted", nil)},
+		{"Non existing ID", int64(invalidIcID), customErrors.NewNotFoundError("item_categories", "items.id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := setUpDataTestIC()
+			s := NewItemCategoryService(m)
+
+			err := s.Delete(context.Background(), tt.id)
+
+			if tt.expectedErr != nil {
+				if !utils.CompareErrors(err, tt.expectedErr) {
+					t.Fatalf("Delete() error = %v, want %v", err, tt.expectedErr)
+				}
+				if m.deletedID != 0 {
+					t.Fatalf("Delete() should not have deleted category %d", m.deletedID)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Delete() unexpected error = %v", err)
+			}
+
+			if m.deletedID != tt.id {
+				t.Fatalf("Delete() deleted %d, want %d", m.deletedID, tt.id)
+			}
+		})
+	}
+}
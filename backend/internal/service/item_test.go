@@ -1,10 +1,13 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
@@ -155,6 +158,21 @@ func (m *MockItemRepository) GetByName(name string, desc bool) ([]model.Item, er
 	return utils.SortSliceByFieldName(result, "Name", desc), nil
 }
 
+func (m *MockItemRepository) SearchByNameAndGroupID(name string, groupID int64, desc bool) ([]model.Item, error) {
+	if m.getByNameErr != nil {
+		return nil, m.getByNameErr
+	}
+
+	result := make([]model.Item, 0)
+	for _, item := range m.items {
+		if item.GroupID == groupID && strings.Contains(strings.ToLower(item.Name), strings.ToLower(name)) {
+			result = append(result, item)
+		}
+	}
+
+	return utils.SortSliceByFieldName(result, "Name", desc), nil
+}
+
 func (m *MockItemRepository) Create(item *model.Item) (int64, error) {
 	if m.createErr != nil {
 		return 0, m.createErr
@@ -217,6 +235,46 @@ func (m *MockRecipeServiceForItem) GetByItemID(_ int64, _ bool) ([]model.Recipe,
 	return m.recipes, nil
 }
 
+func (m *MockRecipeServiceForItem) GetByID(_ int64) (*model.Recipe, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) GetByGroupID(_ int64, _ bool) ([]model.Recipe, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) SearchByNameAndGroupID(_ string, _ int64, _ bool) ([]model.Recipe, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) GetByAvailableItems(_ int64, _ []int64, _ *int) ([]model.RecipeMatch, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) Scale(_ *model.Recipe, _ int) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) NormalizeUnits(_ *model.Recipe) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) EstimateCost(_ *model.Recipe) (*model.CostEstimate, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) Create(_ context.Context, _ *model.Recipe) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) Update(_ context.Context, _ *model.Recipe) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockRecipeServiceForItem) Delete(_ context.Context, _ int64) error {
+	return errors.New("not implemented")
+}
+
 type MockGroceryServiceForItem struct {
 	hasItem bool
 	err     error
@@ -231,6 +289,38 @@ func (m *MockGroceryServiceForItem) HasItem(_ int64) (bool, error) {
 	return m.hasItem, nil
 }
 
+func (m *MockGroceryServiceForItem) GetByID(_ int64) (*model.Grocery, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockGroceryServiceForItem) GetByGroupID(_ int64) ([]model.Grocery, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockGroceryServiceForItem) Create(_ *model.Grocery) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (m *MockGroceryServiceForItem) GenerateFromDishes(_ context.Context, _ int64, _, _ time.Time) ([]model.Grocery, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockGroceryServiceForItem) Update(_ *model.Grocery) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockGroceryServiceForItem) SetBought(_ context.Context, _ int64, _ *float64) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockGroceryServiceForItem) Delete(_ int64) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockGroceryServiceForItem) DeleteBought(_ int64) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
 type MockGroupServiceForItem struct {
 	groups     []model.Group
 	getByIDErr error
@@ -251,6 +341,38 @@ func (m *MockGroupServiceForItem) GetByID(id int64) (*model.Group, error) {
 	return nil, customErrors.NewNotFoundError("groups", "id", nil)
 }
 
+func (m *MockGroupServiceForItem) GetByUserID(_ int64) ([]model.Group, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockGroupServiceForItem) GetMember(_, _ int64) (*model.GroupMember, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockGroupServiceForItem) Create(_ context.Context, _ *model.Group, _ int64) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (m *MockGroupServiceForItem) Update(_ *model.Group) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockGroupServiceForItem) Delete(_ context.Context, _ int64) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockGroupServiceForItem) AddMember(_ *model.GroupMember) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockGroupServiceForItem) UpdateMemberAdmin(_, _ int64, _ bool) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockGroupServiceForItem) RemoveMember(_, _ int64) error {
+	return errors.New("not implemented")
+}
+
 type MockItemCategoryServiceForItem struct {
 	itemCategories []model.ItemCategory
 	getByIDErr     error
@@ -287,6 +409,26 @@ func (m *MockItemCategoryServiceForItem) GetByNameAndGroupID(name string, groupI
 	return nil, customErrors.NewNotFoundError("item_categories", "name, group_id", nil)
 }
 
+func (m *MockItemCategoryServiceForItem) GetByGroupID(_ int64) ([]model.ItemCategory, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockItemCategoryServiceForItem) Create(_ *model.ItemCategory) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (m *MockItemCategoryServiceForItem) Update(_ *model.ItemCategory) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockItemCategoryServiceForItem) Reorder(_ context.Context, _ int64, _ []int64) error {
+	return errors.New("not implemented")
+}
+
+func (m *MockItemCategoryServiceForItem) Delete(_ context.Context, _ int64) error {
+	return errors.New("not implemented")
+}
+
 /*** HELPER ***/
 // Set up test data
 func setUpDataTestItem() *MockItemRepository {
@@ -631,6 +773,65 @@ func TestGetByName(t *testing.T) {
 	}
 }
 
+func TestSearchItemsByNameAndGroupID(t *testing.T) {
+	m := setUpDataTestItem()
+	s := newItemServiceForTest(
+		m,
+		&MockRecipeServiceForItem{},
+		&MockGroceryServiceForItem{},
+		&MockGroupServiceForItem{},
+		&MockItemCategoryServiceForItem{},
+	)
+
+	tests := []struct {
+		name        string
+		search      string
+		groupID     int64
+		repoErr     error
+		expected    []model.Item
+		expectedErr error
+	}{
+		{
+			name:     "Partial name",
+			search:   "i",
+			groupID:  group1.ID,
+			expected: []model.Item{items[4], items[1]},
+		},
+		{
+			name:     "Items of other groups are ignored",
+			search:   "water",
+			groupID:  group1.ID,
+			expected: []model.Item{},
+		},
+		{
+			name:        "Repository error",
+			search:      "i",
+			groupID:     group1.ID,
+			repoErr:     customErrors.NewInternalError("failed to fetch items", nil),
+			expectedErr: customErrors.NewInternalError("failed to fetch items", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m.getByNameErr = tt.repoErr
+
+			actual, err := s.SearchByNameAndGroupID(tt.search, tt.groupID, false)
+
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("SearchByNameAndGroupID() error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+
+			if !compareSlicesItems(actual, tt.expected) {
+				t.Errorf("Items should be equal: expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
 func TestGetRecipes(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type LoginThrottleServiceInterface interface {
+	Check(username, ipAddress string) error
+	RecordFailure(username, ipAddress string) error
+	Reset(username string) error
+}
+
+type LoginThrottleService struct {
+	repo repository.LoginThrottleRepositoryInterface
+}
+
+// NewLoginThrottleService creates a new LoginThrottleService using the provided repository,
+// in which the failed login attempts are kept across restarts.
+func NewLoginThrottleService(repo repository.LoginThrottleRepositoryInterface) *LoginThrottleService {
+	s := &LoginThrottleService{
+		repo: repo,
+	}
+	go s.cleanUp()
+	return s
+}
+
+// Check returns a TooManyRequestsError if the logins of the username or of the client IP are currently delayed,
+// carrying the longest of their remaining delays.
+func (s *LoginThrottleService) Check(username, ipAddress string) error {
+	now := time.Now().UTC()
+	var retryAfter time.Duration
+
+	for kind, key := range throttleKeys(username, ipAddress) {
+		throttle, err := s.repo.Get(kind, key)
+		if err != nil {
+			if _, ok := errors.AsType[*customErrors.NotFoundError](err); ok {
+				continue
+			}
+			return err
+		}
+
+		if throttle.BlockedUntil != nil && throttle.BlockedUntil.After(now) {
+			retryAfter = max(retryAfter, throttle.BlockedUntil.Sub(now))
+		}
+	}
+
+	if retryAfter > 0 {
+		return customErrors.NewTooManyRequestsError(retryAfter, nil)
+	}
+	return nil
+}
+
+// RecordFailure counts a failed login attempt on the username from the client IP.
+// Once a key exceeds its free attempts, each failure delays its next logins twice as long as the previous one,
+// up to constant.LOGIN_LOCKOUT_DURATION. Failures older than constant.LOGIN_FAILURE_WINDOW are forgotten.
+func (s *LoginThrottleService) RecordFailure(username, ipAddress string) error {
+	now := time.Now().UTC()
+
+	for kind, key := range throttleKeys(username, ipAddress) {
+		throttle, err := s.repo.AddFailure(kind, key, now, constant.LOGIN_FAILURE_WINDOW)
+		if err != nil {
+			return err
+		}
+
+		freeAttempts := constant.LOGIN_FREE_ATTEMPTS_PER_USERNAME
+		if kind == constant.LOGIN_THROTTLE_IP {
+			freeAttempts = constant.LOGIN_FREE_ATTEMPTS_PER_IP
+		}
+		if delay := loginBackoff(throttle.Failures - freeAttempts); delay > 0 {
+			if err = s.repo.Block(kind, key, now.Add(delay)); err != nil {
+				return err
+			}
+			if delay == constant.LOGIN_LOCKOUT_DURATION {
+				slog.Warn("Logins locked out after repeated failures", "kind", kind, "key", key, "failures", throttle.Failures)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reset forgets the failed login attempts on a username, unlocking its account.
+// The failed attempts of the client IPs are kept, so that they can't be reset by logging into another account.
+func (s *LoginThrottleService) Reset(username string) error {
+	return s.repo.Delete(constant.LOGIN_THROTTLE_USERNAME, normalizeUsername(username))
+}
+
+/*** PRIVATE METHODS ***/
+
+// cleanUp periodically removes the forgotten failed login attempts from the database.
+func (s *LoginThrottleService) cleanUp() {
+	impl := func() {
+		removed := s.repo.CleanUp(constant.LOGIN_FAILURE_WINDOW)
+		if removed > 0 {
+			slog.Info("Removed forgotten login throttles", "removed", removed)
+		}
+	}
+
+	impl()
+	// Run the cleanup every hour
+	ticker := time.NewTicker(time.Hour)
+
+	for range ticker.C {
+		impl()
+	}
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// throttleKeys returns the keys whose failed login attempts are counted, by kind:
+// the username regardless of its case, and the client IP without its port.
+func throttleKeys(username, ipAddress string) map[string]string {
+	if host, _, err := net.SplitHostPort(ipAddress); err == nil {
+		ipAddress = host
+	}
+
+	return map[string]string{
+		constant.LOGIN_THROTTLE_USERNAME: normalizeUsername(username),
+		constant.LOGIN_THROTTLE_IP:       ipAddress,
+	}
+}
+
+func normalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// loginBackoff returns the delay imposed after the given number of failures beyond the free attempts,
+// doubled on each failure from constant.LOGIN_BACKOFF_BASE up to constant.LOGIN_LOCKOUT_DURATION.
+func loginBackoff(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+
+	delay := constant.LOGIN_BACKOFF_BASE
+	for i := 1; i < failures && delay < constant.LOGIN_LOCKOUT_DURATION; i++ {
+		delay *= 2
+	}
+	return min(delay, constant.LOGIN_LOCKOUT_DURATION)
+}
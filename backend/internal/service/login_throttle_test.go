@@ -0,0 +1,185 @@
+package service
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+)
+
+type MockLoginThrottleRepository struct {
+	mu        sync.Mutex
+	throttles map[string]model.LoginThrottle
+}
+
+func NewMockLoginThrottleRepository() *MockLoginThrottleRepository {
+	return &MockLoginThrottleRepository{
+		throttles: make(map[string]model.LoginThrottle),
+	}
+}
+
+func (m *MockLoginThrottleRepository) Get(kind, key string) (*model.LoginThrottle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	throttle, ok := m.throttles[kind+":"+key]
+	if !ok {
+		return nil, customErrors.NewNotFoundError("login_throttles", "key", nil)
+	}
+	return &throttle, nil
+}
+
+func (m *MockLoginThrottleRepository) AddFailure(kind, key string, at time.Time, window time.Duration) (*model.LoginThrottle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	throttle, ok := m.throttles[kind+":"+key]
+	if !ok || throttle.LastFailureAt.Before(at.Add(-window)) {
+		throttle = model.LoginThrottle{Kind: kind, Key: key}
+	}
+	throttle.Failures++
+	throttle.LastFailureAt = at
+	m.throttles[kind+":"+key] = throttle
+	return &throttle, nil
+}
+
+func (m *MockLoginThrottleRepository) Block(kind, key string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	throttle, ok := m.throttles[kind+":"+key]
+	if ok && (throttle.BlockedUntil == nil || throttle.BlockedUntil.Before(until)) {
+		throttle.BlockedUntil = &until
+		m.throttles[kind+":"+key] = throttle
+	}
+	return nil
+}
+
+func (m *MockLoginThrottleRepository) Delete(kind, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.throttles, kind+":"+key)
+	return nil
+}
+
+func (m *MockLoginThrottleRepository) CleanUp(_ time.Duration) int64 {
+	return 0
+}
+
+func TestNewLoginThrottleService(t *testing.T) {
+	repo := NewMockLoginThrottleRepository()
+
+	service := NewLoginThrottleService(repo)
+
+	if service == nil {
+		t.Fatal("NewLoginThrottleService() returned nil")
+	}
+	if service.repo != repo {
+		t.Error("NewLoginThrottleService() repo does not match the provided one")
+	}
+}
+
+func TestLoginThrottleRecordFailure(t *testing.T) {
+	repo := NewMockLoginThrottleRepository()
+	service := NewLoginThrottleService(repo)
+
+	for range constant.LOGIN_FREE_ATTEMPTS_PER_USERNAME {
+		if err := service.RecordFailure("Alice", "192.0.2.1:4321"); err != nil {
+			t.Fatalf("RecordFailure() error = %v, want nil", err)
+		}
+	}
+	if err := service.Check("alice", "192.0.2.1:1234"); err != nil {
+		t.Fatalf("Check() error = %v, want nil within the free attempts", err)
+	}
+
+	if err := service.RecordFailure("alice", "192.0.2.1:4321"); err != nil {
+		t.Fatalf("RecordFailure() error = %v, want nil", err)
+	}
+
+	// The username is delayed regardless of its case, but another one from the same IP isn't
+	err := service.Check("ALICE", "198.51.100.1:1234")
+	tooManyErr, ok := errors.AsType[*customErrors.TooManyRequestsError](err)
+	if !ok {
+		t.Fatalf("Check() error = %v, want TooManyRequestsError", err)
+	}
+	if tooManyErr.RetryAfter <= 0 || tooManyErr.RetryAfter > constant.LOGIN_BACKOFF_BASE {
+		t.Errorf("Check() retry after = %s, want at most %s", tooManyErr.RetryAfter, constant.LOGIN_BACKOFF_BASE)
+	}
+	if err = service.Check("bob", "192.0.2.1:1234"); err != nil {
+		t.Errorf("Check() error = %v, want nil for another username", err)
+	}
+
+	ipThrottle, err := repo.Get(constant.LOGIN_THROTTLE_IP, "192.0.2.1")
+	if err != nil || ipThrottle.Failures != constant.LOGIN_FREE_ATTEMPTS_PER_USERNAME+1 || ipThrottle.BlockedUntil != nil {
+		t.Errorf("expected the failures of the IP to be counted without delay, got %+v, %v", ipThrottle, err)
+	}
+}
+
+func TestLoginThrottleForgetsOldFailures(t *testing.T) {
+	repo := NewMockLoginThrottleRepository()
+	service := NewLoginThrottleService(repo)
+
+	repo.throttles[constant.LOGIN_THROTTLE_USERNAME+":alice"] = model.LoginThrottle{
+		Kind:          constant.LOGIN_THROTTLE_USERNAME,
+		Key:           "alice",
+		Failures:      50,
+		LastFailureAt: time.Now().UTC().Add(-constant.LOGIN_FAILURE_WINDOW - time.Minute),
+		BlockedUntil:  new(time.Now().UTC().Add(-time.Hour)),
+	}
+
+	if err := service.RecordFailure("alice", "192.0.2.1"); err != nil {
+		t.Fatalf("RecordFailure() error = %v, want nil", err)
+	}
+
+	throttle, _ := repo.Get(constant.LOGIN_THROTTLE_USERNAME, "alice")
+	if throttle.Failures != 1 || throttle.BlockedUntil != nil {
+		t.Errorf("expected the old failures to be forgotten, got %+v", throttle)
+	}
+}
+
+func TestLoginThrottleReset(t *testing.T) {
+	repo := NewMockLoginThrottleRepository()
+	service := NewLoginThrottleService(repo)
+
+	for range constant.LOGIN_FREE_ATTEMPTS_PER_IP + 1 {
+		service.RecordFailure("alice", "192.0.2.1")
+	}
+
+	if err := service.Reset(" Alice"); err != nil {
+		t.Fatalf("Reset() error = %v, want nil", err)
+	}
+
+	if _, err := repo.Get(constant.LOGIN_THROTTLE_USERNAME, "alice"); err == nil {
+		t.Error("Reset() should forget the failures of the username")
+	}
+	// The IP stays delayed
+	if _, ok := errors.AsType[*customErrors.TooManyRequestsError](service.Check("bob", "192.0.2.1")); !ok {
+		t.Error("Reset() shouldn't forget the failures of the IP")
+	}
+}
+
+func TestLoginBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{-1, 0},
+		{0, 0},
+		{1, constant.LOGIN_BACKOFF_BASE},
+		{2, 2 * constant.LOGIN_BACKOFF_BASE},
+		{4, 8 * constant.LOGIN_BACKOFF_BASE},
+		{20, constant.LOGIN_LOCKOUT_DURATION},
+		{1000, constant.LOGIN_LOCKOUT_DURATION},
+	}
+
+	for _, tt := range tests {
+		if actual := loginBackoff(tt.failures); actual != tt.expected {
+			t.Errorf("loginBackoff(%d) = %s, expected %s", tt.failures, actual, tt.expected)
+		}
+	}
+}
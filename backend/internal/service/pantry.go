@@ -0,0 +1,147 @@
+package service
+
+import (
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type PantryServiceInterface interface {
+	GetByID(id int64) (*model.PantryItem, error)
+	GetByGroupID(groupID int64) ([]model.PantryItem, error)
+	Create(pantryItem *model.PantryItem) (int64, error)
+	Update(pantryItem *model.PantryItem) error
+	Delete(id int64) error
+}
+
+type PantryService struct {
+	repo     repository.PantryRepositoryInterface
+	itemRepo repository.ItemRepositoryInterface
+	unitRepo repository.UnitRepositoryInterface
+}
+
+// NewPantryService creates a new PantryService using the provided repositories,
+// the item and unit ones being used to validate the references of the pantry lines.
+func NewPantryService(repo repository.PantryRepositoryInterface,
+	itemRepo repository.ItemRepositoryInterface,
+	unitRepo repository.UnitRepositoryInterface) *PantryService {
+	return &PantryService{
+		repo:     repo,
+		itemRepo: itemRepo,
+		unitRepo: unitRepo,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID returns the pantry line identified by id, with its item and unit.
+func (s *PantryService) GetByID(id int64) (*model.PantryItem, error) {
+	return s.repo.GetByID(id)
+}
+
+// GetByGroupID returns the pantry of a group, sorted by the position of the item categories, then by item name.
+func (s *PantryService) GetByGroupID(groupID int64) ([]model.PantryItem, error) {
+	return s.repo.GetByGroupID(groupID)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create validates and adds a new pantry line, returning its ID.
+// An item can only be stocked once in a given unit.
+func (s *PantryService) Create(pantryItem *model.PantryItem) (int64, error) {
+	if err := s.validatePantryItem(pantryItem); err != nil {
+		return 0, err
+	}
+
+	return s.repo.Create(pantryItem)
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update validates and replaces the item, unit and quantity of the pantry line identified by pantryItem.ID.
+// The group of a pantry line is kept.
+func (s *PantryService) Update(pantryItem *model.PantryItem) error {
+	currentPantryItem, err := s.repo.GetByID(pantryItem.ID)
+	if err != nil {
+		return err
+	}
+
+	pantryItem.GroupID = currentPantryItem.GroupID
+
+	if err := s.validatePantryItem(pantryItem); err != nil {
+		return err
+	}
+
+	return s.repo.Update(pantryItem)
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the pantry line identified by id.
+func (s *PantryService) Delete(id int64) error {
+	return s.repo.Delete(id)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// validatePantryItem checks the quantity of the pantry line and ensures that its item belongs to its group,
+// and its unit to the catalogue or to its group.
+func (s *PantryService) validatePantryItem(pantryItem *model.PantryItem) error {
+	if pantryItem.Quantity < 0 {
+		return customErrors.NewInvalidParamsError([]string{"quantity"}, nil)
+	}
+
+	item, err := s.itemRepo.GetByID(pantryItem.Item.ID)
+	if err != nil {
+		return referenceError(err, "Item", "item must exists")
+	}
+	if item.GroupID != pantryItem.GroupID {
+		return customErrors.NewConflictError("Item", "item must belongs to the same group as the pantry item", nil)
+	}
+
+	unit, err := s.unitRepo.GetByID(pantryItem.Unit.ID)
+	if err != nil {
+		return referenceError(err, "Unit", "unit must exists")
+	}
+	if unit.GroupID != nil && *unit.GroupID != pantryItem.GroupID {
+		return customErrors.NewConflictError("Unit", "unit must belongs to the catalogue or to the same group as the pantry item", nil)
+	}
+
+	pantryItem.Item = *item
+	pantryItem.Unit = *unit
+
+	return nil
+}
+
+// deductFromPantry takes a quantity of an item, expressed in unit, out of the pantry lines of this item whose unit has the same type,
+// in the order of the lines. The quantities of the lines are updated in place and never fall below zero: what the pantry lacks is ignored.
+func deductFromPantry(pantryItems []model.PantryItem, itemID int64, quantity float64, unit *model.Unit) error {
+	for i := range pantryItems {
+		if quantity <= 0 {
+			break
+		}
+
+		pantryItem := &pantryItems[i]
+		if pantryItem.Item.ID != itemID || pantryItem.Unit.UnitType != unit.UnitType || pantryItem.Quantity <= 0 {
+			continue
+		}
+
+		stock, err := convertQuantity(pantryItem.Quantity, &pantryItem.Unit, unit)
+		if err != nil {
+			return err
+		}
+
+		if stock <= quantity {
+			pantryItem.Quantity = 0
+			quantity -= stock
+			continue
+		}
+
+		if pantryItem.Quantity, err = convertQuantity(stock-quantity, unit, &pantryItem.Unit); err != nil {
+			return err
+		}
+		quantity = 0
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockPantryRepository struct {
+	pantryItems []model.PantryItem
+	created     *model.PantryItem
+	updated     *model.PantryItem
+	deducted    []model.PantryItem
+	cookedID    int64
+	deletedID   int64
+}
+
+func (m *MockPantryRepository) GetByID(id int64) (*model.PantryItem, error) {
+	for i := range m.pantryItems {
+		if m.pantryItems[i].ID == id {
+			pantryItem := m.pantryItems[i]
+			return &pantryItem, nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("pantry", "id", nil)
+}
+
+func (m *MockPantryRepository) GetByGroupID(groupID int64) ([]model.PantryItem, error) {
+	pantryItems := []model.PantryItem{}
+	for _, pantryItem := range m.pantryItems {
+		if pantryItem.GroupID == groupID {
+			pantryItems = append(pantryItems, pantryItem)
+		}
+	}
+	return pantryItems, nil
+}
+
+func (m *MockPantryRepository) Create(pantryItem *model.PantryItem) (int64, error) {
+	m.created = pantryItem
+	return 42, nil
+}
+
+func (m *MockPantryRepository) Update(pantryItem *model.PantryItem) error {
+	m.updated = pantryItem
+	return nil
+}
+
+func (m *MockPantryRepository) DeductForDish(_ context.Context, dishID int64, pantryItems []model.PantryItem) error {
+	m.cookedID = dishID
+	m.deducted = pantryItems
+	return nil
+}
+
+func (m *MockPantryRepository) Delete(id int64) error {
+	m.deletedID = id
+	return nil
+}
+
+// setUpPantryServiceData builds a PantryService whose repository contains 500 g of flour stocked by the first group.
+func setUpPantryServiceData() (*PantryService, *MockPantryRepository) {
+	pantryRepo := &MockPantryRepository{
+		pantryItems: []model.PantryItem{
+			{ID: 1, Quantity: 500, Item: items[0], Unit: unitGram, GroupID: group1.ID},
+		},
+	}
+	unitRepo := &MockUnitRepository{units: []model.Unit{unitGram, unitPiece, unitHandful}}
+
+	return NewPantryService(pantryRepo, setUpDataTestItem(), unitRepo), pantryRepo
+}
+
+func TestNewPantryService(t *testing.T) {
+	repo := &MockPantryRepository{}
+	itemRepo := NewMockItemRepository()
+	unitRepo := &MockUnitRepository{}
+
+	service := NewPantryService(repo, itemRepo, unitRepo)
+
+	if service == nil {
+		t.Fatal("NewPantryService() returned nil")
+	}
+	if service.repo != repo || service.itemRepo != itemRepo || service.unitRepo != unitRepo {
+		t.Error("NewPantryService() repositories do not match the provided ones")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetPantryItemsByGroupID(t *testing.T) {
+	service, _ := setUpPantryServiceData()
+
+	actual, err := service.GetByGroupID(group1.ID)
+	if err != nil {
+		t.Fatalf("GetByGroupID() unexpected error = %v", err)
+	}
+	if len(actual) != 1 || actual[0].Item.Name != items[0].Name {
+		t.Errorf("GetByGroupID() expected the flour of the group, got %v", actual)
+	}
+
+	actual, err = service.GetByGroupID(group2.ID)
+	if err != nil {
+		t.Fatalf("GetByGroupID() unexpected error = %v", err)
+	}
+	if len(actual) != 0 {
+		t.Errorf("GetByGroupID() expected an empty pantry, got %v", actual)
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreatePantryItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		pantryItem model.PantryItem
+		err        error
+	}{
+		{
+			name:       "Valid pantry item",
+			pantryItem: model.PantryItem{Quantity: 3, Item: model.Item{ID: items[3].ID}, Unit: model.Unit{ID: unitPiece.ID}, GroupID: group1.ID},
+		},
+		{
+			name:       "Empty stock",
+			pantryItem: model.PantryItem{Quantity: 0, Item: model.Item{ID: items[3].ID}, Unit: model.Unit{ID: unitPiece.ID}, GroupID: group1.ID},
+		},
+		{
+			name:       "Negative quantity",
+			pantryItem: model.PantryItem{Quantity: -1, Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitGram.ID}, GroupID: group1.ID},
+			err:        customErrors.NewInvalidParamsError([]string{"quantity"}, nil),
+		},
+		{
+			name:       "Unknown item",
+			pantryItem: model.PantryItem{Quantity: 1, Item: model.Item{ID: invalidItemID}, Unit: model.Unit{ID: unitGram.ID}, GroupID: group1.ID},
+			err:        customErrors.NewConflictError("Item", "item must exists", nil),
+		},
+		{
+			name:       "Item of another group",
+			pantryItem: model.PantryItem{Quantity: 1, Item: model.Item{ID: items[2].ID}, Unit: model.Unit{ID: unitGram.ID}, GroupID: group1.ID},
+			err:        customErrors.NewConflictError("Item", "item must belongs to the same group as the pantry item", nil),
+		},
+		{
+			name:       "Custom unit of another group",
+			pantryItem: model.PantryItem{Quantity: 1, Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitHandful.ID}, GroupID: group1.ID},
+			err:        customErrors.NewConflictError("Unit", "unit must belongs to the catalogue or to the same group as the pantry item", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpPantryServiceData()
+
+			id, err := service.Create(&tt.pantryItem)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Create() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+			if id != 42 {
+				t.Errorf("Create() expected id 42, got %d", id)
+			}
+
+			// The item and unit of a new pantry line are resolved.
+			if repo.created.Item.Name != items[3].Name || repo.created.Unit.Name != unitPiece.Name {
+				t.Errorf("Create() unexpected created pantry item %+v", *repo.created)
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestUpdatePantryItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		pantryItem model.PantryItem
+		err        error
+	}{
+		{
+			name:       "Valid update",
+			pantryItem: model.PantryItem{ID: 1, Quantity: 1000, Item: model.Item{ID: items[1].ID}, Unit: model.Unit{ID: unitGram.ID}, GroupID: group2.ID},
+		},
+		{
+			name:       "Unknown pantry item",
+			pantryItem: model.PantryItem{ID: -1, Quantity: 1, Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitGram.ID}},
+			err:        customErrors.NewNotFoundError("pantry", "id", nil),
+		},
+		{
+			name:       "Item of another group",
+			pantryItem: model.PantryItem{ID: 1, Quantity: 1, Item: model.Item{ID: items[2].ID}, Unit: model.Unit{ID: unitGram.ID}},
+			err:        customErrors.NewConflictError("Item", "item must belongs to the same group as the pantry item", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpPantryServiceData()
+
+			err := service.Update(&tt.pantryItem)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Update() unexpected error = %v", err)
+			}
+
+			// The group is kept.
+			if repo.updated.GroupID != group1.ID || repo.updated.Quantity != 1000 || repo.updated.Item.Name != items[1].Name {
+				t.Errorf("Update() unexpected updated pantry item %+v", *repo.updated)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS ***/
+
+func TestDeletePantryItem(t *testing.T) {
+	service, repo := setUpPantryServiceData()
+
+	if err := service.Delete(1); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+	if repo.deletedID != 1 {
+		t.Errorf("Delete() expected pantry item 1 to be deleted, got %d", repo.deletedID)
+	}
+}
+
+/*** HELPER FUNCTIONS ***/
+
+func TestDeductFromPantry(t *testing.T) {
+	tests := []struct {
+		name     string
+		itemID   int64
+		quantity float64
+		unit     model.Unit
+		expected []float64
+		err      error
+	}{
+		{"Quantity in another unit", items[0].ID, 0.2, unitKilogram, []float64{300, 1, 6}, nil},
+		{"First line exhausted", items[0].ID, 700, unitGram, []float64{0, 0.8, 6}, nil},
+		{"Lacking stock", items[0].ID, 2, unitKilogram, []float64{0, 0, 6}, nil},
+		{"Unit of another type", items[0].ID, 2, unitPiece, []float64{500, 1, 6}, nil},
+		{"Item out of stock", items[1].ID, 100, unitGram, []float64{500, 1, 6}, nil},
+		{"Other item", items[3].ID, 4, unitPiece, []float64{500, 1, 2}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pantryItems := []model.PantryItem{
+				{ID: 1, Quantity: 500, Item: items[0], Unit: unitGram},
+				{ID: 2, Quantity: 1, Item: items[0], Unit: unitKilogram},
+				{ID: 3, Quantity: 6, Item: items[3], Unit: unitPiece},
+			}
+
+			err := deductFromPantry(pantryItems, tt.itemID, tt.quantity, &tt.unit)
+			if !utils.CompareErrors(err, tt.err) {
+				t.Fatalf("deductFromPantry() error = %v, want %v", err, tt.err)
+			}
+
+			actual := []float64{}
+			for _, pantryItem := range pantryItems {
+				actual = append(actual, pantryItem.Quantity)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("deductFromPantry() left %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -1,24 +1,71 @@
 package service
 
 import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
 	"github.com/zouipo/yumsday/backend/internal/repository"
 )
 
 type RecipeServiceInterface interface {
+	GetByID(id int64) (*model.Recipe, error)
+	GetByGroupID(groupID int64, descending bool) ([]model.Recipe, error)
+	SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.Recipe, error)
 	GetByItemID(itemID int64, descending bool) ([]model.Recipe, error)
+	GetByAvailableItems(groupID int64, itemIDs []int64, maxMissing *int) ([]model.RecipeMatch, error)
+	Scale(recipe *model.Recipe, servings int) error
+	NormalizeUnits(recipe *model.Recipe) error
+	EstimateCost(recipe *model.Recipe) (*model.CostEstimate, error)
+	Create(ctx context.Context, recipe *model.Recipe) (int64, error)
+	Update(ctx context.Context, recipe *model.Recipe) error
+	Delete(ctx context.Context, id int64) error
 }
 
 type RecipeService struct {
-	repo repository.RecipeRepositoryInterface
+	repo               repository.RecipeRepositoryInterface
+	itemRepo           repository.ItemRepositoryInterface
+	unitRepo           repository.UnitRepositoryInterface
+	recipeCategoryRepo repository.RecipeCategoryRepositoryInterface
 }
 
-func NewRecipeService(recipeRepo repository.RecipeRepositoryInterface) *RecipeService {
+// NewRecipeService creates a new RecipeService using the provided repositories,
+// the item, unit and recipe category ones being used to validate the references of the recipes.
+func NewRecipeService(recipeRepo repository.RecipeRepositoryInterface,
+	itemRepo repository.ItemRepositoryInterface,
+	unitRepo repository.UnitRepositoryInterface,
+	recipeCategoryRepo repository.RecipeCategoryRepositoryInterface) *RecipeService {
 	return &RecipeService{
-		repo: recipeRepo,
+		repo:               recipeRepo,
+		itemRepo:           itemRepo,
+		unitRepo:           unitRepo,
+		recipeCategoryRepo: recipeCategoryRepo,
 	}
 }
 
+/*** READ OPERATIONS ***/
+
+// GetByID returns the recipe identified by id, with its categories and ingredients.
+func (s *RecipeService) GetByID(id int64) (*model.Recipe, error) {
+	return s.repo.GetByID(id)
+}
+
+// GetByGroupID returns all the recipes of a group, sorted by name.
+func (s *RecipeService) GetByGroupID(groupID int64, descending bool) ([]model.Recipe, error) {
+	return s.repo.GetByGroupID(groupID, descending)
+}
+
+// SearchByNameAndGroupID returns the recipes of a group whose name contains the provided one, sorted by name.
+func (s *RecipeService) SearchByNameAndGroupID(name string, groupID int64, descending bool) ([]model.Recipe, error) {
+	return s.repo.SearchByNameAndGroupID(name, groupID, descending)
+}
+
 func (s *RecipeService) GetByItemID(itemID int64, descending bool) ([]model.Recipe, error) {
 	recipes, err := s.repo.GetByItemID(itemID, descending)
 	if err != nil {
@@ -27,3 +74,276 @@ func (s *RecipeService) GetByItemID(itemID int64, descending bool) ([]model.Reci
 
 	return recipes, nil
 }
+
+// GetByAvailableItems returns the recipes of a group which use at least one of the provided items, with their missing ingredients.
+// They are sorted by decreasing coverage, then by increasing number of missing ingredients and by name.
+// When maxMissing is provided, the recipes missing more ingredients are left out.
+func (s *RecipeService) GetByAvailableItems(groupID int64, itemIDs []int64, maxMissing *int) ([]model.RecipeMatch, error) {
+	e := customErrors.NewInvalidParamsError([]string{}, nil).(*customErrors.InvalidParamsError)
+
+	if len(itemIDs) == 0 {
+		e.AddInvalidField("item_ids")
+	}
+	if maxMissing != nil && *maxMissing < 0 {
+		e.AddInvalidField("max_missing")
+	}
+
+	if len(e.Fields) > 0 {
+		return nil, e
+	}
+
+	recipes, err := s.repo.GetByGroupIDAndItemIDs(groupID, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []model.RecipeMatch{}
+	for _, recipe := range recipes {
+		match := model.RecipeMatch{Recipe: recipe, MissingIngredients: []model.Ingredient{}}
+		for _, ingredient := range recipe.Ingredients {
+			if !slices.Contains(itemIDs, ingredient.Item.ID) {
+				match.MissingIngredients = append(match.MissingIngredients, ingredient)
+			}
+		}
+
+		if maxMissing != nil && len(match.MissingIngredients) > *maxMissing {
+			continue
+		}
+
+		match.Coverage = float64(len(recipe.Ingredients)-len(match.MissingIngredients)) / float64(len(recipe.Ingredients))
+		matches = append(matches, match)
+	}
+
+	slices.SortStableFunc(matches, func(a, b model.RecipeMatch) int {
+		return cmp.Or(
+			cmp.Compare(b.Coverage, a.Coverage),
+			cmp.Compare(len(a.MissingIngredients), len(b.MissingIngredients)),
+			strings.Compare(a.Recipe.Name, b.Recipe.Name),
+		)
+	})
+
+	return matches, nil
+}
+
+// Scale rescales in place the quantities of the ingredients of a recipe to the provided number of servings.
+// The servings of the recipe must be known.
+func (s *RecipeService) Scale(recipe *model.Recipe, servings int) error {
+	if servings <= 0 {
+		return customErrors.NewInvalidParamsError([]string{"servings"}, nil)
+	}
+	if recipe.Servings == nil || *recipe.Servings <= 0 {
+		return customErrors.NewValidationError("servings", customErrors.RECIPE_SCALE_ERROR, nil)
+	}
+
+	scale := float64(servings) / float64(*recipe.Servings)
+	for i := range recipe.Ingredients {
+		if recipe.Ingredients[i].Quantity != nil {
+			recipe.Ingredients[i].Quantity = new(*recipe.Ingredients[i].Quantity * scale)
+		}
+	}
+	recipe.Servings = &servings
+
+	return nil
+}
+
+// NormalizeUnits expresses in place the quantity of each ingredient of a recipe in its most readable unit,
+// among the units available to the group of the recipe, e.g. 1500 g becomes 1.5 kg.
+func (s *RecipeService) NormalizeUnits(recipe *model.Recipe) error {
+	units, err := s.unitRepo.GetByGroupID(recipe.GroupID)
+	if err != nil {
+		return err
+	}
+
+	for i := range recipe.Ingredients {
+		ingredient := &recipe.Ingredients[i]
+		if ingredient.Quantity == nil {
+			continue
+		}
+
+		quantity, unit := readableQuantity(*ingredient.Quantity, &ingredient.Unit, units)
+		ingredient.Quantity = &quantity
+		ingredient.Unit = unit
+	}
+
+	return nil
+}
+
+// EstimateCost estimates the cost of a recipe for its servings from the average market price of its items.
+// Ingredients without quantity, such as salt to taste, cost nothing.
+func (s *RecipeService) EstimateCost(recipe *model.Recipe) (*model.CostEstimate, error) {
+	estimate := &model.CostEstimate{Servings: recipe.Servings, UnpricedIngredients: []model.Ingredient{}}
+
+	if err := addRecipeCost(estimate, recipe, 1, s.itemRepo, make(map[int64]*model.Item)); err != nil {
+		return nil, err
+	}
+
+	return estimate, nil
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create validates and adds a new recipe, with its categories and ingredients, to the database.
+func (s *RecipeService) Create(ctx context.Context, recipe *model.Recipe) (int64, error) {
+	recipe.CreatedAt = time.Now().UTC()
+
+	// New ingredients must not reuse the ID of an existing one, which would be moved to this recipe.
+	for i := range recipe.Ingredients {
+		recipe.Ingredients[i].ID = 0
+	}
+
+	if err := s.validateRecipe(recipe); err != nil {
+		return 0, err
+	}
+
+	return s.repo.Create(ctx, recipe)
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update validates and replaces the recipe identified by recipe.ID, its categories and its ingredients.
+// The group and creation date of a recipe can't be updated.
+func (s *RecipeService) Update(ctx context.Context, recipe *model.Recipe) error {
+	currentRecipe, err := s.repo.GetByID(recipe.ID)
+	if err != nil {
+		return err
+	}
+
+	recipe.GroupID = currentRecipe.GroupID
+	recipe.CreatedAt = currentRecipe.CreatedAt
+
+	// Existing ingredients are updated in place, so they must belong to the recipe.
+	currentIngredients := make(map[int64]bool, len(currentRecipe.Ingredients))
+	for _, ing := range currentRecipe.Ingredients {
+		currentIngredients[ing.ID] = true
+	}
+	for _, ing := range recipe.Ingredients {
+		if ing.ID != 0 && !currentIngredients[ing.ID] {
+			return customErrors.NewConflictError("Ingredient", "ingredient must belongs to the recipe", nil)
+		}
+	}
+
+	if err := s.validateRecipe(recipe); err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, recipe)
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the recipe identified by id, with its ingredients and its links to categories and dishes.
+func (s *RecipeService) Delete(ctx context.Context, id int64) error {
+	return s.repo.Delete(ctx, id)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// validateRecipe checks the fields of the recipe and ensures that every referenced item, unit and category exists
+// and belongs to the group of the recipe, units possibly coming from the catalogue.
+func (s *RecipeService) validateRecipe(recipe *model.Recipe) error {
+	recipe.Name = strings.TrimSpace(recipe.Name)
+
+	e := customErrors.NewInvalidParamsError([]string{}, nil).(*customErrors.InvalidParamsError)
+
+	if recipe.Name == "" {
+		e.AddInvalidField("name")
+	}
+	if recipe.PreparationTimeMin != nil && *recipe.PreparationTimeMin < 0 {
+		e.AddInvalidField("preparation_time_min")
+	}
+	if recipe.CookingTimeMin != nil && *recipe.CookingTimeMin < 0 {
+		e.AddInvalidField("cooking_time_min")
+	}
+	if recipe.Servings != nil && *recipe.Servings <= 0 {
+		e.AddInvalidField("servings")
+	}
+	for _, ing := range recipe.Ingredients {
+		if ing.Quantity != nil && *ing.Quantity < 0 {
+			e.AddInvalidField("quantity")
+			break
+		}
+	}
+
+	if len(e.Fields) > 0 {
+		return e
+	}
+
+	for i, c := range recipe.Categories {
+		category, err := s.recipeCategoryRepo.GetByID(c.ID)
+		if err != nil {
+			return referenceError(err, "RecipeCategory", "recipe category must exists")
+		}
+		if category.GroupID != recipe.GroupID {
+			return customErrors.NewConflictError("RecipeCategory", "recipe category must belongs to the same group as the recipe", nil)
+		}
+		recipe.Categories[i] = *category
+	}
+
+	for i, ing := range recipe.Ingredients {
+		item, err := s.itemRepo.GetByID(ing.Item.ID)
+		if err != nil {
+			return referenceError(err, "Item", "item must exists")
+		}
+		if item.GroupID != recipe.GroupID {
+			return customErrors.NewConflictError("Item", "item must belongs to the same group as the recipe", nil)
+		}
+
+		unit, err := s.unitRepo.GetByID(ing.Unit.ID)
+		if err != nil {
+			return referenceError(err, "Unit", "unit must exists")
+		}
+		if unit.GroupID != nil && *unit.GroupID != recipe.GroupID {
+			return customErrors.NewConflictError("Unit", "unit must belongs to the catalogue or to the same group as the recipe", nil)
+		}
+
+		recipe.Ingredients[i].Item = *item
+		recipe.Ingredients[i].Unit = *unit
+	}
+
+	return nil
+}
+
+// addRecipeCost adds to the estimate the cost of the ingredients of a recipe, their quantities multiplied by scale,
+// and the ingredients which can't be priced. Items are looked up once through the items map, shared between calls.
+func addRecipeCost(estimate *model.CostEstimate, recipe *model.Recipe, scale float64,
+	itemRepo repository.ItemRepositoryInterface, items map[int64]*model.Item) error {
+	for _, ingredient := range recipe.Ingredients {
+		if ingredient.Quantity == nil {
+			continue
+		}
+
+		item, ok := items[ingredient.Item.ID]
+		if !ok {
+			var err error
+			if item, err = itemRepo.GetByID(ingredient.Item.ID); err != nil {
+				return err
+			}
+			items[ingredient.Item.ID] = item
+		}
+
+		if item.AverageMarketPrice == nil || item.UnitType != ingredient.Unit.UnitType {
+			if !slices.ContainsFunc(estimate.UnpricedIngredients, func(i model.Ingredient) bool { return i.ID == ingredient.ID }) {
+				estimate.UnpricedIngredients = append(estimate.UnpricedIngredients, ingredient)
+			}
+			continue
+		}
+
+		// Prices of weights and volumes are given per kilogram or liter, i.e. 1000 of their base unit.
+		priceFactor := 1.0
+		if item.UnitType == enum.Weight || item.UnitType == enum.Volume {
+			priceFactor = 1000
+		}
+
+		estimate.Cost += *ingredient.Quantity * scale * ingredient.Unit.Factor / priceFactor * *item.AverageMarketPrice
+	}
+
+	return nil
+}
+
+// referenceError turns the NotFoundError of a referenced entity into a ConflictError, other errors being returned as is.
+func referenceError(err error, entityType, errorMessage string) error {
+	if _, isNotFoundError := errors.AsType[*customErrors.NotFoundError](err); isNotFoundError {
+		return customErrors.NewConflictError(entityType, errorMessage, nil)
+	}
+	return err
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type RecipeBundleServiceInterface interface {
+	Export(groupID int64, recipeID, categoryID *int64) (*model.RecipeBundle, error)
+	Import(ctx context.Context, groupID int64, bundle *model.RecipeBundle) ([]model.Recipe, error)
+}
+
+type RecipeBundleService struct {
+	repo               repository.RecipeBundleRepositoryInterface
+	recipeRepo         repository.RecipeRepositoryInterface
+	itemRepo           repository.ItemRepositoryInterface
+	recipeCategoryRepo repository.RecipeCategoryRepositoryInterface
+}
+
+// NewRecipeBundleService creates a new RecipeBundleService using the provided repositories,
+// the recipe, item and recipe category ones being used to gather the exported recipes and their references.
+func NewRecipeBundleService(repo repository.RecipeBundleRepositoryInterface,
+	recipeRepo repository.RecipeRepositoryInterface,
+	itemRepo repository.ItemRepositoryInterface,
+	recipeCategoryRepo repository.RecipeCategoryRepositoryInterface) *RecipeBundleService {
+	return &RecipeBundleService{
+		repo:               repo,
+		recipeRepo:         recipeRepo,
+		itemRepo:           itemRepo,
+		recipeCategoryRepo: recipeCategoryRepo,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// Export bundles recipes of a group with the units, items and categories they use: the recipe identified by recipeID,
+// the recipes of the category identified by categoryID, or every recipe of the group when both are nil.
+// At most one of them can be provided; a NotFoundError is returned if the recipe or category doesn't belong to the group.
+func (s *RecipeBundleService) Export(groupID int64, recipeID, categoryID *int64) (*model.RecipeBundle, error) {
+	var recipes []model.Recipe
+
+	switch {
+	case recipeID != nil && categoryID != nil:
+		return nil, customErrors.NewInvalidParamsError([]string{"recipe_id", "category_id"}, nil)
+	case recipeID != nil:
+		recipe, err := s.recipeRepo.GetByID(*recipeID)
+		if err != nil {
+			return nil, err
+		}
+		if recipe.GroupID != groupID {
+			return nil, customErrors.NewNotFoundError("recipes", "id", nil)
+		}
+		recipes = []model.Recipe{*recipe}
+	case categoryID != nil:
+		category, err := s.recipeCategoryRepo.GetByID(*categoryID)
+		if err != nil {
+			return nil, err
+		}
+		if category.GroupID != groupID {
+			return nil, customErrors.NewNotFoundError("recipe_categories", "id", nil)
+		}
+		if recipes, err = s.recipeRepo.GetByCategoryID(*categoryID, false); err != nil {
+			return nil, err
+		}
+	default:
+		var err error
+		if recipes, err = s.recipeRepo.GetByGroupID(groupID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.bundle(recipes)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Import validates a bundle and creates its recipes in a group, in a single transaction, with the units, items and
+// categories missing from the group. Those already in the group, or in the catalogue for units, are matched by name,
+// and must have the same unit type, and factor for units, as in the bundle.
+// The created recipes are returned in the order of the bundle.
+func (s *RecipeBundleService) Import(ctx context.Context, groupID int64, bundle *model.RecipeBundle) ([]model.Recipe, error) {
+	if err := validateRecipeBundle(bundle); err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now().UTC()
+	for i := range bundle.Recipes {
+		bundle.Recipes[i].CreatedAt = createdAt
+	}
+
+	ids, err := s.repo.Import(ctx, bundle, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipes := make([]model.Recipe, 0, len(ids))
+	for _, id := range ids {
+		recipe, err := s.recipeRepo.GetByID(id)
+		if err != nil {
+			return nil, err
+		}
+		recipes = append(recipes, *recipe)
+	}
+
+	return recipes, nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// bundle gathers recipes in a bundle with the units, items and categories they use, each of them once and sorted by name.
+// IDs and groups are left out, references being made by name.
+func (s *RecipeBundleService) bundle(recipes []model.Recipe) (*model.RecipeBundle, error) {
+	bundle := &model.RecipeBundle{
+		Version:          constant.RECIPE_BUNDLE_VERSION,
+		Units:            []model.Unit{},
+		ItemCategories:   []model.ItemCategory{},
+		Items:            []model.Item{},
+		RecipeCategories: []model.RecipeCategory{},
+		Recipes:          make([]model.Recipe, 0, len(recipes)),
+	}
+
+	seenUnits := make(map[int64]bool)
+	seenItems := make(map[int64]bool)
+	seenItemCategories := make(map[string]bool)
+	seenRecipeCategories := make(map[string]bool)
+
+	for _, recipe := range recipes {
+		bundleRecipe := model.Recipe{
+			Name:               recipe.Name,
+			Description:        recipe.Description,
+			ImageURL:           recipe.ImageURL,
+			OriginalLink:       recipe.OriginalLink,
+			PreparationTimeMin: recipe.PreparationTimeMin,
+			CookingTimeMin:     recipe.CookingTimeMin,
+			Servings:           recipe.Servings,
+			Instructions:       recipe.Instructions,
+			Public:             recipe.Public,
+			Comment:            recipe.Comment,
+			Categories:         make([]model.RecipeCategory, 0, len(recipe.Categories)),
+			Ingredients:        make([]model.Ingredient, 0, len(recipe.Ingredients)),
+		}
+
+		for _, category := range recipe.Categories {
+			bundleRecipe.Categories = append(bundleRecipe.Categories, model.RecipeCategory{Name: category.Name})
+			if !seenRecipeCategories[category.Name] {
+				seenRecipeCategories[category.Name] = true
+				bundle.RecipeCategories = append(bundle.RecipeCategories, model.RecipeCategory{Name: category.Name})
+			}
+		}
+
+		for _, ing := range recipe.Ingredients {
+			bundleRecipe.Ingredients = append(bundleRecipe.Ingredients, model.Ingredient{
+				Quantity: ing.Quantity,
+				Item:     model.Item{Name: ing.Item.Name},
+				Unit:     model.Unit{Name: ing.Unit.Name},
+			})
+
+			if !seenUnits[ing.Unit.ID] {
+				seenUnits[ing.Unit.ID] = true
+				bundle.Units = append(bundle.Units, model.Unit{Name: ing.Unit.Name, Factor: ing.Unit.Factor, UnitType: ing.Unit.UnitType})
+			}
+
+			if seenItems[ing.Item.ID] {
+				continue
+			}
+			seenItems[ing.Item.ID] = true
+
+			item, err := s.itemRepo.GetByID(ing.Item.ID)
+			if err != nil {
+				return nil, err
+			}
+			bundle.Items = append(bundle.Items, model.Item{
+				Name:               item.Name,
+				Description:        item.Description,
+				AverageMarketPrice: item.AverageMarketPrice,
+				UnitType:           item.UnitType,
+				ItemCategory:       model.ItemCategory{Name: item.ItemCategory.Name},
+			})
+			if !seenItemCategories[item.ItemCategory.Name] {
+				seenItemCategories[item.ItemCategory.Name] = true
+				bundle.ItemCategories = append(bundle.ItemCategories, model.ItemCategory{Name: item.ItemCategory.Name})
+			}
+		}
+
+		bundle.Recipes = append(bundle.Recipes, bundleRecipe)
+	}
+
+	slices.SortFunc(bundle.Units, func(a, b model.Unit) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(bundle.ItemCategories, func(a, b model.ItemCategory) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(bundle.Items, func(a, b model.Item) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(bundle.RecipeCategories, func(a, b model.RecipeCategory) int { return cmp.Compare(a.Name, b.Name) })
+
+	return bundle, nil
+}
+
+// validateRecipeBundle checks the version and the fields of a bundle, whose names are trimmed,
+// and ensures that every name referenced by its parts is defined in the bundle, regardless of its case.
+func validateRecipeBundle(bundle *model.RecipeBundle) error {
+	e := customErrors.NewInvalidParamsError([]string{}, nil).(*customErrors.InvalidParamsError)
+	invalid := func(field string) {
+		if !slices.Contains(e.Fields, field) {
+			e.AddInvalidField(field)
+		}
+	}
+
+	if bundle.Version != constant.RECIPE_BUNDLE_VERSION {
+		invalid("version")
+	}
+	if len(bundle.Recipes) == 0 {
+		invalid("recipes")
+	}
+
+	units := make(map[string]bool)
+	for i := range bundle.Units {
+		unit := &bundle.Units[i]
+		unit.Name = strings.TrimSpace(unit.Name)
+		if unit.Name == "" || unit.Factor <= 0 || unit.UnitType == (enum.UnitType{}) {
+			invalid("units")
+		}
+		units[strings.ToLower(unit.Name)] = true
+	}
+
+	itemCategories := make(map[string]bool)
+	for i := range bundle.ItemCategories {
+		category := &bundle.ItemCategories[i]
+		category.Name = strings.TrimSpace(category.Name)
+		if category.Name == "" {
+			invalid("item_categories")
+		}
+		itemCategories[strings.ToLower(category.Name)] = true
+	}
+
+	items := make(map[string]bool)
+	for i := range bundle.Items {
+		item := &bundle.Items[i]
+		item.Name = strings.TrimSpace(item.Name)
+		item.ItemCategory.Name = strings.TrimSpace(item.ItemCategory.Name)
+		if item.Name == "" || item.UnitType == (enum.UnitType{}) ||
+			item.ItemCategory.Name != "" && !itemCategories[strings.ToLower(item.ItemCategory.Name)] {
+			invalid("items")
+		}
+		items[strings.ToLower(item.Name)] = true
+	}
+
+	recipeCategories := make(map[string]bool)
+	for i := range bundle.RecipeCategories {
+		category := &bundle.RecipeCategories[i]
+		category.Name = strings.TrimSpace(category.Name)
+		if category.Name == "" {
+			invalid("recipe_categories")
+		}
+		recipeCategories[strings.ToLower(category.Name)] = true
+	}
+
+	for i := range bundle.Recipes {
+		recipe := &bundle.Recipes[i]
+		recipe.Name = strings.TrimSpace(recipe.Name)
+		if recipe.Name == "" ||
+			recipe.PreparationTimeMin != nil && *recipe.PreparationTimeMin < 0 ||
+			recipe.CookingTimeMin != nil && *recipe.CookingTimeMin < 0 ||
+			recipe.Servings != nil && *recipe.Servings <= 0 {
+			invalid("recipes")
+		}
+		for j := range recipe.Categories {
+			category := &recipe.Categories[j]
+			category.Name = strings.TrimSpace(category.Name)
+			if !recipeCategories[strings.ToLower(category.Name)] {
+				invalid("recipes")
+			}
+		}
+		for j := range recipe.Ingredients {
+			ing := &recipe.Ingredients[j]
+			ing.Item.Name = strings.TrimSpace(ing.Item.Name)
+			ing.Unit.Name = strings.TrimSpace(ing.Unit.Name)
+			if ing.Quantity != nil && *ing.Quantity < 0 || !items[strings.ToLower(ing.Item.Name)] || !units[strings.ToLower(ing.Unit.Name)] {
+				invalid("recipes")
+			}
+		}
+	}
+
+	if len(e.Fields) > 0 {
+		return e
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockRecipeBundleRepository struct {
+	ids       []int64
+	importErr error
+	imported  *model.RecipeBundle
+	groupID   int64
+}
+
+func (m *MockRecipeBundleRepository) Import(_ context.Context, bundle *model.RecipeBundle, groupID int64) ([]int64, error) {
+	if m.importErr != nil {
+		return nil, m.importErr
+	}
+
+	m.imported = bundle
+	m.groupID = groupID
+	return m.ids, nil
+}
+
+// setUpRecipeBundleServiceData builds a RecipeBundleService whose repositories contain a recipe of each test group.
+func setUpRecipeBundleServiceData() (*RecipeBundleService, *MockRecipeBundleRepository) {
+	recipeService, recipeRepo, categoryRepo := setUpRecipeServiceData()
+	repo := &MockRecipeBundleRepository{}
+	return NewRecipeBundleService(repo, recipeRepo, recipeService.itemRepo, categoryRepo), repo
+}
+
+// validRecipeBundle returns a bundle of a single recipe, using each of its parts.
+func validRecipeBundle() *model.RecipeBundle {
+	return &model.RecipeBundle{
+		Version:          1,
+		Units:            []model.Unit{{Name: "Gram", Factor: 1, UnitType: enum.Weight}},
+		ItemCategories:   []model.ItemCategory{{Name: "PANTRY"}},
+		Items:            []model.Item{{Name: "Flour", UnitType: enum.Weight, ItemCategory: model.ItemCategory{Name: "PANTRY"}}},
+		RecipeCategories: []model.RecipeCategory{{Name: "DESSERT"}},
+		Recipes: []model.Recipe{
+			{
+				Name:        " Pancakes ",
+				Categories:  []model.RecipeCategory{{Name: "dessert"}},
+				Ingredients: []model.Ingredient{{Quantity: new(250.0), Item: model.Item{Name: "flour"}, Unit: model.Unit{Name: "gram "}}},
+			},
+		},
+	}
+}
+
+func TestNewRecipeBundleService(t *testing.T) {
+	repo := &MockRecipeBundleRepository{}
+	recipeRepo := &MockRecipeRepository{}
+	itemRepo := NewMockItemRepository()
+	categoryRepo := &MockRecipeCategoryRepository{}
+
+	service := NewRecipeBundleService(repo, recipeRepo, itemRepo, categoryRepo)
+
+	if service == nil {
+		t.Fatal("NewRecipeBundleService() returned nil")
+	}
+	if service.repo != repo || service.recipeRepo != recipeRepo || service.itemRepo != itemRepo || service.recipeCategoryRepo != categoryRepo {
+		t.Error("NewRecipeBundleService() repositories do not match the provided ones")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestExportRecipeBundle(t *testing.T) {
+	expected := &model.RecipeBundle{
+		Version:          1,
+		Units:            []model.Unit{{Name: "Gram", Factor: 1, UnitType: enum.Weight}},
+		ItemCategories:   []model.ItemCategory{{Name: "PANTRY"}},
+		Items:            []model.Item{{Name: "Flour", Description: new("All-purpose flour"), AverageMarketPrice: new(2.50), UnitType: enum.Weight, ItemCategory: model.ItemCategory{Name: "PANTRY"}}},
+		RecipeCategories: []model.RecipeCategory{{Name: "DESSERT"}},
+		Recipes: []model.Recipe{
+			{
+				Name:        "Pancakes",
+				Categories:  []model.RecipeCategory{{Name: "DESSERT"}},
+				Ingredients: []model.Ingredient{{Quantity: new(250.0), Item: model.Item{Name: "Flour"}, Unit: model.Unit{Name: "Gram"}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		groupID     int64
+		recipeID    *int64
+		categoryID  *int64
+		expected    *model.RecipeBundle
+		expectedErr error
+	}{
+		{"whole group", group1.ID, nil, nil, expected, nil},
+		{"single recipe", group1.ID, new(int64(1)), nil, expected, nil},
+		{"category", group1.ID, nil, new(recipeCategoryDessert.ID), expected, nil},
+		{"recipe of another group", group2.ID, new(int64(1)), nil, nil, customErrors.NewNotFoundError("recipes", "id", nil)},
+		{"category of another group", group1.ID, nil, new(recipeCategoryVegan.ID), nil, customErrors.NewNotFoundError("recipe_categories", "id", nil)},
+		{"recipe and category", group1.ID, new(int64(1)), new(recipeCategoryDessert.ID), nil, customErrors.NewInvalidParamsError([]string{"recipe_id", "category_id"}, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := setUpRecipeBundleServiceData()
+
+			actual, err := service.Export(tt.groupID, tt.recipeID, tt.categoryID)
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("Export() error = %v, expected %v", err, tt.expectedErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("Export() = %+v, expected %+v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestImportRecipeBundle(t *testing.T) {
+	service, repo := setUpRecipeBundleServiceData()
+	repo.ids = []int64{1}
+
+	actual, err := service.Import(context.Background(), group1.ID, validRecipeBundle())
+	if err != nil {
+		t.Fatalf("Import() unexpected error = %v", err)
+	}
+
+	if len(actual) != 1 || actual[0].ID != 1 || actual[0].Name != "Pancakes" {
+		t.Errorf("Import() = %+v, expected the created recipe", actual)
+	}
+	if repo.groupID != group1.ID {
+		t.Errorf("Import() group = %d, expected %d", repo.groupID, group1.ID)
+	}
+
+	recipe := repo.imported.Recipes[0]
+	if recipe.Name != "Pancakes" || recipe.Ingredients[0].Unit.Name != "gram" || recipe.CreatedAt.IsZero() {
+		t.Errorf("Import() imported recipe = %+v, expected a trimmed and dated recipe", recipe)
+	}
+}
+
+func TestImportRecipeBundleInvalid(t *testing.T) {
+	tests := []struct {
+		name           string
+		edit           func(bundle *model.RecipeBundle)
+		expectedFields []string
+	}{
+		{"unsupported version", func(b *model.RecipeBundle) { b.Version = 2 }, []string{"version"}},
+		{"no recipe", func(b *model.RecipeBundle) { b.Recipes = nil }, []string{"recipes"}},
+		{"invalid unit", func(b *model.RecipeBundle) { b.Units[0].Factor = 0 }, []string{"units"}},
+		{"blank item category", func(b *model.RecipeBundle) { b.ItemCategories[0].Name = " " }, []string{"item_categories", "items"}},
+		{"item without type", func(b *model.RecipeBundle) { b.Items[0].UnitType = enum.UnitType{} }, []string{"items"}},
+		{"blank recipe name", func(b *model.RecipeBundle) { b.Recipes[0].Name = "" }, []string{"recipes"}},
+		{"undefined recipe category", func(b *model.RecipeBundle) { b.RecipeCategories = nil }, []string{"recipes"}},
+		{"undefined item", func(b *model.RecipeBundle) { b.Recipes[0].Ingredients[0].Item.Name = "Sugar" }, []string{"recipes"}},
+		{"undefined unit", func(b *model.RecipeBundle) { b.Units[0].Name = "Kilogram" }, []string{"recipes"}},
+		{"negative quantity", func(b *model.RecipeBundle) { b.Recipes[0].Ingredients[0].Quantity = new(-1.0) }, []string{"recipes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpRecipeBundleServiceData()
+			bundle := validRecipeBundle()
+			tt.edit(bundle)
+
+			_, err := service.Import(context.Background(), group1.ID, bundle)
+			if !utils.CompareErrors(err, customErrors.NewInvalidParamsError(tt.expectedFields, nil)) {
+				t.Errorf("Import() error = %v, expected invalid fields %v", err, tt.expectedFields)
+			}
+			if repo.imported != nil {
+				t.Error("Import() shouldn't reach the repository")
+			}
+		})
+	}
+}
+
+func TestImportRecipeBundleRepositoryError(t *testing.T) {
+	service, repo := setUpRecipeBundleServiceData()
+	repo.importErr = errors.New("db failure")
+
+	if _, err := service.Import(context.Background(), group1.ID, validRecipeBundle()); err != repo.importErr {
+		t.Errorf("Import() error = %v, expected %v", err, repo.importErr)
+	}
+}
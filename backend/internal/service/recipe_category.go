@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type RecipeCategoryServiceInterface interface {
+	GetByID(id int64) (*model.RecipeCategory, error)
+	GetByGroupID(groupID int64, descending bool) ([]model.RecipeCategory, error)
+	GetRecipes(id int64, descending bool) ([]model.Recipe, error)
+	Create(category *model.RecipeCategory) (int64, error)
+	Update(category *model.RecipeCategory) error
+	Delete(ctx context.Context, id int64, detach bool) error
+}
+
+type RecipeCategoryService struct {
+	repo       repository.RecipeCategoryRepositoryInterface
+	recipeRepo repository.RecipeRepositoryInterface
+}
+
+// NewRecipeCategoryService creates a new RecipeCategoryService using the provided repositories,
+// the recipe one being used to list the recipes of a category.
+func NewRecipeCategoryService(repo repository.RecipeCategoryRepositoryInterface, recipeRepo repository.RecipeRepositoryInterface) *RecipeCategoryService {
+	return &RecipeCategoryService{
+		repo:       repo,
+		recipeRepo: recipeRepo,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID returns the recipe category identified by id.
+func (s *RecipeCategoryService) GetByID(id int64) (*model.RecipeCategory, error) {
+	return s.repo.GetByID(id)
+}
+
+// GetByGroupID returns all the recipe categories of a group, sorted by name.
+func (s *RecipeCategoryService) GetByGroupID(groupID int64, descending bool) ([]model.RecipeCategory, error) {
+	return s.repo.GetByGroupID(groupID, descending)
+}
+
+// GetRecipes returns the recipes belonging to the recipe category identified by id, sorted by name.
+func (s *RecipeCategoryService) GetRecipes(id int64, descending bool) ([]model.Recipe, error) {
+	if _, err := s.repo.GetByID(id); err != nil {
+		return nil, err
+	}
+
+	return s.recipeRepo.GetByCategoryID(id, descending)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create validates and adds a new recipe category to its group, returning its ID.
+func (s *RecipeCategoryService) Create(category *model.RecipeCategory) (int64, error) {
+	if err := s.validateRecipeCategory(category); err != nil {
+		return 0, err
+	}
+
+	return s.repo.Create(category)
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update validates and renames the recipe category identified by category.ID.
+// The group of a recipe category can't be updated.
+func (s *RecipeCategoryService) Update(category *model.RecipeCategory) error {
+	currentCategory, err := s.repo.GetByID(category.ID)
+	if err != nil {
+		return err
+	}
+
+	category.GroupID = currentCategory.GroupID
+
+	if err := s.validateRecipeCategory(category); err != nil {
+		return err
+	}
+
+	return s.repo.Update(category)
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the recipe category identified by id.
+// A category still used by recipes is only deleted when detach is true, the recipes being kept without it.
+func (s *RecipeCategoryService) Delete(ctx context.Context, id int64, detach bool) error {
+	return s.repo.Delete(ctx, id, detach)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// validateRecipeCategory trims the name of the category, checks its length
+// and ensures no other category of the group already uses it, whatever its case.
+func (s *RecipeCategoryService) validateRecipeCategory(category *model.RecipeCategory) error {
+	category.Name = strings.TrimSpace(category.Name)
+
+	if category.Name == "" || len(category.Name) > 100 {
+		slog.Debug(customErrors.RECIPE_CATEGORY_NAME_FIELD_ERROR, "name", category.Name)
+		return customErrors.NewValidationError("name", customErrors.RECIPE_CATEGORY_NAME_FIELD_ERROR, nil)
+	}
+
+	existing, err := s.repo.GetByNameAndGroupID(category.Name, category.GroupID)
+	if err != nil {
+		if _, isNotFoundError := errors.AsType[*customErrors.NotFoundError](err); isNotFoundError {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != category.ID {
+		return customErrors.NewConflictError("RecipeCategory", "a recipe category with this name already exists in the group", nil)
+	}
+
+	return nil
+}
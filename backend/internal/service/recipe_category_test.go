@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockRecipeCategoryRepository struct {
+	categories []model.RecipeCategory
+	err        error
+	createErr  error
+	updateErr  error
+	deleteErr  error
+	created    *model.RecipeCategory
+	updated    *model.RecipeCategory
+	deletedID  int64
+	detached   bool
+}
+
+func (m *MockRecipeCategoryRepository) GetByID(id int64) (*model.RecipeCategory, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	for i := range m.categories {
+		if m.categories[i].ID == id {
+			return &m.categories[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("recipe_categories", "id", nil)
+}
+
+func (m *MockRecipeCategoryRepository) GetByGroupID(groupID int64, _ bool) ([]model.RecipeCategory, error) {
+	categories := []model.RecipeCategory{}
+	for _, category := range m.categories {
+		if category.GroupID == groupID {
+			categories = append(categories, category)
+		}
+	}
+	return categories, nil
+}
+
+func (m *MockRecipeCategoryRepository) GetByNameAndGroupID(name string, groupID int64) (*model.RecipeCategory, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	for i := range m.categories {
+		if strings.EqualFold(m.categories[i].Name, name) && m.categories[i].GroupID == groupID {
+			return &m.categories[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("recipe_categories", "name, group_id", nil)
+}
+
+func (m *MockRecipeCategoryRepository) Create(category *model.RecipeCategory) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	m.created = category
+	return 42, nil
+}
+
+func (m *MockRecipeCategoryRepository) Update(category *model.RecipeCategory) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+
+	m.updated = category
+	return nil
+}
+
+func (m *MockRecipeCategoryRepository) Delete(_ context.Context, id int64, detach bool) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	m.deletedID = id
+	m.detached = detach
+	return nil
+}
+
+// setUpRecipeCategoryServiceData builds a RecipeCategoryService sharing its categories with the recipes of setUpRecipeServiceData.
+func setUpRecipeCategoryServiceData() (*RecipeCategoryService, *MockRecipeCategoryRepository) {
+	_, recipeRepo, categoryRepo := setUpRecipeServiceData()
+	return NewRecipeCategoryService(categoryRepo, recipeRepo), categoryRepo
+}
+
+func TestNewRecipeCategoryService(t *testing.T) {
+	categoryRepo := &MockRecipeCategoryRepository{}
+	recipeRepo := NewMockRecipeRepository()
+
+	service := NewRecipeCategoryService(categoryRepo, recipeRepo)
+
+	if service == nil {
+		t.Fatal("NewRecipeCategoryService() returned nil")
+	}
+	if service.repo != categoryRepo {
+		t.Error("NewRecipeCategoryService() did not set the recipe category repository")
+	}
+	if service.recipeRepo != recipeRepo {
+		t.Error("NewRecipeCategoryService() did not set the recipe repository")
+	}
+}
+
+func TestGetRecipeCategoryByID(t *testing.T) {
+	service, _ := setUpRecipeCategoryServiceData()
+
+	category, err := service.GetByID(recipeCategoryDessert.ID)
+	if err != nil {
+		t.Fatalf("GetByID() unexpected error = %v", err)
+	}
+	if !reflect.DeepEqual(*category, recipeCategoryDessert) {
+		t.Errorf("GetByID() = %+v, want %+v", *category, recipeCategoryDessert)
+	}
+
+	expectedErr := customErrors.NewNotFoundError("recipe_categories", "id", nil)
+	if _, err := service.GetByID(-1); !utils.CompareErrors(err, expectedErr) {
+		t.Errorf("GetByID() error = %v, want %v", err, expectedErr)
+	}
+}
+
+func TestGetRecipeCategoriesByGroupID(t *testing.T) {
+	service, _ := setUpRecipeCategoryServiceData()
+
+	categories, err := service.GetByGroupID(group2.ID, false)
+	if err != nil {
+		t.Fatalf("GetByGroupID() unexpected error = %v", err)
+	}
+	if !reflect.DeepEqual(categories, []model.RecipeCategory{recipeCategoryVegan}) {
+		t.Errorf("GetByGroupID() = %+v, want only the VEGAN category", categories)
+	}
+}
+
+func TestGetRecipeCategoryRecipes(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		expectedIDs []int64
+		err         error
+	}{
+		{"Category with recipes", recipeCategoryDessert.ID, []int64{1}, nil},
+		{"Category without recipe", recipeCategoryVegan.ID, []int64{}, nil},
+		{"Unknown category", -1, nil, customErrors.NewNotFoundError("recipe_categories", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := setUpRecipeCategoryServiceData()
+
+			recipes, err := service.GetRecipes(tt.id, false)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("GetRecipes() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GetRecipes() unexpected error = %v", err)
+			}
+
+			if len(recipes) != len(tt.expectedIDs) {
+				t.Fatalf("GetRecipes() returned %d recipes, want %d", len(recipes), len(tt.expectedIDs))
+			}
+			for i := range recipes {
+				if recipes[i].ID != tt.expectedIDs[i] {
+					t.Errorf("GetRecipes()[%d].ID = %d, want %d", i, recipes[i].ID, tt.expectedIDs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCreateRecipeCategory(t *testing.T) {
+	tests := []struct {
+		name         string
+		category     model.RecipeCategory
+		expectedName string
+		createErr    error
+		err          error
+	}{
+		{
+			name:         "Valid category",
+			category:     model.RecipeCategory{Name: "  SOUP  ", GroupID: group1.ID},
+			expectedName: "SOUP",
+		},
+		{
+			name:         "Name used by another group",
+			category:     model.RecipeCategory{Name: "VEGAN", GroupID: group1.ID},
+			expectedName: "VEGAN",
+		},
+		{
+			name:     "Empty name",
+			category: model.RecipeCategory{Name: "   ", GroupID: group1.ID},
+			err:      customErrors.NewValidationError("name", customErrors.RECIPE_CATEGORY_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:     "Too long name",
+			category: model.RecipeCategory{Name: strings.Repeat("a", 101), GroupID: group1.ID},
+			err:      customErrors.NewValidationError("name", customErrors.RECIPE_CATEGORY_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:     "Name already used in the group",
+			category: model.RecipeCategory{Name: "Dessert", GroupID: group1.ID},
+			err:      customErrors.NewConflictError("RecipeCategory", "a recipe category with this name already exists in the group", nil),
+		},
+		{
+			name:      "Repository error",
+			category:  model.RecipeCategory{Name: "SOUP", GroupID: -1},
+			createErr: customErrors.NewNotFoundError("groups", "id", nil),
+			err:       customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, categoryRepo := setUpRecipeCategoryServiceData()
+			categoryRepo.createErr = tt.createErr
+
+			id, err := service.Create(&tt.category)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Create() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+
+			if id != 42 {
+				t.Errorf("Create() id = %d, want 42", id)
+			}
+			if categoryRepo.created.Name != tt.expectedName {
+				t.Errorf("Create() name = %q, want %q", categoryRepo.created.Name, tt.expectedName)
+			}
+		})
+	}
+}
+
+func TestUpdateRecipeCategory(t *testing.T) {
+	tests := []struct {
+		name      string
+		category  model.RecipeCategory
+		updateErr error
+		err       error
+	}{
+		{
+			name:     "Rename",
+			category: model.RecipeCategory{ID: recipeCategoryDessert.ID, Name: "SWEETS"},
+		},
+		{
+			name:     "Change the case of its own name",
+			category: model.RecipeCategory{ID: recipeCategoryDessert.ID, Name: "Dessert"},
+		},
+		{
+			name:     "Group can't be changed",
+			category: model.RecipeCategory{ID: recipeCategoryVegan.ID, Name: "DESSERT", GroupID: group1.ID},
+		},
+		{
+			name:     "Unknown category",
+			category: model.RecipeCategory{ID: -1, Name: "SWEETS"},
+			err:      customErrors.NewNotFoundError("recipe_categories", "id", nil),
+		},
+		{
+			name:     "Empty name",
+			category: model.RecipeCategory{ID: recipeCategoryDessert.ID, Name: ""},
+			err:      customErrors.NewValidationError("name", customErrors.RECIPE_CATEGORY_NAME_FIELD_ERROR, nil),
+		},
+		{
+			name:      "Repository error",
+			category:  model.RecipeCategory{ID: recipeCategoryDessert.ID, Name: "SWEETS"},
+			updateErr: customErrors.NewInternalError("failed to update recipe category", nil),
+			err:       customErrors.NewInternalError("failed to update recipe category", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, categoryRepo := setUpRecipeCategoryServiceData()
+			categoryRepo.updateErr = tt.updateErr
+			currentCategory, _ := categoryRepo.GetByID(tt.category.ID)
+
+			err := service.Update(&tt.category)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Update() unexpected error = %v", err)
+			}
+
+			if categoryRepo.updated.GroupID != currentCategory.GroupID {
+				t.Errorf("Update() group = %d, want %d", categoryRepo.updated.GroupID, currentCategory.GroupID)
+			}
+		})
+	}
+}
+
+func TestDeleteRecipeCategory(t *testing.T) {
+	tests := []struct {
+		name      string
+		detach    bool
+		deleteErr error
+	}{
+		{"Unused category", false, nil},
+		{"Detach the recipes", true, nil},
+		{"Repository error", false, customErrors.NewConflictError("RecipeCategory", "can't delete recipe category used by recipes", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, categoryRepo := setUpRecipeCategoryServiceData()
+			categoryRepo.deleteErr = tt.deleteErr
+
+			err := service.Delete(context.Background(), 1, tt.detach)
+
+			if tt.deleteErr != nil {
+				if !utils.CompareErrors(err, tt.deleteErr) {
+					t.Fatalf("Delete() error = %v, want %v", err, tt.deleteErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Delete() unexpected error = %v", err)
+			}
+
+			if categoryRepo.deletedID != 1 || categoryRepo.detached != tt.detach {
+				t.Errorf("Delete() deleted %d with detach %t, want 1 with detach %t", categoryRepo.deletedID, categoryRepo.detached, tt.detach)
+			}
+		})
+	}
+}
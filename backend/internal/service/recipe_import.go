@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/ingredientline"
+	"github.com/zouipo/yumsday/backend/internal/pkg/schemaorg"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type RecipeImportServiceInterface interface {
+	Import(ctx context.Context, groupID int64, link, page *string, language *enum.Language) (*model.RecipeDraft, error)
+	ParseIngredients(groupID int64, lines []string, language enum.Language) ([]model.ParsedIngredient, error)
+}
+
+type RecipeImportService struct {
+	itemRepo repository.ItemRepositoryInterface
+	unitRepo repository.UnitRepositoryInterface
+	client   *http.Client
+}
+
+// NewRecipeImportService creates a new RecipeImportService using the provided repositories to match the ingredients
+// of the imported recipes, and the provided client to fetch their pages.
+func NewRecipeImportService(itemRepo repository.ItemRepositoryInterface,
+	unitRepo repository.UnitRepositoryInterface,
+	client *http.Client) *RecipeImportService {
+	return &RecipeImportService{
+		itemRepo: itemRepo,
+		unitRepo: unitRepo,
+		client:   client,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// Import drafts a recipe of a group from the schema.org Recipe JSON-LD of a web page, either fetched from link or pasted as page;
+// exactly one of them must be provided. The draft isn't saved: it is meant to be reviewed, then created as any other recipe.
+// Its ingredients are the lines of the page parsed as done by ParseIngredients, in the given language if any,
+// else in the inLanguage of the recipe, else in English; the lines whose item or unit isn't known to the group are left unmatched.
+func (s *RecipeImportService) Import(ctx context.Context, groupID int64, link, page *string, language *enum.Language) (*model.RecipeDraft, error) {
+	e := customErrors.NewInvalidParamsError([]string{}, nil).(*customErrors.InvalidParamsError)
+
+	switch {
+	case (link == nil) == (page == nil):
+		e.AddInvalidField("url")
+		e.AddInvalidField("html")
+	case link != nil && !isWebURL(*link):
+		e.AddInvalidField("url")
+	case page != nil && len(*page) > constant.MAX_RECIPE_IMPORT_SIZE:
+		e.AddInvalidField("html")
+	}
+
+	if len(e.Fields) > 0 {
+		return nil, e
+	}
+
+	field := "html"
+	var content []byte
+	if link != nil {
+		field = "url"
+
+		var err error
+		if content, err = s.fetch(ctx, *link); err != nil {
+			return nil, err
+		}
+	} else {
+		content = []byte(*page)
+	}
+
+	recipe, err := schemaorg.ExtractRecipe(content)
+	if err != nil {
+		return nil, customErrors.NewValidationError(field, customErrors.RECIPE_IMPORT_NOT_FOUND_ERROR, err)
+	}
+
+	if language == nil {
+		language = new(languageFromTag(recipe.Language))
+	}
+
+	parsed, err := s.parseLines(groupID, recipe.Ingredients, *language)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := &model.RecipeDraft{
+		Recipe: model.Recipe{
+			Name:               recipe.Name,
+			Description:        optionalText(recipe.Description),
+			ImageURL:           optionalText(recipe.Image),
+			OriginalLink:       link,
+			PreparationTimeMin: durationMinutes(recipe.PrepTime),
+			CookingTimeMin:     durationMinutes(recipe.CookTime),
+			Servings:           recipe.Yield,
+			Instructions:       optionalText(strings.Join(recipe.Instructions, "\n")),
+			GroupID:            groupID,
+			Categories:         []model.RecipeCategory{},
+			Ingredients:        []model.Ingredient{},
+		},
+		UnmatchedIngredients: []string{},
+	}
+
+	for _, ingredient := range parsed {
+		if ingredient.Item == nil || ingredient.Unit == nil {
+			draft.UnmatchedIngredients = append(draft.UnmatchedIngredients, ingredient.Line)
+			continue
+		}
+		draft.Recipe.Ingredients = append(draft.Recipe.Ingredients, model.Ingredient{
+			Quantity: ingredient.Quantity,
+			Item:     *ingredient.Item,
+			Unit:     *ingredient.Unit,
+		})
+	}
+
+	return draft, nil
+}
+
+// ParseIngredients splits ingredient lines of free text written in a language, e.g. pasted from a recipe, into their
+// quantity, unit, item and note, resolved against the units and items of a group by resolveIngredient.
+// Between 1 and constant.MAX_INGREDIENT_LINES lines must be provided; blank lines are skipped.
+func (s *RecipeImportService) ParseIngredients(groupID int64, lines []string, language enum.Language) ([]model.ParsedIngredient, error) {
+	if len(lines) == 0 || len(lines) > constant.MAX_INGREDIENT_LINES {
+		return nil, customErrors.NewInvalidParamsError([]string{"lines"}, nil)
+	}
+
+	return s.parseLines(groupID, lines, language)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// parseLines parses ingredient lines written in a language, knowing the custom units of the group,
+// and resolves them against the units and items of the group. Blank lines are skipped.
+func (s *RecipeImportService) parseLines(groupID int64, lines []string, language enum.Language) ([]model.ParsedIngredient, error) {
+	items, err := s.itemRepo.GetByGroupID(groupID, "Name", false)
+	if err != nil {
+		return nil, err
+	}
+	units, err := s.unitRepo.GetByGroupID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	customUnits := []string{}
+	for _, unit := range units {
+		if unit.GroupID != nil {
+			customUnits = append(customUnits, unit.Name)
+		}
+	}
+	parser := ingredientline.NewParser(language, customUnits...)
+
+	parsed := []model.ParsedIngredient{}
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		parsed = append(parsed, resolveIngredient(parser.Parse(line), groupID, items, units))
+	}
+
+	return parsed, nil
+}
+
+// fetch downloads the page of a recipe, at most constant.MAX_RECIPE_IMPORT_SIZE bytes of it.
+func (s *RecipeImportService) fetch(ctx context.Context, link string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return nil, customErrors.NewInvalidParamsError([]string{"url"}, err)
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, customErrors.NewValidationError("url", customErrors.RECIPE_IMPORT_FETCH_ERROR, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, customErrors.NewValidationError("url", customErrors.RECIPE_IMPORT_FETCH_ERROR, fmt.Errorf("unexpected status %s", res.Status))
+	}
+
+	content, err := io.ReadAll(io.LimitReader(res.Body, constant.MAX_RECIPE_IMPORT_SIZE))
+	if err != nil {
+		return nil, customErrors.NewValidationError("url", customErrors.RECIPE_IMPORT_FETCH_ERROR, err)
+	}
+
+	return content, nil
+}
+
+// languageFromTag returns the language of a BCP 47 tag such as "fr-FR", read from its primary subtag,
+// or English for an empty tag or a language other than the supported ones.
+func languageFromTag(tag string) enum.Language {
+	primary, _, _ := strings.Cut(tag, "-")
+	switch strings.ToLower(strings.TrimSpace(primary)) {
+	case "fr":
+		return enum.French
+	default:
+		return enum.English
+	}
+}
+
+// isWebURL tells whether link is an absolute HTTP or HTTPS URL.
+func isWebURL(link string) bool {
+	u, err := url.Parse(link)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// optionalText returns nil for an empty text.
+func optionalText(text string) *string {
+	if text == "" {
+		return nil
+	}
+	return &text
+}
+
+// durationMinutes rounds a duration to the nearest minute.
+func durationMinutes(duration *time.Duration) *int {
+	if duration == nil {
+		return nil
+	}
+	return new(int(math.Round(duration.Minutes())))
+}
+
+// resolveIngredient finds the unit and the item of a parsed ingredient line among the units and items of a group.
+// Its item is the item whose name, possibly in the plural or singular, is the longest found in the item part of the line.
+// When none is found, an item of the group named after that part is offered as a new item, its unit type being the one
+// of the unit of the line, or pieces for counted items such as "2 lemons". Lines without unit get the base unit of the
+// unit type of their item.
+func resolveIngredient(line ingredientline.Line, groupID int64, items []model.Item, units []model.Unit) model.ParsedIngredient {
+	parsed := model.ParsedIngredient{
+		Line:        line.Text,
+		Quantity:    line.Quantity,
+		MinQuantity: line.MinQuantity,
+		Note:        line.Note,
+	}
+
+	if line.Unit != "" {
+		parsed.Unit = findUnit(line.Unit, units)
+	}
+
+	text := strings.ToLower(line.Item)
+	for i := range items {
+		if parsed.Item != nil && len(items[i].Name) <= len(parsed.Item.Name) {
+			continue
+		}
+		if mentionIndex(text, strings.ToLower(items[i].Name)) >= 0 {
+			parsed.Item = &items[i]
+		}
+	}
+
+	var unitType enum.UnitType
+	switch {
+	case parsed.Item != nil:
+		unitType = parsed.Item.UnitType
+	case line.Item == "":
+		return parsed
+	case parsed.Unit != nil:
+		unitType = parsed.Unit.UnitType
+	case line.Quantity != nil:
+		unitType = enum.Piece
+	default:
+		unitType = enum.Undefined
+	}
+
+	if parsed.Item == nil {
+		first, size := utf8.DecodeRuneInString(line.Item)
+		parsed.NewItem = &model.Item{
+			Name:     string(unicode.ToUpper(first)) + line.Item[size:],
+			UnitType: unitType,
+			GroupID:  groupID,
+		}
+	}
+
+	if parsed.Unit == nil && line.Unit == "" {
+		parsed.Unit = baseUnit(unitType, units)
+	}
+
+	return parsed
+}
+
+// mentionIndex returns the index of the first mention of an item name in a text, as whole words,
+// the name being possibly put in the plural or in the singular. It returns -1 if the name isn't mentioned.
+func mentionIndex(text, name string) int {
+	for _, form := range []string{name, name + "s", name + "es", strings.TrimSuffix(name, "s")} {
+		for offset := 0; offset < len(text); {
+			index := strings.Index(text[offset:], form)
+			if index < 0 {
+				break
+			}
+			start, end := offset+index, offset+index+len(form)
+
+			before, _ := utf8.DecodeLastRuneInString(text[:start])
+			after, _ := utf8.DecodeRuneInString(text[end:])
+			if !isWordRune(before) && !isWordRune(after) {
+				return start
+			}
+			offset = start + 1
+		}
+	}
+	return -1
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// findUnit returns the unit of a name, as given by ingredientline.Parser.
+// The custom units of the group take precedence over the catalogue.
+func findUnit(name string, units []model.Unit) *model.Unit {
+	var found *model.Unit
+	for i := range units {
+		if !strings.EqualFold(units[i].Name, name) {
+			continue
+		}
+		if units[i].GroupID != nil {
+			return &units[i]
+		}
+		if found == nil {
+			found = &units[i]
+		}
+	}
+	return found
+}
+
+// baseUnit returns the unit of the catalogue which is the base of a unit type, i.e. whose factor is 1.
+func baseUnit(unitType enum.UnitType, units []model.Unit) *model.Unit {
+	for i := range units {
+		if units[i].GroupID == nil && units[i].UnitType == unitType && units[i].Factor == 1 {
+			return &units[i]
+		}
+	}
+	return nil
+}
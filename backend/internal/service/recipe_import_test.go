@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+var (
+	unitMilliliter = model.Unit{ID: 4, Name: "Milliliter", Factor: 1, UnitType: enum.Volume}
+	unitTablespoon = model.Unit{ID: 6, Name: "Tablespoon", Factor: 15, UnitType: enum.Volume}
+
+	importedRecipePage = `<html><head>
+<script type="application/ld+json">
+{
+  "@context": "https://schema.org",
+  "@type": "Recipe",
+  "name": "Pepper Rice",
+  "description": "Rice with a kick.",
+  "image": "https://cooking.example.com/rice.jpg",
+  "prepTime": "PT5M",
+  "cookTime": "PT1H",
+  "recipeYield": "2 servings",
+  "recipeIngredient": ["250 g rice", "2 tbsp olive oil", "Pepper", "2 eggs"],
+  "recipeInstructions": [
+    {"@type": "HowToStep", "text": "Cook the rice."},
+    {"@type": "HowToStep", "text": "Season with oil and pepper."}
+  ]
+}
+</script>
+</head><body></body></html>`
+)
+
+func setUpRecipeImportServiceData(client *http.Client) *RecipeImportService {
+	unitRepo := &MockUnitRepository{units: []model.Unit{unitKilogram, unitGram, unitMilliliter, unitTablespoon, unitPiece, unitHandful}}
+	return NewRecipeImportService(setUpDataTestItem(), unitRepo, client)
+}
+
+func TestNewRecipeImportService(t *testing.T) {
+	itemRepo := &MockItemRepository{}
+	unitRepo := &MockUnitRepository{}
+	client := &http.Client{}
+
+	service := NewRecipeImportService(itemRepo, unitRepo, client)
+
+	if service == nil {
+		t.Fatal("NewRecipeImportService() returned nil")
+	}
+	if service.itemRepo != itemRepo || service.unitRepo != unitRepo || service.client != client {
+		t.Error("NewRecipeImportService() dependencies do not match the provided ones")
+	}
+}
+
+func TestImportRecipe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pepper-rice":
+			w.Write([]byte(importedRecipePage))
+		case "/about":
+			w.Write([]byte("<html><body>About us</body></html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	expectedRecipe := model.Recipe{
+		Name:               "Pepper Rice",
+		Description:        new("Rice with a kick."),
+		ImageURL:           new("https://cooking.example.com/rice.jpg"),
+		PreparationTimeMin: new(5),
+		CookingTimeMin:     new(60),
+		Servings:           new(2),
+		Instructions:       new("Cook the rice.\nSeason with oil and pepper."),
+		GroupID:            group1.ID,
+		Categories:         []model.RecipeCategory{},
+		Ingredients: []model.Ingredient{
+			{Quantity: new(250.0), Item: items[1], Unit: unitGram},
+			{Quantity: new(2.0), Item: items[4], Unit: unitTablespoon},
+			{Item: items[3], Unit: unitGram},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		link     *string
+		page     *string
+		expected *model.RecipeDraft
+		err      error
+	}{
+		{
+			name:     "Pasted page",
+			page:     new(importedRecipePage),
+			expected: &model.RecipeDraft{Recipe: expectedRecipe, UnmatchedIngredients: []string{"2 eggs"}},
+		},
+		{
+			name: "Fetched page",
+			link: new(server.URL + "/pepper-rice"),
+			expected: &model.RecipeDraft{
+				Recipe: func() model.Recipe {
+					recipe := expectedRecipe
+					recipe.OriginalLink = new(server.URL + "/pepper-rice")
+					return recipe
+				}(),
+				UnmatchedIngredients: []string{"2 eggs"},
+			},
+		},
+		{
+			name: "Neither link nor page",
+			err:  customErrors.NewInvalidParamsError([]string{"url", "html"}, nil),
+		},
+		{
+			name: "Both link and page",
+			link: new(server.URL + "/pepper-rice"),
+			page: new(importedRecipePage),
+			err:  customErrors.NewInvalidParamsError([]string{"url", "html"}, nil),
+		},
+		{
+			name: "Link other than HTTP",
+			link: new("ftp://cooking.example.com/pepper-rice"),
+			err:  customErrors.NewInvalidParamsError([]string{"url"}, nil),
+		},
+		{
+			name: "Relative link",
+			link: new("/pepper-rice"),
+			err:  customErrors.NewInvalidParamsError([]string{"url"}, nil),
+		},
+		{
+			name: "Too large page",
+			page: new(strings.Repeat(" ", constant.MAX_RECIPE_IMPORT_SIZE+1)),
+			err:  customErrors.NewInvalidParamsError([]string{"html"}, nil),
+		},
+		{
+			name: "Missing page",
+			link: new(server.URL + "/missing"),
+			err:  customErrors.NewValidationError("url", customErrors.RECIPE_IMPORT_FETCH_ERROR, nil),
+		},
+		{
+			name: "Fetched page without recipe",
+			link: new(server.URL + "/about"),
+			err:  customErrors.NewValidationError("url", customErrors.RECIPE_IMPORT_NOT_FOUND_ERROR, nil),
+		},
+		{
+			name: "Pasted page without recipe",
+			page: new("<p>Pepper rice: cook the rice, add pepper.</p>"),
+			err:  customErrors.NewValidationError("html", customErrors.RECIPE_IMPORT_NOT_FOUND_ERROR, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setUpRecipeImportServiceData(server.Client())
+
+			actual, err := service.Import(context.Background(), group1.ID, tt.link, tt.page, nil)
+
+			if !utils.CompareErrors(err, tt.err) {
+				t.Fatalf("Import() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("Import() = %+v, want %+v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+// TestImportRecipe_Ingredients verifies that the ingredient lines of an imported page are parsed as by ParseIngredients.
+func TestImportRecipe_Ingredients(t *testing.T) {
+	eggs := model.Item{ID: 9, Name: "Eggs", UnitType: enum.Piece, GroupID: group1.ID}
+
+	tests := []struct {
+		name     string
+		line     string
+		expected *model.Ingredient
+	}{
+		{"Quantity and abbreviated unit", "250 g flour", &model.Ingredient{Quantity: new(250.0), Item: items[0], Unit: unitGram}},
+		{"Unit stuck to the quantity", "1,5kg rice", &model.Ingredient{Quantity: new(1.5), Item: items[1], Unit: unitKilogram}},
+		{"Unit name in the plural", "2 Tablespoons Olive Oil", &model.Ingredient{Quantity: new(2.0), Item: items[4], Unit: unitTablespoon}},
+		{"Abbreviation with a dot and a note", "1 tbsp. olive oil, extra virgin", &model.Ingredient{Quantity: new(1.0), Item: items[4], Unit: unitTablespoon}},
+		{"Mixed number", "2 1/2 tablespoons olive oil", &model.Ingredient{Quantity: new(2.5), Item: items[4], Unit: unitTablespoon}},
+		{"Vulgar fraction", "½ tbsp olive oil", &model.Ingredient{Quantity: new(0.5), Item: items[4], Unit: unitTablespoon}},
+		{"Item without unit", "2 eggs", &model.Ingredient{Quantity: new(2.0), Item: eggs, Unit: unitPiece}},
+		{"Item in the singular", "1 egg", &model.Ingredient{Quantity: new(1.0), Item: eggs, Unit: unitPiece}},
+		{"Item without quantity", "Freshly ground pepper", &model.Ingredient{Item: items[3], Unit: unitGram}},
+		{"Unit unknown to the group", "2 cups rice", nil},
+		{"Unknown item", "200 g butter", nil},
+		{"Partial word", "100 g ricotta", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setUpRecipeImportServiceData(nil)
+			service.itemRepo.(*MockItemRepository).items = append([]model.Item{eggs}, items...)
+			page := `<script type="application/ld+json">{"@type": "Recipe", "name": "Test", "recipeIngredient": [` + strconv.Quote(tt.line) + `]}</script>`
+
+			draft, err := service.Import(context.Background(), group1.ID, nil, &page, nil)
+			if err != nil {
+				t.Fatalf("Import() unexpected error = %v", err)
+			}
+
+			if tt.expected == nil {
+				if len(draft.Recipe.Ingredients) != 0 || !reflect.DeepEqual(draft.UnmatchedIngredients, []string{tt.line}) {
+					t.Errorf("Import() expected %q to be unmatched, got %+v and %v", tt.line, draft.Recipe.Ingredients, draft.UnmatchedIngredients)
+				}
+				return
+			}
+			if len(draft.Recipe.Ingredients) != 1 || !reflect.DeepEqual(draft.Recipe.Ingredients[0], *tt.expected) {
+				t.Errorf("Import() ingredients of %q = %+v, want %+v", tt.line, draft.Recipe.Ingredients, *tt.expected)
+			}
+		})
+	}
+}
+
+// TestImportRecipe_Language verifies that the ingredient lines of an imported page are read in the requested language,
+// else in the language of the recipe, else in English.
+func TestImportRecipe_Language(t *testing.T) {
+	// "c. à soupe" is only read as a tablespoon in French.
+	const line = "2 c. à soupe d'olive oil"
+
+	tests := []struct {
+		name       string
+		inLanguage string
+		language   *enum.Language
+		matched    bool
+	}{
+		{"Language of the recipe", `"fr-FR"`, nil, true},
+		{"Language object of the recipe", `{"@type": "Language", "name": "French", "alternateName": "fr"}`, nil, true},
+		{"Requested language", `""`, &enum.French, true},
+		{"Requested language over the one of the recipe", `"fr"`, &enum.English, false},
+		{"Unsupported language of the recipe", `"de-DE"`, nil, false},
+		{"No language", `""`, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setUpRecipeImportServiceData(nil)
+			page := `<script type="application/ld+json">{"@type": "Recipe", "name": "Test", "inLanguage": ` + tt.inLanguage +
+				`, "recipeIngredient": [` + strconv.Quote(line) + `]}</script>`
+
+			draft, err := service.Import(context.Background(), group1.ID, nil, &page, tt.language)
+			if err != nil {
+				t.Fatalf("Import() unexpected error = %v", err)
+			}
+
+			expected := model.Ingredient{Quantity: new(2.0), Item: items[4], Unit: unitTablespoon}
+			if matched := len(draft.Recipe.Ingredients) == 1 && reflect.DeepEqual(draft.Recipe.Ingredients[0], expected); matched != tt.matched {
+				t.Errorf("Import() ingredients = %+v and unmatched %v, expected matched = %v", draft.Recipe.Ingredients, draft.UnmatchedIngredients, tt.matched)
+			}
+		})
+	}
+}
+
+func TestParseIngredients(t *testing.T) {
+	tests := []struct {
+		name     string
+		groupID  int64
+		lines    []string
+		language enum.Language
+		expected []model.ParsedIngredient
+		err      error
+	}{
+		{
+			name:     "Known items and units",
+			groupID:  group1.ID,
+			lines:    []string{"2 1/2 tbsp olive oil, extra virgin", "", "1½ kg rice", "Freshly ground pepper"},
+			language: enum.English,
+			expected: []model.ParsedIngredient{
+				{Line: "2 1/2 tbsp olive oil, extra virgin", Quantity: new(2.5), Unit: &unitTablespoon, Item: &items[4], Note: "extra virgin"},
+				{Line: "1½ kg rice", Quantity: new(1.5), Unit: &unitKilogram, Item: &items[1]},
+				{Line: "Freshly ground pepper", Unit: &unitGram, Item: &items[3]},
+			},
+		},
+		{
+			name:     "Unknown items offered as new ones",
+			groupID:  group1.ID,
+			lines:    []string{"200 g de beurre", "2 à 3 citrons", "un peu de sel"},
+			language: enum.French,
+			expected: []model.ParsedIngredient{
+				{Line: "200 g de beurre", Quantity: new(200.0), Unit: &unitGram,
+					NewItem: &model.Item{Name: "Beurre", UnitType: enum.Weight, GroupID: group1.ID}},
+				{Line: "2 à 3 citrons", Quantity: new(3.0), MinQuantity: new(2.0), Unit: &unitPiece,
+					NewItem: &model.Item{Name: "Citrons", UnitType: enum.Piece, GroupID: group1.ID}},
+				{Line: "un peu de sel", NewItem: &model.Item{Name: "Un peu de sel", UnitType: enum.Undefined, GroupID: group1.ID}},
+			},
+		},
+		{
+			name:     "Custom unit of the group",
+			groupID:  group2.ID,
+			lines:    []string{"2 handfuls of water"},
+			language: enum.English,
+			expected: []model.ParsedIngredient{
+				{Line: "2 handfuls of water", Quantity: new(2.0), Unit: &unitHandful, Item: &items[2]},
+			},
+		},
+		{
+			name:     "Custom unit of another group",
+			groupID:  group1.ID,
+			lines:    []string{"2 handfuls of rice"},
+			language: enum.English,
+			expected: []model.ParsedIngredient{
+				{Line: "2 handfuls of rice", Quantity: new(2.0), Unit: &unitGram, Item: &items[1]},
+			},
+		},
+		{
+			name:     "No lines",
+			groupID:  group1.ID,
+			lines:    []string{},
+			language: enum.English,
+			err:      customErrors.NewInvalidParamsError([]string{"lines"}, nil),
+		},
+		{
+			name:     "Too many lines",
+			groupID:  group1.ID,
+			lines:    make([]string, constant.MAX_INGREDIENT_LINES+1),
+			language: enum.English,
+			err:      customErrors.NewInvalidParamsError([]string{"lines"}, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := setUpRecipeImportServiceData(http.DefaultClient)
+
+			actual, err := service.ParseIngredients(tt.groupID, tt.lines, tt.language)
+
+			if !utils.CompareErrors(err, tt.err) {
+				t.Fatalf("ParseIngredients() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("ParseIngredients() = %+v, want %+v", actual, tt.expected)
+			}
+		})
+	}
+}
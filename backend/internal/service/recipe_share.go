@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type RecipeShareServiceInterface interface {
+	GetPublicByID(id int64) (*model.Recipe, error)
+	Copy(ctx context.Context, id int64, groupID int64) (*model.Recipe, error)
+}
+
+type RecipeShareService struct {
+	recipeRepo          repository.RecipeRepositoryInterface
+	recipeBundleService RecipeBundleServiceInterface
+}
+
+// NewRecipeShareService creates a new RecipeShareService using the provided recipe repository,
+// and the recipe bundle service through which public recipes are copied between groups.
+func NewRecipeShareService(recipeRepo repository.RecipeRepositoryInterface, recipeBundleService RecipeBundleServiceInterface) *RecipeShareService {
+	return &RecipeShareService{
+		recipeRepo:          recipeRepo,
+		recipeBundleService: recipeBundleService,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetPublicByID retrieves a public recipe by its ID, regardless of its group.
+// A NotFoundError is returned if the recipe isn't public, so that private recipes can't be told from missing ones.
+func (s *RecipeShareService) GetPublicByID(id int64) (*model.Recipe, error) {
+	recipe, err := s.recipeRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !recipe.Public {
+		return nil, customErrors.NewNotFoundError("recipes", "id", nil)
+	}
+
+	return recipe, nil
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Copy creates in a group a private copy of a public recipe, possibly from another group, and returns it.
+// The copy is imported as a bundle, its items and units being matched by name to the ones of the group. It's built
+// only from what the public recipe shows: its comment and recipe categories, private to its original group,
+// aren't copied, nor are the descriptions, prices and categories of its items.
+func (s *RecipeShareService) Copy(ctx context.Context, id int64, groupID int64) (*model.Recipe, error) {
+	recipe, err := s.GetPublicByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	recipes, err := s.recipeBundleService.Import(ctx, groupID, publicRecipeBundle(recipe))
+	if err != nil {
+		return nil, err
+	}
+
+	return &recipes[0], nil
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// publicRecipeBundle builds a bundle holding a private copy of a public recipe, from its public fields only.
+// Its items and units are referenced by name; as the public recipe doesn't show the unit type of its items,
+// each item takes the unit type of the unit it's used with.
+func publicRecipeBundle(recipe *model.Recipe) *model.RecipeBundle {
+	bundle := &model.RecipeBundle{
+		Version:          constant.RECIPE_BUNDLE_VERSION,
+		Units:            []model.Unit{},
+		ItemCategories:   []model.ItemCategory{},
+		Items:            []model.Item{},
+		RecipeCategories: []model.RecipeCategory{},
+	}
+
+	copied := model.Recipe{
+		Name:               recipe.Name,
+		Description:        recipe.Description,
+		ImageURL:           recipe.ImageURL,
+		OriginalLink:       recipe.OriginalLink,
+		PreparationTimeMin: recipe.PreparationTimeMin,
+		CookingTimeMin:     recipe.CookingTimeMin,
+		Servings:           recipe.Servings,
+		Instructions:       recipe.Instructions,
+		Categories:         []model.RecipeCategory{},
+		Ingredients:        make([]model.Ingredient, 0, len(recipe.Ingredients)),
+	}
+
+	seenUnits := make(map[int64]bool)
+	seenItems := make(map[int64]bool)
+	for _, ing := range recipe.Ingredients {
+		copied.Ingredients = append(copied.Ingredients, model.Ingredient{
+			Quantity: ing.Quantity,
+			Item:     model.Item{Name: ing.Item.Name},
+			Unit:     model.Unit{Name: ing.Unit.Name},
+		})
+
+		if !seenUnits[ing.Unit.ID] {
+			seenUnits[ing.Unit.ID] = true
+			bundle.Units = append(bundle.Units, model.Unit{Name: ing.Unit.Name, Factor: ing.Unit.Factor, UnitType: ing.Unit.UnitType})
+		}
+		if !seenItems[ing.Item.ID] {
+			seenItems[ing.Item.ID] = true
+			bundle.Items = append(bundle.Items, model.Item{Name: ing.Item.Name, UnitType: ing.Unit.UnitType})
+		}
+	}
+
+	bundle.Recipes = []model.Recipe{copied}
+	return bundle
+}
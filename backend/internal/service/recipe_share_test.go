@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+// setUpRecipeShareServiceData builds a RecipeShareService whose repositories contain a public recipe of the first group,
+// and a private recipe of the second one.
+func setUpRecipeShareServiceData() (*RecipeShareService, *MockRecipeBundleRepository) {
+	recipeBundleService, repo := setUpRecipeBundleServiceData()
+	recipeRepo := recipeBundleService.recipeRepo.(*MockRecipeRepository)
+	recipeRepo.recipes[0].Public = true
+	recipeRepo.recipes[0].Comment = new("Family favorite!")
+
+	return NewRecipeShareService(recipeRepo, recipeBundleService), repo
+}
+
+func TestNewRecipeShareService(t *testing.T) {
+	recipeRepo := &MockRecipeRepository{}
+	recipeBundleService := &RecipeBundleService{}
+
+	service := NewRecipeShareService(recipeRepo, recipeBundleService)
+
+	if service == nil {
+		t.Fatal("NewRecipeShareService() returned nil")
+	}
+	if service.recipeRepo != recipeRepo || service.recipeBundleService != recipeBundleService {
+		t.Error("NewRecipeShareService() dependencies do not match the provided ones")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetPublicRecipeByID(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int64
+		expectedErr error
+	}{
+		{"public recipe", 1, nil},
+		{"private recipe", 2, customErrors.NewNotFoundError("recipes", "id", nil)},
+		{"missing recipe", 99, customErrors.NewNotFoundError("recipes", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := setUpRecipeShareServiceData()
+
+			actual, err := service.GetPublicByID(tt.id)
+			if !utils.CompareErrors(err, tt.expectedErr) {
+				t.Fatalf("GetPublicByID() error = %v, expected %v", err, tt.expectedErr)
+			}
+			if err == nil && actual.ID != tt.id {
+				t.Errorf("GetPublicByID() = %+v, expected recipe %d", actual, tt.id)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCopyRecipe(t *testing.T) {
+	service, repo := setUpRecipeShareServiceData()
+	repo.ids = []int64{2}
+
+	actual, err := service.Copy(context.Background(), 1, group2.ID)
+	if err != nil {
+		t.Fatalf("Copy() unexpected error = %v", err)
+	}
+
+	if actual.ID != 2 {
+		t.Errorf("Copy() = %+v, expected the created recipe", actual)
+	}
+	if repo.groupID != group2.ID {
+		t.Errorf("Copy() group = %d, expected %d", repo.groupID, group2.ID)
+	}
+
+	bundle := repo.imported
+	copied := bundle.Recipes[0]
+	if copied.Name != "Pancakes" || copied.Public || copied.Comment != nil || len(copied.Categories) != 0 || len(bundle.RecipeCategories) != 0 {
+		t.Errorf("Copy() imported recipe = %+v, expected a private copy without comment nor categories", copied)
+	}
+	if len(copied.Ingredients) != 1 || copied.Ingredients[0].Item.Name != "Flour" || len(bundle.Items) != 1 {
+		t.Errorf("Copy() imported ingredients = %+v, expected the flour", copied.Ingredients)
+	}
+	if len(bundle.ItemCategories) != 0 || bundle.Items[0].Description != nil || bundle.Items[0].AverageMarketPrice != nil ||
+		bundle.Items[0].ItemCategory.Name != "" || bundle.Items[0].UnitType != enum.Weight {
+		t.Errorf("Copy() imported items = %+v, expected the flour without its description, price nor category", bundle.Items)
+	}
+}
+
+func TestCopyPrivateRecipe(t *testing.T) {
+	service, repo := setUpRecipeShareServiceData()
+
+	_, err := service.Copy(context.Background(), 2, group1.ID)
+	if !utils.CompareErrors(err, customErrors.NewNotFoundError("recipes", "id", nil)) {
+		t.Errorf("Copy() error = %v, expected not found error", err)
+	}
+	if repo.imported != nil {
+		t.Error("Copy() shouldn't import a private recipe")
+	}
+}
@@ -1,17 +1,28 @@
 package service
 
 import (
+	"context"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
 	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
 )
 
 type MockRecipeRepository struct {
 	recipes      []model.Recipe
 	getByItemErr error
+	createErr    error
+	updateErr    error
+	deleteErr    error
+	created      *model.Recipe
+	updated      *model.Recipe
+	deletedID    int64
 }
 
 func NewMockRecipeRepository() *MockRecipeRepository {
@@ -20,14 +31,39 @@ func NewMockRecipeRepository() *MockRecipeRepository {
 	}
 }
 
-func (m *MockRecipeRepository) GetByID(_ int64) (model.Recipe, error) {
-	return model.Recipe{}, nil
+func (m *MockRecipeRepository) GetByID(id int64) (*model.Recipe, error) {
+	for i := range m.recipes {
+		if m.recipes[i].ID == id {
+			return &m.recipes[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("recipes", "id", nil)
 }
 
-func (m *MockRecipeRepository) GetByGroupID(_ int64) ([]model.Recipe, error) {
+func (m *MockRecipeRepository) GetByName(_ string, _ bool) ([]model.Recipe, error) {
 	return nil, nil
 }
 
+func (m *MockRecipeRepository) SearchByNameAndGroupID(name string, groupID int64, _ bool) ([]model.Recipe, error) {
+	recipes := []model.Recipe{}
+	for _, recipe := range m.recipes {
+		if recipe.GroupID == groupID && strings.Contains(strings.ToLower(recipe.Name), strings.ToLower(name)) {
+			recipes = append(recipes, recipe)
+		}
+	}
+	return recipes, nil
+}
+
+func (m *MockRecipeRepository) GetByGroupID(groupID int64, _ bool) ([]model.Recipe, error) {
+	recipes := []model.Recipe{}
+	for _, recipe := range m.recipes {
+		if recipe.GroupID == groupID {
+			recipes = append(recipes, recipe)
+		}
+	}
+	return recipes, nil
+}
+
 func (m *MockRecipeRepository) GetByItemID(itemID int64, _ bool) ([]model.Recipe, error) {
 	if m.getByItemErr != nil {
 		return nil, m.getByItemErr
@@ -36,22 +72,110 @@ func (m *MockRecipeRepository) GetByItemID(itemID int64, _ bool) ([]model.Recipe
 	return m.recipes, nil
 }
 
-func (m *MockRecipeRepository) Create(_ *model.Recipe) (int64, error) {
+func (m *MockRecipeRepository) GetByGroupIDAndItemIDs(groupID int64, itemIDs []int64) ([]model.Recipe, error) {
+	recipes := []model.Recipe{}
+	for _, recipe := range m.recipes {
+		if recipe.GroupID != groupID {
+			continue
+		}
+		for _, ingredient := range recipe.Ingredients {
+			if slices.Contains(itemIDs, ingredient.Item.ID) {
+				recipes = append(recipes, recipe)
+				break
+			}
+		}
+	}
+	return recipes, nil
+}
+
+func (m *MockRecipeRepository) GetByCategoryID(categoryID int64, _ bool) ([]model.Recipe, error) {
+	recipes := []model.Recipe{}
+	for _, recipe := range m.recipes {
+		for _, category := range recipe.Categories {
+			if category.ID == categoryID {
+				recipes = append(recipes, recipe)
+				break
+			}
+		}
+	}
+	return recipes, nil
+}
+
+func (m *MockRecipeRepository) GetRecipeGroupID(_ int64) (int64, error) {
 	return 0, nil
 }
 
-func (m *MockRecipeRepository) Update(_ *model.Recipe) error {
+func (m *MockRecipeRepository) Create(_ context.Context, recipe *model.Recipe) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	m.created = recipe
+	return 42, nil
+}
+
+func (m *MockRecipeRepository) Update(_ context.Context, recipe *model.Recipe) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+
+	m.updated = recipe
 	return nil
 }
 
-func (m *MockRecipeRepository) Delete(_ int64) error {
+func (m *MockRecipeRepository) Delete(_ context.Context, id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	m.deletedID = id
 	return nil
 }
 
+var (
+	unitGram  = model.Unit{ID: 2, Name: "Gram", Factor: 1, UnitType: enum.Weight}
+	unitPiece = model.Unit{ID: 8, Name: "Piece", Factor: 1, UnitType: enum.Piece}
+	// unitHandful is a custom unit of the second group.
+	unitHandful = model.Unit{ID: 23, Name: "Handful", Factor: 30, UnitType: enum.Weight, GroupID: new(group2.ID)}
+
+	recipeCategoryDessert = model.RecipeCategory{ID: 1, Name: "DESSERT", GroupID: group1.ID}
+	recipeCategoryVegan   = model.RecipeCategory{ID: 7, Name: "VEGAN", GroupID: group2.ID}
+
+	recipeCreatedAt = time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// setUpRecipeServiceData builds a RecipeService whose repositories contain a recipe of each test group.
+func setUpRecipeServiceData() (*RecipeService, *MockRecipeRepository, *MockRecipeCategoryRepository) {
+	recipeRepo := &MockRecipeRepository{
+		recipes: []model.Recipe{
+			{
+				ID:         1,
+				Name:       "Pancakes",
+				CreatedAt:  recipeCreatedAt,
+				GroupID:    group1.ID,
+				Categories: []model.RecipeCategory{recipeCategoryDessert},
+				Ingredients: []model.Ingredient{
+					{ID: 1, Quantity: new(250.0), Item: items[0], Unit: unitGram},
+				},
+			},
+			{ID: 2, Name: "Lemonade", CreatedAt: recipeCreatedAt, GroupID: group2.ID},
+		},
+	}
+	categoryRepo := &MockRecipeCategoryRepository{
+		categories: []model.RecipeCategory{recipeCategoryDessert, recipeCategoryVegan},
+	}
+	unitRepo := &MockUnitRepository{units: []model.Unit{unitGram, unitPiece, unitHandful}}
+
+	return NewRecipeService(recipeRepo, setUpDataTestItem(), unitRepo, categoryRepo), recipeRepo, categoryRepo
+}
+
 func TestNewRecipeService(t *testing.T) {
 	mockRepo := &MockRecipeRepository{}
+	itemRepo := NewMockItemRepository()
+	unitRepo := &MockUnitRepository{}
+	categoryRepo := &MockRecipeCategoryRepository{}
 
-	service := NewRecipeService(mockRepo)
+	service := NewRecipeService(mockRepo, itemRepo, unitRepo, categoryRepo)
 
 	if service == nil {
 		t.Fatal("NewRecipeService() returned nil")
@@ -60,6 +184,74 @@ func TestNewRecipeService(t *testing.T) {
 	if service.repo == nil {
 		t.Error("NewRecipeService() repo is nil")
 	}
+
+	if service.itemRepo != itemRepo || service.unitRepo != unitRepo || service.recipeCategoryRepo != categoryRepo {
+		t.Error("NewRecipeService() repositories do not match the provided ones")
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+func TestGetRecipeByID(t *testing.T) {
+	service, _, _ := setUpRecipeServiceData()
+
+	actual, err := service.GetByID(1)
+	if err != nil {
+		t.Fatalf("GetByID() unexpected error = %v", err)
+	}
+	if actual.Name != "Pancakes" {
+		t.Errorf("GetByID() expected Pancakes, got %s", actual.Name)
+	}
+
+	_, err = service.GetByID(-1)
+	if !utils.CompareErrors(err, customErrors.NewNotFoundError("recipes", "id", nil)) {
+		t.Errorf("GetByID() expected not found error, got %v", err)
+	}
+}
+
+func TestGetRecipesByGroupID(t *testing.T) {
+	service, _, _ := setUpRecipeServiceData()
+
+	actual, err := service.GetByGroupID(group2.ID, false)
+	if err != nil {
+		t.Fatalf("GetByGroupID() unexpected error = %v", err)
+	}
+	if len(actual) != 1 || actual[0].ID != 2 {
+		t.Errorf("GetByGroupID() expected recipe 2 only, got %v", actual)
+	}
+}
+
+func TestSearchRecipesByNameAndGroupID(t *testing.T) {
+	service, _, _ := setUpRecipeServiceData()
+
+	tests := []struct {
+		name        string
+		search      string
+		groupID     int64
+		expectedIDs []int64
+	}{
+		{"Recipe of the group", "cake", group1.ID, []int64{1}},
+		{"Recipe of another group", "lemon", group1.ID, []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := service.SearchByNameAndGroupID(tt.search, tt.groupID, false)
+			if err != nil {
+				t.Fatalf("SearchByNameAndGroupID() unexpected error = %v", err)
+			}
+
+			if len(actual) != len(tt.expectedIDs) {
+				t.Fatalf("SearchByNameAndGroupID() returned %d recipes, expected %d", len(actual), len(tt.expectedIDs))
+			}
+
+			for i := range actual {
+				if actual[i].ID != tt.expectedIDs[i] {
+					t.Errorf("SearchByNameAndGroupID() expected recipe %d, got %d", tt.expectedIDs[i], actual[i].ID)
+				}
+			}
+		})
+	}
 }
 
 func TestGetByItemID(t *testing.T) {
@@ -107,7 +299,7 @@ func TestGetByItemID(t *testing.T) {
 				getByItemErr: tt.err,
 			}
 
-			service := NewRecipeService(mockRepo)
+			service := NewRecipeService(mockRepo, NewMockItemRepository(), &MockUnitRepository{}, &MockRecipeCategoryRepository{})
 			actual, err := service.GetByItemID(tt.itemID, tt.descending)
 
 			if tt.err != nil {
@@ -134,3 +326,514 @@ func TestGetByItemID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRecipesByAvailableItems(t *testing.T) {
+	riceSalad := model.Recipe{ID: 1, Name: "Rice Salad", GroupID: group1.ID, Ingredients: []model.Ingredient{
+		{ID: 1, Item: items[1]}, {ID: 2, Item: items[3]}, {ID: 3, Item: items[4]},
+	}}
+	pancakes := model.Recipe{ID: 2, Name: "Pancakes", GroupID: group1.ID, Ingredients: []model.Ingredient{
+		{ID: 4, Item: items[0]},
+	}}
+	friedRice := model.Recipe{ID: 3, Name: "Fried Rice", GroupID: group1.ID, Ingredients: []model.Ingredient{
+		{ID: 5, Item: items[1]}, {ID: 6, Item: items[4]},
+	}}
+	lemonade := model.Recipe{ID: 4, Name: "Lemonade", GroupID: group2.ID, Ingredients: []model.Ingredient{
+		{ID: 7, Item: items[2]},
+	}}
+
+	tests := []struct {
+		name               string
+		itemIDs            []int64
+		maxMissing         *int
+		expectedIDs        []int64
+		expectedCoverages  []float64
+		expectedMissingIDs [][]int64
+		err                error
+	}{
+		{
+			name:               "Ranked by coverage",
+			itemIDs:            []int64{items[0].ID, items[1].ID},
+			expectedIDs:        []int64{2, 3, 1},
+			expectedCoverages:  []float64{1, 0.5, 1.0 / 3},
+			expectedMissingIDs: [][]int64{{}, {6}, {2, 3}},
+		},
+		{
+			name:               "Whole recipe first",
+			itemIDs:            []int64{items[1].ID, items[4].ID},
+			expectedIDs:        []int64{3, 1},
+			expectedCoverages:  []float64{1, 2.0 / 3},
+			expectedMissingIDs: [][]int64{{}, {2}},
+		},
+		{
+			name:               "Too many missing ingredients",
+			itemIDs:            []int64{items[0].ID, items[1].ID},
+			maxMissing:         new(1),
+			expectedIDs:        []int64{2, 3},
+			expectedCoverages:  []float64{1, 0.5},
+			expectedMissingIDs: [][]int64{{}, {6}},
+		},
+		{
+			name:               "Nothing missing",
+			itemIDs:            []int64{items[1].ID, items[4].ID},
+			maxMissing:         new(0),
+			expectedIDs:        []int64{3},
+			expectedCoverages:  []float64{1},
+			expectedMissingIDs: [][]int64{{}},
+		},
+		{
+			name:               "Items of another group",
+			itemIDs:            []int64{items[2].ID},
+			expectedIDs:        []int64{},
+			expectedCoverages:  []float64{},
+			expectedMissingIDs: [][]int64{},
+		},
+		{
+			name:    "No item",
+			itemIDs: []int64{},
+			err:     customErrors.NewInvalidParamsError([]string{"item_ids"}, nil),
+		},
+		{
+			name:       "Negative max missing",
+			itemIDs:    []int64{items[0].ID},
+			maxMissing: new(-1),
+			err:        customErrors.NewInvalidParamsError([]string{"max_missing"}, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, recipeRepo, _ := setUpRecipeServiceData()
+			recipeRepo.recipes = []model.Recipe{riceSalad, pancakes, friedRice, lemonade}
+
+			actual, err := service.GetByAvailableItems(group1.ID, tt.itemIDs, tt.maxMissing)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("GetByAvailableItems() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GetByAvailableItems() unexpected error = %v", err)
+			}
+
+			ids := []int64{}
+			coverages := []float64{}
+			missingIDs := [][]int64{}
+			for _, match := range actual {
+				ids = append(ids, match.Recipe.ID)
+				coverages = append(coverages, match.Coverage)
+				missing := []int64{}
+				for _, ingredient := range match.MissingIngredients {
+					missing = append(missing, ingredient.ID)
+				}
+				missingIDs = append(missingIDs, missing)
+			}
+
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("GetByAvailableItems() returned recipes %v, want %v", ids, tt.expectedIDs)
+			}
+			if !reflect.DeepEqual(coverages, tt.expectedCoverages) {
+				t.Errorf("GetByAvailableItems() returned coverages %v, want %v", coverages, tt.expectedCoverages)
+			}
+			if !reflect.DeepEqual(missingIDs, tt.expectedMissingIDs) {
+				t.Errorf("GetByAvailableItems() returned missing ingredients %v, want %v", missingIDs, tt.expectedMissingIDs)
+			}
+		})
+	}
+}
+
+func TestScaleRecipe(t *testing.T) {
+	tests := []struct {
+		name       string
+		servings   *int
+		target     int
+		quantities []*float64
+		err        error
+	}{
+		{"Doubled servings", new(4), 8, []*float64{new(500.0), nil}, nil},
+		{"Halved servings", new(4), 2, []*float64{new(125.0), nil}, nil},
+		{"Invalid servings", new(4), 0, nil, customErrors.NewInvalidParamsError([]string{"servings"}, nil)},
+		{"Unknown servings", nil, 2, nil, customErrors.NewValidationError("servings", customErrors.RECIPE_SCALE_ERROR, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _ := setUpRecipeServiceData()
+			recipe := model.Recipe{
+				Servings: tt.servings,
+				Ingredients: []model.Ingredient{
+					{Quantity: new(250.0), Item: items[0], Unit: unitGram},
+					{Item: items[3], Unit: unitPiece},
+				},
+			}
+
+			err := service.Scale(&recipe, tt.target)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Scale() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Scale() unexpected error = %v", err)
+			}
+
+			if *recipe.Servings != tt.target {
+				t.Errorf("Scale() expected %d servings, got %d", tt.target, *recipe.Servings)
+			}
+			for i, ingredient := range recipe.Ingredients {
+				if !reflect.DeepEqual(ingredient.Quantity, tt.quantities[i]) {
+					t.Errorf("Scale() expected quantity %v for ingredient %d, got %v", tt.quantities[i], i, ingredient.Quantity)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeRecipeUnits(t *testing.T) {
+	service, _, _ := setUpRecipeServiceData()
+	service.unitRepo = &MockUnitRepository{units: []model.Unit{unitKilogram, unitGram, unitPiece, unitHandful}}
+
+	recipe := model.Recipe{
+		GroupID: group1.ID,
+		Ingredients: []model.Ingredient{
+			{Quantity: new(1500.0), Item: items[0], Unit: unitGram},
+			{Quantity: new(0.2), Item: items[1], Unit: unitKilogram},
+			{Quantity: new(3.0), Item: items[3], Unit: unitPiece},
+			{Item: items[3], Unit: unitGram},
+		},
+	}
+
+	if err := service.NormalizeUnits(&recipe); err != nil {
+		t.Fatalf("NormalizeUnits() unexpected error = %v", err)
+	}
+
+	expected := []model.Ingredient{
+		{Quantity: new(1.5), Item: items[0], Unit: unitKilogram},
+		{Quantity: new(200.0), Item: items[1], Unit: unitGram},
+		{Quantity: new(3.0), Item: items[3], Unit: unitPiece},
+		{Item: items[3], Unit: unitGram},
+	}
+	if !reflect.DeepEqual(recipe.Ingredients, expected) {
+		t.Errorf("NormalizeUnits() = %v, want %v", recipe.Ingredients, expected)
+	}
+}
+
+func TestEstimateRecipeCost(t *testing.T) {
+	unitMilliliter := model.Unit{ID: 4, Name: "Milliliter", Factor: 1, UnitType: enum.Volume}
+
+	tests := []struct {
+		name        string
+		ingredients []model.Ingredient
+		expected    *model.CostEstimate
+		err         error
+	}{
+		{
+			name: "Priced and unpriced ingredients",
+			ingredients: []model.Ingredient{
+				{ID: 1, Quantity: new(500.0), Item: items[0], Unit: unitGram},
+				{ID: 2, Quantity: new(2.0), Item: items[3], Unit: unitPiece},
+				{ID: 3, Quantity: new(100.0), Item: items[4], Unit: unitMilliliter},
+				{ID: 4, Item: items[1], Unit: unitGram},
+			},
+			// 0.5 kg of flour at 2.50 per kilogram; the pepper is counted in pieces but priced by weight
+			// and the olive oil has no price, while the rice to taste costs nothing.
+			expected: &model.CostEstimate{
+				Cost:     1.25,
+				Servings: new(4),
+				UnpricedIngredients: []model.Ingredient{
+					{ID: 2, Quantity: new(2.0), Item: items[3], Unit: unitPiece},
+					{ID: 3, Quantity: new(100.0), Item: items[4], Unit: unitMilliliter},
+				},
+			},
+		},
+		{
+			name:        "Unknown item",
+			ingredients: []model.Ingredient{{ID: 1, Quantity: new(1.0), Item: model.Item{ID: invalidItemID}, Unit: unitGram}},
+			err:         customErrors.NewNotFoundError("items", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _ := setUpRecipeServiceData()
+
+			actual, err := service.EstimateCost(&model.Recipe{Servings: new(4), Ingredients: tt.ingredients})
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("EstimateCost() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("EstimateCost() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("EstimateCost() = %+v, want %+v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+/*** CREATE OPERATIONS ***/
+
+func TestCreateRecipe(t *testing.T) {
+	tests := []struct {
+		name        string
+		recipe      model.Recipe
+		categoryErr error
+		createErr   error
+		err         error
+	}{
+		{
+			name: "Valid recipe",
+			recipe: model.Recipe{
+				Name:       "  Crepes  ",
+				Servings:   new(4),
+				GroupID:    group1.ID,
+				Categories: []model.RecipeCategory{{ID: recipeCategoryDessert.ID}},
+				Ingredients: []model.Ingredient{
+					{ID: 99, Quantity: new(300.0), Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitGram.ID}},
+					{Item: model.Item{ID: items[3].ID}, Unit: model.Unit{ID: unitPiece.ID}},
+				},
+			},
+		},
+		{
+			name:   "Recipe without category nor ingredient",
+			recipe: model.Recipe{Name: "Water", GroupID: group1.ID},
+		},
+		{
+			name: "Invalid fields",
+			recipe: model.Recipe{
+				Name:           " ",
+				CookingTimeMin: new(-5),
+				Servings:       new(0),
+				GroupID:        group1.ID,
+			},
+			err: customErrors.NewInvalidParamsError([]string{"name", "cooking_time_min", "servings"}, nil),
+		},
+		{
+			name: "Negative quantity",
+			recipe: model.Recipe{
+				Name:        "Crepes",
+				GroupID:     group1.ID,
+				Ingredients: []model.Ingredient{{Quantity: new(-1.0), Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitGram.ID}}},
+			},
+			err: customErrors.NewInvalidParamsError([]string{"quantity"}, nil),
+		},
+		{
+			name:   "Unknown category",
+			recipe: model.Recipe{Name: "Crepes", GroupID: group1.ID, Categories: []model.RecipeCategory{{ID: -1}}},
+			err:    customErrors.NewConflictError("RecipeCategory", "recipe category must exists", nil),
+		},
+		{
+			name:   "Category of another group",
+			recipe: model.Recipe{Name: "Crepes", GroupID: group1.ID, Categories: []model.RecipeCategory{{ID: recipeCategoryVegan.ID}}},
+			err:    customErrors.NewConflictError("RecipeCategory", "recipe category must belongs to the same group as the recipe", nil),
+		},
+		{
+			name:        "Category repository error",
+			recipe:      model.Recipe{Name: "Crepes", GroupID: group1.ID, Categories: []model.RecipeCategory{{ID: recipeCategoryDessert.ID}}},
+			categoryErr: customErrors.NewInternalError("failed to fetch recipe categories", nil),
+			err:         customErrors.NewInternalError("failed to fetch recipe categories", nil),
+		},
+		{
+			name: "Unknown item",
+			recipe: model.Recipe{
+				Name:        "Crepes",
+				GroupID:     group1.ID,
+				Ingredients: []model.Ingredient{{Item: model.Item{ID: invalidItemID}, Unit: model.Unit{ID: unitGram.ID}}},
+			},
+			err: customErrors.NewConflictError("Item", "item must exists", nil),
+		},
+		{
+			name: "Item of another group",
+			recipe: model.Recipe{
+				Name:        "Crepes",
+				GroupID:     group1.ID,
+				Ingredients: []model.Ingredient{{Item: model.Item{ID: items[2].ID}, Unit: model.Unit{ID: unitGram.ID}}},
+			},
+			err: customErrors.NewConflictError("Item", "item must belongs to the same group as the recipe", nil),
+		},
+		{
+			name: "Unknown unit",
+			recipe: model.Recipe{
+				Name:        "Crepes",
+				GroupID:     group1.ID,
+				Ingredients: []model.Ingredient{{Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: -1}}},
+			},
+			err: customErrors.NewConflictError("Unit", "unit must exists", nil),
+		},
+		{
+			name: "Custom unit of another group",
+			recipe: model.Recipe{
+				Name:        "Crepes",
+				GroupID:     group1.ID,
+				Ingredients: []model.Ingredient{{Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitHandful.ID}}},
+			},
+			err: customErrors.NewConflictError("Unit", "unit must belongs to the catalogue or to the same group as the recipe", nil),
+		},
+		{
+			name:      "Repository error",
+			recipe:    model.Recipe{Name: "Crepes", GroupID: group1.ID},
+			createErr: customErrors.NewInternalError("Failed to create recipe", nil),
+			err:       customErrors.NewInternalError("Failed to create recipe", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, recipeRepo, categoryRepo := setUpRecipeServiceData()
+			recipeRepo.createErr = tt.createErr
+			categoryRepo.err = tt.categoryErr
+
+			id, err := service.Create(context.Background(), &tt.recipe)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Create() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+
+			if id != 42 {
+				t.Errorf("Create() expected id 42, got %d", id)
+			}
+
+			created := recipeRepo.created
+			if created.Name != strings.TrimSpace(created.Name) || created.CreatedAt.IsZero() {
+				t.Errorf("Create() expected trimmed name and creation date, got %q and %v", created.Name, created.CreatedAt)
+			}
+
+			for _, ing := range created.Ingredients {
+				if ing.ID != 0 {
+					t.Errorf("Create() expected new ingredients, got ingredient %d", ing.ID)
+				}
+				if ing.Item.Name == "" || ing.Unit.Name == "" {
+					t.Errorf("Create() expected resolved item and unit, got %+v", ing)
+				}
+			}
+		})
+	}
+}
+
+/*** UPDATE OPERATIONS ***/
+
+func TestUpdateRecipe(t *testing.T) {
+	tests := []struct {
+		name      string
+		recipe    model.Recipe
+		updateErr error
+		err       error
+	}{
+		{
+			name: "Valid update",
+			recipe: model.Recipe{
+				ID:      1,
+				Name:    "Pancakes with sugar",
+				GroupID: group2.ID,
+				Ingredients: []model.Ingredient{
+					{ID: 1, Quantity: new(200.0), Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitGram.ID}},
+					{Quantity: new(1.0), Item: model.Item{ID: items[1].ID}, Unit: model.Unit{ID: unitPiece.ID}},
+				},
+			},
+		},
+		{
+			name:   "Unknown recipe",
+			recipe: model.Recipe{ID: -1, Name: "Pancakes"},
+			err:    customErrors.NewNotFoundError("recipes", "id", nil),
+		},
+		{
+			name: "Ingredient of another recipe",
+			recipe: model.Recipe{
+				ID:          1,
+				Name:        "Pancakes",
+				Ingredients: []model.Ingredient{{ID: 8, Item: model.Item{ID: items[0].ID}, Unit: model.Unit{ID: unitGram.ID}}},
+			},
+			err: customErrors.NewConflictError("Ingredient", "ingredient must belongs to the recipe", nil),
+		},
+		{
+			name:   "Category of another group",
+			recipe: model.Recipe{ID: 1, Name: "Pancakes", Categories: []model.RecipeCategory{{ID: recipeCategoryVegan.ID}}},
+			err:    customErrors.NewConflictError("RecipeCategory", "recipe category must belongs to the same group as the recipe", nil),
+		},
+		{
+			name:      "Repository error",
+			recipe:    model.Recipe{ID: 1, Name: "Pancakes"},
+			updateErr: customErrors.NewInternalError("Failed to update recipe", nil),
+			err:       customErrors.NewInternalError("Failed to update recipe", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, recipeRepo, _ := setUpRecipeServiceData()
+			recipeRepo.updateErr = tt.updateErr
+
+			err := service.Update(context.Background(), &tt.recipe)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Update() unexpected error = %v", err)
+			}
+
+			// The group and the creation date are kept.
+			if recipeRepo.updated.GroupID != group1.ID || !recipeRepo.updated.CreatedAt.Equal(recipeCreatedAt) {
+				t.Errorf("Update() expected group %d and creation date %v, got %d and %v",
+					group1.ID, recipeCreatedAt, recipeRepo.updated.GroupID, recipeRepo.updated.CreatedAt)
+			}
+		})
+	}
+}
+
+/*** DELETE OPERATIONS ***/
+
+func TestDeleteRecipe(t *testing.T) {
+	tests := []struct {
+		name      string
+		deleteErr error
+	}{
+		{"Existing recipe", nil},
+		{"Repository error", customErrors.NewNotFoundError("recipes", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, recipeRepo, _ := setUpRecipeServiceData()
+			recipeRepo.deleteErr = tt.deleteErr
+
+			err := service.Delete(context.Background(), 1)
+
+			if tt.deleteErr != nil {
+				if !utils.CompareErrors(err, tt.deleteErr) {
+					t.Fatalf("Delete() error = %v, want %v", err, tt.deleteErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Delete() unexpected error = %v", err)
+			}
+
+			if recipeRepo.deletedID != 1 {
+				t.Errorf("Delete() expected recipe 1 to be deleted, got %d", recipeRepo.deletedID)
+			}
+		})
+	}
+}
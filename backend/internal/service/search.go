@@ -0,0 +1,67 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/zouipo/yumsday/backend/internal/constant"
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type SearchServiceInterface interface {
+	Search(groupID int64, text string, limit int) ([]model.SearchHit, error)
+}
+
+type SearchService struct {
+	repo repository.SearchRepositoryInterface
+}
+
+// NewSearchService creates a new SearchService using the provided repository.
+func NewSearchService(repo repository.SearchRepositoryInterface) *SearchService {
+	return &SearchService{
+		repo: repo,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// Search returns at most limit recipes and items of a group matching every word of text, from the most to the least relevant.
+// Words match as prefixes, accents and case being ignored, so that "tomat" matches "Tomatoes".
+// The limit must be between 1 and constant.MAX_SEARCH_LIMIT.
+func (s *SearchService) Search(groupID int64, text string, limit int) ([]model.SearchHit, error) {
+	e := customErrors.NewInvalidParamsError([]string{}, nil).(*customErrors.InvalidParamsError)
+
+	match := searchMatch(text)
+	if match == "" {
+		e.AddInvalidField("q")
+	}
+	if limit < 1 || limit > constant.MAX_SEARCH_LIMIT {
+		e.AddInvalidField("limit")
+	}
+
+	if len(e.Fields) > 0 {
+		return nil, e
+	}
+
+	return s.repo.Search(groupID, match, limit)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// searchMatch builds an FTS5 query matching every word of text as a prefix.
+// Words are quoted so that the FTS5 syntax (operators, columns filters...) can't be injected by users.
+// It returns an empty string when text contains no word.
+func searchMatch(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	terms := make([]string, len(words))
+	for i, word := range words {
+		terms[i] = `"` + word + `"*`
+	}
+
+	return strings.Join(terms, " ")
+}
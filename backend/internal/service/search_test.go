@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockSearchRepository struct {
+	hits      []model.SearchHit
+	lastMatch string
+	lastLimit int
+}
+
+func (m *MockSearchRepository) Search(groupID int64, match string, limit int) ([]model.SearchHit, error) {
+	m.lastMatch = match
+	m.lastLimit = limit
+	return m.hits, nil
+}
+
+func TestNewSearchService(t *testing.T) {
+	repo := &MockSearchRepository{}
+
+	service := NewSearchService(repo)
+
+	if service == nil {
+		t.Fatal("NewSearchService() returned nil")
+	}
+	if service.repo != repo {
+		t.Error("NewSearchService() repository does not match the provided one")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name          string
+		text          string
+		limit         int
+		expectedMatch string
+		err           error
+	}{
+		{"Single word", "tomato", 20, `"tomato"*`, nil},
+		{"Several words", "  Chocolate chip ", 20, `"Chocolate"* "chip"*`, nil},
+		{"Accented words", "crème brûlée", 20, `"crème"* "brûlée"*`, nil},
+		{"FTS5 syntax is ignored", `name:"soup" OR -salad*`, 100, `"name"* "soup"* "OR"* "salad"*`, nil},
+		{"Empty text", "", 20, "", customErrors.NewInvalidParamsError([]string{"q"}, nil)},
+		{"Text without word", `"*-:`, 20, "", customErrors.NewInvalidParamsError([]string{"q"}, nil)},
+		{"Zero limit", "tomato", 0, "", customErrors.NewInvalidParamsError([]string{"limit"}, nil)},
+		{"Too high limit", "tomato", 101, "", customErrors.NewInvalidParamsError([]string{"limit"}, nil)},
+		{"Invalid text and limit", " ", -1, "", customErrors.NewInvalidParamsError([]string{"q", "limit"}, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockSearchRepository{hits: []model.SearchHit{{Kind: model.SearchHitRecipe, ID: 3, Name: "Tomato Soup"}}}
+			service := NewSearchService(repo)
+
+			actual, err := service.Search(group1.ID, tt.text, tt.limit)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Search() error = %v, want %v", err, tt.err)
+				}
+				if repo.lastMatch != "" {
+					t.Errorf("Search() shouldn't query the repository, got match %q", repo.lastMatch)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Search() unexpected error = %v", err)
+			}
+			if repo.lastMatch != tt.expectedMatch || repo.lastLimit != tt.limit {
+				t.Errorf("Search() queried %q limited to %d, want %q limited to %d", repo.lastMatch, repo.lastLimit, tt.expectedMatch, tt.limit)
+			}
+			if len(actual) != 1 {
+				t.Errorf("Search() returned %d hits, want 1", len(actual))
+			}
+		})
+	}
+}
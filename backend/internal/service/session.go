@@ -8,6 +8,7 @@ import (
 
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
 	"github.com/zouipo/yumsday/backend/internal/repository"
 )
 
@@ -18,19 +19,29 @@ type SessionServiceInterface interface {
 	Expiration() time.Duration
 	Save(session *model.Session) error
 	Remove(session *model.Session) error
+	Rotate(session *model.Session) error
+	Invalidate(userID int64, current *model.Session) error
+	GetByUserID(userID int64) ([]model.Session, error)
+	Revoke(userID int64, id string, current *model.Session) error
+	RevokeOthers(userID int64, current *model.Session) error
 }
 
 type SessionService struct {
-	repo       repository.SessionRepositoryInterface
-	cookieName string
-	expiration time.Duration
+	repo         repository.SessionRepositoryInterface
+	apiTokenRepo repository.APITokenRepositoryInterface
+	cookieName   string
+	expiration   time.Duration
 }
 
-func NewSessionService(repo repository.SessionRepositoryInterface, cookieName string, expiration time.Duration) *SessionService {
+func NewSessionService(repo repository.SessionRepositoryInterface,
+	apiTokenRepo repository.APITokenRepositoryInterface,
+	cookieName string,
+	expiration time.Duration) *SessionService {
 	s := &SessionService{
-		repo:       repo,
-		cookieName: cookieName,
-		expiration: expiration,
+		repo:         repo,
+		apiTokenRepo: apiTokenRepo,
+		cookieName:   cookieName,
+		expiration:   expiration,
 	}
 	go s.cleanUp()
 	return s
@@ -83,9 +94,16 @@ func (s *SessionService) GetSession(r *http.Request) *model.Session {
 	return session
 }
 
+// GetByUserID returns the sessions of a user which haven't expired yet, the most recently active first.
+func (s *SessionService) GetByUserID(userID int64) ([]model.Session, error) {
+	return s.repo.GetByUserID(userID, s.expiration)
+}
+
+// Save records the activity of a stored session. Sessions aren't stored until they are rotated, e.g. on login,
+// and a session removed in the meantime, e.g. revoked while one of its requests was being served, stays removed.
 func (s *SessionService) Save(session *model.Session) error {
 	session.LastActivity = time.Now().UTC()
-	return s.repo.Write(session)
+	return s.repo.Update(session)
 }
 
 func (s *SessionService) Remove(session *model.Session) error {
@@ -93,6 +111,79 @@ func (s *SessionService) Remove(session *model.Session) error {
 	return s.repo.Delete(session.ID)
 }
 
+// Rotate gives a fresh ID to the session and removes the row of its previous ID,
+// so that an ID known before a change of privileges, such as a login, can't be used afterwards.
+// The session is stored under its new ID; SessionInjector sends it in the cookie of the response.
+func (s *SessionService) Rotate(session *model.Session) error {
+	if err := s.repo.Delete(session.ID); err != nil {
+		return err
+	}
+
+	slog.Debug("Rotating session", "id", session.ID)
+	session.ID = utils.GenerateSessionID()
+	session.CreatedAt = time.Now().UTC()
+	session.LastActivity = session.CreatedAt
+	return s.repo.Create(session)
+}
+
+// Invalidate removes every session and every API token of a user, e.g. once their password or role changed.
+// The current session is rotated instead when it belongs to the user, so that they stay logged in; it can be nil.
+func (s *SessionService) Invalidate(userID int64, current *model.Session) error {
+	exceptID := ""
+	if current != nil && current.UserID != nil && *current.UserID == userID {
+		exceptID = current.ID
+	}
+
+	if err := s.repo.DeleteByUserID(userID, exceptID); err != nil {
+		return err
+	}
+	// The API tokens would otherwise keep working with credentials which may have been compromised.
+	if err := s.apiTokenRepo.DeleteByUserID(userID); err != nil {
+		return err
+	}
+	slog.Debug("Invalidated user sessions and API tokens", "user", userID)
+
+	if exceptID != "" {
+		return s.Rotate(current)
+	}
+	return nil
+}
+
+// Revoke logs a user out of one of their sessions, identified by the digest of its ID as exposed to the clients.
+// Revoking the current session, which can be nil, turns it into an anonymous one under a fresh ID, as a logout does.
+func (s *SessionService) Revoke(userID int64, id string, current *model.Session) error {
+	sessions, err := s.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if utils.HashToken(session.ID) != id {
+			continue
+		}
+
+		slog.Debug("Revoking session", "user", userID)
+		if current != nil && current.ID == session.ID {
+			current.UserID = nil
+			return s.Rotate(current)
+		}
+		return s.repo.Delete(session.ID)
+	}
+
+	return customErrors.NewNotFoundError("sessions", id, nil)
+}
+
+// RevokeOthers logs a user out of all their sessions but the current one, which can be nil.
+func (s *SessionService) RevokeOthers(userID int64, current *model.Session) error {
+	exceptID := ""
+	if current != nil {
+		exceptID = current.ID
+	}
+
+	slog.Debug("Revoking other sessions", "user", userID)
+	return s.repo.DeleteByUserID(userID, exceptID)
+}
+
 /*** PRIVATE METHODS ***/
 
 // cleanUp periodically removes expired sessions from the database.
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"sync"
@@ -9,6 +10,7 @@ import (
 
 	customErrors "github.com/zouipo/yumsday/backend/internal/error"
 	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
 )
 
 var (
@@ -49,7 +51,25 @@ func (m *MockSessionRepository) GetByID(id string) (*model.Session, error) {
 	return session, nil
 }
 
-func (m *MockSessionRepository) Write(s *model.Session) error {
+func (m *MockSessionRepository) GetByUserID(userID int64, exp time.Duration) ([]model.Session, error) {
+	if m.getByIDErr != nil {
+		return nil, m.getByIDErr
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	cutoff := time.Now().Add(-exp).UTC()
+	sessions := []model.Session{}
+	for _, session := range m.sessions {
+		if session.UserID != nil && *session.UserID == userID && !session.LastActivity.Before(cutoff) {
+			sessions = append(sessions, *session)
+		}
+	}
+	return sessions, nil
+}
+
+func (m *MockSessionRepository) Create(s *model.Session) error {
 	if m.writeErr != nil {
 		return m.writeErr
 	}
@@ -61,6 +81,20 @@ func (m *MockSessionRepository) Write(s *model.Session) error {
 	return nil
 }
 
+func (m *MockSessionRepository) Update(s *model.Session) error {
+	if m.writeErr != nil {
+		return m.writeErr
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.sessions[s.ID]; exists {
+		m.sessions[s.ID] = s
+	}
+	return nil
+}
+
 func (m *MockSessionRepository) Delete(id string) error {
 	if m.deleteErr != nil {
 		return m.deleteErr
@@ -73,6 +107,22 @@ func (m *MockSessionRepository) Delete(id string) error {
 	return nil
 }
 
+func (m *MockSessionRepository) DeleteByUserID(userID int64, exceptID string) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, session := range m.sessions {
+		if session.UserID != nil && *session.UserID == userID && id != exceptID {
+			delete(m.sessions, id)
+		}
+	}
+	return nil
+}
+
 func (m *MockSessionRepository) CleanUp(exp time.Duration) int64 {
 	if m.cleanUpErr != nil {
 		return 0
@@ -143,7 +193,7 @@ func TestNewSessionService(t *testing.T) {
 	mockRepo := NewMockSessionRepository()
 	expiration := 24 * time.Hour
 
-	service := NewSessionService(mockRepo, cookieName, expiration)
+	service := NewSessionService(mockRepo, &MockAPITokenRepository{}, cookieName, expiration)
 
 	if service == nil {
 		t.Fatal("NewSessionService() returned nil")
@@ -178,7 +228,7 @@ func TestNewSessionService_CleansUpExpiredSessions(t *testing.T) {
 	mockRepo.addSession(validSession1)
 	mockRepo.addSession(validSession2)
 
-	_ = NewSessionService(mockRepo, cookieName, testExpiration)
+	_ = NewSessionService(mockRepo, &MockAPITokenRepository{}, cookieName, testExpiration)
 
 	// Pause to allow the cleanup goroutine to run
 	time.Sleep(100 * time.Millisecond)
@@ -214,7 +264,7 @@ func TestNewSessionService_CleansUpError(t *testing.T) {
 	validSession1 := createTestSession("valid-1", time.Now().UTC().Add(-30*time.Minute))
 	mockRepo.addSession(validSession1)
 
-	_ = NewSessionService(mockRepo, cookieName, testExpiration)
+	_ = NewSessionService(mockRepo, &MockAPITokenRepository{}, cookieName, testExpiration)
 
 	time.Sleep(100 * time.Millisecond)
 
@@ -406,6 +456,7 @@ func TestSave_Success(t *testing.T) {
 
 	oldLastActivity := time.Now().UTC().Add(-10 * time.Minute)
 	session := createTestSession("test-session", oldLastActivity)
+	mockRepo.addSession(session)
 
 	// Wait to ensure time difference in LastActivity
 	time.Sleep(10 * time.Millisecond)
@@ -516,3 +567,260 @@ func TestRemove_Error(t *testing.T) {
 		t.Error("Remove() should not have add or delete sessions")
 	}
 }
+
+func TestRotate_Success(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	sessionID := "test-session-123"
+	session := createTestSession(sessionID, time.Now().UTC())
+	mockRepo.addSession(session)
+
+	service := &SessionService{
+		repo:       mockRepo,
+		cookieName: cookieName,
+		expiration: expiration,
+	}
+
+	if err := service.Rotate(session); err != nil {
+		t.Fatalf("Rotate() error = %v, want nil", err)
+	}
+
+	if session.ID == sessionID {
+		t.Error("Rotate() should give a fresh ID to the session")
+	}
+	if mockRepo.hasSession(sessionID) {
+		t.Error("Rotate() did not delete the session under its old ID")
+	}
+	if !mockRepo.hasSession(session.ID) {
+		t.Error("Rotate() did not save the session under its new ID")
+	}
+	if mockRepo.sessionCount() != 1 {
+		t.Errorf("Rotate() left %d sessions, want 1", mockRepo.sessionCount())
+	}
+}
+
+func TestRotate_Error(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	mockRepo.deleteErr = customErrors.NewInternalError("Failed to delete session", nil)
+
+	sessionID := "test-session-123"
+	session := createTestSession(sessionID, time.Now().UTC())
+	mockRepo.addSession(session)
+
+	service := &SessionService{
+		repo:       mockRepo,
+		cookieName: cookieName,
+		expiration: expiration,
+	}
+
+	if err := service.Rotate(session); err == nil {
+		t.Fatal("Rotate() error = nil, want non-nil")
+	}
+	if session.ID != sessionID {
+		t.Error("Rotate() shouldn't change the session ID on error")
+	}
+}
+
+func TestInvalidate_RotatesCurrentSession(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	current := createTestSession("current", time.Now().UTC())
+	other := createTestSession("other", time.Now().UTC())
+	otherUser := createTestSession("other-user", time.Now().UTC())
+	otherUser.UserID = new(int64(2))
+	mockRepo.addSession(current)
+	mockRepo.addSession(other)
+	mockRepo.addSession(otherUser)
+	apiTokenRepo := setUpDataTestAPIToken()
+
+	service := &SessionService{
+		repo:         mockRepo,
+		apiTokenRepo: apiTokenRepo,
+		cookieName:   cookieName,
+		expiration:   expiration,
+	}
+
+	if err := service.Invalidate(1, current); err != nil {
+		t.Fatalf("Invalidate() error = %v, want nil", err)
+	}
+
+	if mockRepo.hasSession("other") {
+		t.Error("Invalidate() did not delete the other session of the user")
+	}
+	if mockRepo.hasSession("current") {
+		t.Error("Invalidate() did not rotate the current session")
+	}
+	if !mockRepo.hasSession(current.ID) {
+		t.Error("Invalidate() did not save the current session under its new ID")
+	}
+	if !mockRepo.hasSession("other-user") {
+		t.Error("Invalidate() shouldn't delete the sessions of another user")
+	}
+	for _, token := range apiTokenRepo.tokens {
+		if token.UserID == 1 {
+			t.Errorf("Invalidate() did not delete the API token %d of the user", token.ID)
+		}
+	}
+	if len(apiTokenRepo.tokens) == 0 {
+		t.Error("Invalidate() shouldn't delete the API tokens of another user")
+	}
+}
+
+func TestInvalidate_CurrentSessionOfAnotherUser(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	target := createTestSession("target", time.Now().UTC())
+	admin := createTestSession("admin", time.Now().UTC())
+	admin.UserID = new(int64(2))
+	mockRepo.addSession(target)
+	mockRepo.addSession(admin)
+
+	service := &SessionService{
+		repo:         mockRepo,
+		apiTokenRepo: setUpDataTestAPIToken(),
+		cookieName:   cookieName,
+		expiration:   expiration,
+	}
+
+	if err := service.Invalidate(1, admin); err != nil {
+		t.Fatalf("Invalidate() error = %v, want nil", err)
+	}
+
+	if mockRepo.hasSession("target") {
+		t.Error("Invalidate() did not delete the session of the user")
+	}
+	if admin.ID != "admin" || !mockRepo.hasSession("admin") {
+		t.Error("Invalidate() shouldn't touch the current session of another user")
+	}
+}
+
+// TestInvalidate_SaveAfterwards tests that saving a session of the user once invalidated, as done when a request
+// which was being served at the time ends, doesn't log it back in.
+func TestInvalidate_SaveAfterwards(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	stale := createTestSession("stale", time.Now().UTC())
+	mockRepo.addSession(stale)
+
+	service := &SessionService{
+		repo:         mockRepo,
+		apiTokenRepo: setUpDataTestAPIToken(),
+		cookieName:   cookieName,
+		expiration:   expiration,
+	}
+
+	if err := service.Invalidate(1, nil); err != nil {
+		t.Fatalf("Invalidate() error = %v, want nil", err)
+	}
+	if err := service.Save(stale); err != nil {
+		t.Fatalf("Save() error = %v, want nil", err)
+	}
+
+	if mockRepo.hasSession("stale") {
+		t.Error("Save() brought back a session removed by Invalidate()")
+	}
+}
+
+func TestRevoke_OtherSession(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	current := createTestSession("current", time.Now().UTC())
+	other := createTestSession("other", time.Now().UTC())
+	mockRepo.addSession(current)
+	mockRepo.addSession(other)
+
+	service := &SessionService{
+		repo:       mockRepo,
+		cookieName: cookieName,
+		expiration: expiration,
+	}
+
+	if err := service.Revoke(1, utils.HashToken("other"), current); err != nil {
+		t.Fatalf("Revoke() error = %v, want nil", err)
+	}
+
+	if mockRepo.hasSession("other") {
+		t.Error("Revoke() did not delete the session")
+	}
+	if !mockRepo.hasSession("current") || current.ID != "current" {
+		t.Error("Revoke() shouldn't touch the current session")
+	}
+}
+
+func TestRevoke_CurrentSession(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	current := createTestSession("current", time.Now().UTC())
+	mockRepo.addSession(current)
+
+	service := &SessionService{
+		repo:       mockRepo,
+		cookieName: cookieName,
+		expiration: expiration,
+	}
+
+	if err := service.Revoke(1, utils.HashToken("current"), current); err != nil {
+		t.Fatalf("Revoke() error = %v, want nil", err)
+	}
+
+	if mockRepo.hasSession("current") {
+		t.Error("Revoke() did not delete the current session under its old ID")
+	}
+	if current.UserID != nil {
+		t.Error("Revoke() should log the current session out")
+	}
+	if current.ID == "current" {
+		t.Error("Revoke() should give a fresh ID to the current session")
+	}
+}
+
+func TestRevoke_NotFound(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	otherUser := createTestSession("other-user", time.Now().UTC())
+	otherUser.UserID = new(int64(2))
+	expired := createTestSession("expired", time.Now().Add(-2*expiration).UTC())
+	mockRepo.addSession(otherUser)
+	mockRepo.addSession(expired)
+
+	service := &SessionService{
+		repo:       mockRepo,
+		cookieName: cookieName,
+		expiration: expiration,
+	}
+
+	for _, id := range []string{"unknown", utils.HashToken("other-user"), utils.HashToken("expired")} {
+		err := service.Revoke(1, id, nil)
+		if appErr, ok := errors.AsType[customErrors.AppError](err); !ok || appErr.HTTPStatus() != http.StatusNotFound {
+			t.Errorf("Revoke(%q) error = %v, want a NotFoundError", id, err)
+		}
+	}
+
+	if mockRepo.sessionCount() != 2 {
+		t.Error("Revoke() should not have deleted any session")
+	}
+}
+
+func TestRevokeOthers(t *testing.T) {
+	mockRepo := NewMockSessionRepository()
+	current := createTestSession("current", time.Now().UTC())
+	other := createTestSession("other", time.Now().UTC())
+	otherUser := createTestSession("other-user", time.Now().UTC())
+	otherUser.UserID = new(int64(2))
+	mockRepo.addSession(current)
+	mockRepo.addSession(other)
+	mockRepo.addSession(otherUser)
+
+	service := &SessionService{
+		repo:       mockRepo,
+		cookieName: cookieName,
+		expiration: expiration,
+	}
+
+	if err := service.RevokeOthers(1, current); err != nil {
+		t.Fatalf("RevokeOthers() error = %v, want nil", err)
+	}
+
+	if mockRepo.hasSession("other") {
+		t.Error("RevokeOthers() did not delete the other session")
+	}
+	if !mockRepo.hasSession("current") || current.ID != "current" {
+		t.Error("RevokeOthers() shouldn't touch the current session")
+	}
+	if !mockRepo.hasSession("other-user") {
+		t.Error("RevokeOthers() shouldn't delete the sessions of another user")
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/repository"
+)
+
+type UnitServiceInterface interface {
+	GetByID(id int64) (*model.Unit, error)
+	GetByGroupID(groupID int64) ([]model.Unit, error)
+	Create(unit *model.Unit) (int64, error)
+	Update(unit *model.Unit) error
+	Delete(id int64) error
+	Convert(quantity float64, from, to *model.Unit) (float64, error)
+}
+
+type UnitService struct {
+	repo repository.UnitRepositoryInterface
+}
+
+// NewUnitService creates a new UnitService using the provided UnitRepository.
+func NewUnitService(repo repository.UnitRepositoryInterface) *UnitService {
+	return &UnitService{
+		repo: repo,
+	}
+}
+
+/*** READ OPERATIONS ***/
+
+// GetByID returns the unit identified by id, from the catalogue or custom.
+func (s *UnitService) GetByID(id int64) (*model.Unit, error) {
+	return s.repo.GetByID(id)
+}
+
+// GetByGroupID returns the units available to a group: the catalogue and its custom units.
+func (s *UnitService) GetByGroupID(groupID int64) ([]model.Unit, error) {
+	return s.repo.GetByGroupID(groupID)
+}
+
+// Convert returns the quantity expressed in the from unit converted to the to unit.
+// Both units must be of the same unit type.
+func (s *UnitService) Convert(quantity float64, from, to *model.Unit) (float64, error) {
+	return convertQuantity(quantity, from, to)
+}
+
+/*** CREATE OPERATIONS ***/
+
+// Create validates and adds a new custom unit to its group, returning its ID.
+func (s *UnitService) Create(unit *model.Unit) (int64, error) {
+	if unit.GroupID == nil {
+		return 0, customErrors.NewForbiddenError(nil)
+	}
+
+	if err := s.validateUnit(unit); err != nil {
+		return 0, err
+	}
+
+	return s.repo.Create(unit)
+}
+
+/*** UPDATE OPERATIONS ***/
+
+// Update validates and replaces the name, factor and unit type of the custom unit identified by unit.ID.
+// The units of the catalogue can't be updated and the group of a custom unit can't be changed.
+func (s *UnitService) Update(unit *model.Unit) error {
+	currentUnit, err := s.repo.GetByID(unit.ID)
+	if err != nil {
+		return err
+	}
+
+	if currentUnit.GroupID == nil {
+		return customErrors.NewForbiddenError(nil)
+	}
+
+	unit.GroupID = currentUnit.GroupID
+
+	if err := s.validateUnit(unit); err != nil {
+		return err
+	}
+
+	return s.repo.Update(unit)
+}
+
+/*** DELETE OPERATIONS ***/
+
+// Delete removes the custom unit identified by id, as long as no ingredient, grocery or pantry item uses it.
+// The units of the catalogue can't be deleted.
+func (s *UnitService) Delete(id int64) error {
+	unit, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if unit.GroupID == nil {
+		return customErrors.NewForbiddenError(nil)
+	}
+
+	return s.repo.Delete(id)
+}
+
+/*** HELPER FUNCTIONS ***/
+
+// validateUnit trims the name of the unit, checks its fields
+// and ensures neither the catalogue nor the group already has a unit with the same name, whatever its case.
+func (s *UnitService) validateUnit(unit *model.Unit) error {
+	unit.Name = strings.TrimSpace(unit.Name)
+
+	e := customErrors.NewInvalidParamsError([]string{}, nil).(*customErrors.InvalidParamsError)
+
+	if unit.Name == "" || len(unit.Name) > 100 {
+		e.AddInvalidField("name")
+	}
+	if unit.Factor <= 0 {
+		e.AddInvalidField("factor")
+	}
+	// Caught when the field unit_type is omitted in the JSON body (set to the zero value, an empty string)
+	if unit.UnitType.String() == "" {
+		e.AddInvalidField("unit_type")
+	}
+
+	if len(e.Fields) > 0 {
+		return e
+	}
+
+	existing, err := s.repo.GetByNameAndGroupID(unit.Name, *unit.GroupID)
+	if err != nil {
+		if _, isNotFoundError := errors.AsType[*customErrors.NotFoundError](err); isNotFoundError {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != unit.ID {
+		return customErrors.NewConflictError("Unit", "a unit with this name already exists", nil)
+	}
+
+	return nil
+}
+
+// convertQuantity converts a quantity from a unit to another of the same unit type, through their factor to the base unit.
+func convertQuantity(quantity float64, from, to *model.Unit) (float64, error) {
+	if from.UnitType != to.UnitType {
+		return 0, customErrors.NewValidationError("unit_type", customErrors.UNIT_CONVERSION_ERROR, nil)
+	}
+
+	return quantity * from.Factor / to.Factor, nil
+}
+
+// readableUnits are the names of the units of the catalogue between which quantities are made readable:
+// the metric units commonly written in recipes, leaving out the ones such as centiliters or deciliters.
+var readableUnits = map[string]bool{
+	"Milligram":  true,
+	"Gram":       true,
+	"Kilogram":   true,
+	"Milliliter": true,
+	"Liter":      true,
+}
+
+// readableQuantity expresses a positive quantity in the largest unit of the same unit type in which it is at least one,
+// or in the smallest unit of the unit type when it is too small for any of them.
+// Only the readableUnits of the catalogue are considered, e.g. milligram, gram and kilogram, so that a quantity never
+// moves to cups or pounds, nor 250 ml to 2.5 dl; quantities in other units are left as they are.
+func readableQuantity(quantity float64, unit *model.Unit, units []model.Unit) (float64, model.Unit) {
+	if quantity <= 0 || unit.Factor <= 0 || !isReadableUnit(unit) {
+		return quantity, *unit
+	}
+
+	baseQuantity := quantity * unit.Factor
+	smallestUnit := *unit
+	var readableUnit *model.Unit
+
+	for i := range units {
+		candidate := &units[i]
+		if candidate.UnitType != unit.UnitType || candidate.Factor <= 0 || !isReadableUnit(candidate) {
+			continue
+		}
+
+		if candidate.Factor < smallestUnit.Factor {
+			smallestUnit = *candidate
+		}
+		// The tolerance keeps e.g. 0.001 kg in grams despite the rounding of its conversion.
+		if baseQuantity >= candidate.Factor*(1-1e-9) && (readableUnit == nil || candidate.Factor > readableUnit.Factor) {
+			readableUnit = candidate
+		}
+	}
+
+	if readableUnit == nil {
+		readableUnit = &smallestUnit
+	}
+
+	converted, _ := convertQuantity(quantity, unit, readableUnit)
+	return converted, *readableUnit
+}
+
+// isReadableUnit tells whether a unit is one of the readableUnits of the catalogue.
+func isReadableUnit(unit *model.Unit) bool {
+	return unit.GroupID == nil && readableUnits[unit.Name]
+}
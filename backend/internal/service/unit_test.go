@@ -0,0 +1,356 @@
+package service
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	customErrors "github.com/zouipo/yumsday/backend/internal/error"
+	"github.com/zouipo/yumsday/backend/internal/model"
+	"github.com/zouipo/yumsday/backend/internal/model/enum"
+	"github.com/zouipo/yumsday/backend/internal/pkg/utils"
+)
+
+type MockUnitRepository struct {
+	units     []model.Unit
+	createErr error
+	deleteErr error
+	created   *model.Unit
+	updated   *model.Unit
+	deletedID int64
+}
+
+func (m *MockUnitRepository) GetByID(id int64) (*model.Unit, error) {
+	for i := range m.units {
+		if m.units[i].ID == id {
+			return &m.units[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("units", "id", nil)
+}
+
+func (m *MockUnitRepository) GetByGroupID(groupID int64) ([]model.Unit, error) {
+	units := []model.Unit{}
+	for _, unit := range m.units {
+		if unit.GroupID == nil || *unit.GroupID == groupID {
+			units = append(units, unit)
+		}
+	}
+	return units, nil
+}
+
+func (m *MockUnitRepository) GetByNameAndGroupID(name string, groupID int64) (*model.Unit, error) {
+	for i := range m.units {
+		if strings.EqualFold(m.units[i].Name, name) && (m.units[i].GroupID == nil || *m.units[i].GroupID == groupID) {
+			return &m.units[i], nil
+		}
+	}
+	return nil, customErrors.NewNotFoundError("units", "name, group_id", nil)
+}
+
+func (m *MockUnitRepository) Create(unit *model.Unit) (int64, error) {
+	if m.createErr != nil {
+		return 0, m.createErr
+	}
+
+	m.created = unit
+	return 42, nil
+}
+
+func (m *MockUnitRepository) Update(unit *model.Unit) error {
+	m.updated = unit
+	return nil
+}
+
+func (m *MockUnitRepository) Delete(id int64) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	m.deletedID = id
+	return nil
+}
+
+var unitKilogram = model.Unit{ID: 1, Name: "Kilogram", Factor: 1000, UnitType: enum.Weight}
+
+func setUpUnitServiceData() (*UnitService, *MockUnitRepository) {
+	repo := &MockUnitRepository{units: []model.Unit{unitKilogram, unitGram, unitPiece, unitHandful}}
+	return NewUnitService(repo), repo
+}
+
+func TestNewUnitService(t *testing.T) {
+	repo := &MockUnitRepository{}
+
+	service := NewUnitService(repo)
+
+	if service == nil {
+		t.Fatal("NewUnitService() returned nil")
+	}
+	if service.repo != repo {
+		t.Error("NewUnitService() did not set the repository")
+	}
+}
+
+func TestGetUnitsByGroupID(t *testing.T) {
+	service, _ := setUpUnitServiceData()
+
+	tests := []struct {
+		name          string
+		groupID       int64
+		expectedCount int
+	}{
+		{"Catalogue and custom units", group2.ID, 4},
+		{"Catalogue only", group1.ID, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			units, err := service.GetByGroupID(tt.groupID)
+			if err != nil {
+				t.Fatalf("GetByGroupID() unexpected error = %v", err)
+			}
+			if len(units) != tt.expectedCount {
+				t.Errorf("GetByGroupID() returned %d units, want %d", len(units), tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestConvertUnit(t *testing.T) {
+	service, _ := setUpUnitServiceData()
+
+	tests := []struct {
+		name     string
+		quantity float64
+		from     model.Unit
+		to       model.Unit
+		expected float64
+		err      error
+	}{
+		{"Larger to smaller unit", 1.5, unitKilogram, unitGram, 1500, nil},
+		{"Smaller to larger unit", 250, unitGram, unitKilogram, 0.25, nil},
+		{"Custom unit", 2, unitHandful, unitGram, 60, nil},
+		{"Same unit", 3, unitPiece, unitPiece, 3, nil},
+		{"Different unit types", 1, unitGram, unitPiece, 0, customErrors.NewValidationError("unit_type", customErrors.UNIT_CONVERSION_ERROR, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := service.Convert(tt.quantity, &tt.from, &tt.to)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Convert() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Convert() unexpected error = %v", err)
+			}
+			if actual != tt.expected {
+				t.Errorf("Convert() = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReadableQuantity(t *testing.T) {
+	unitMilligram := model.Unit{ID: 12, Name: "Milligram", Factor: 0.001, UnitType: enum.Weight}
+	unitOunce := model.Unit{ID: 13, Name: "Ounce", Factor: 28.349523125, UnitType: enum.Weight}
+	unitDozen := model.Unit{ID: 21, Name: "Dozen", Factor: 12, UnitType: enum.Piece}
+	unitLiter := model.Unit{ID: 3, Name: "Liter", Factor: 1000, UnitType: enum.Volume}
+	unitCentiliter := model.Unit{ID: 15, Name: "Centiliter", Factor: 10, UnitType: enum.Volume}
+	unitDeciliter := model.Unit{ID: 16, Name: "Deciliter", Factor: 100, UnitType: enum.Volume}
+	units := []model.Unit{unitKilogram, unitGram, unitPiece, unitHandful, unitMilligram, unitOunce, unitDozen,
+		unitLiter, unitMilliliter, unitCentiliter, unitDeciliter}
+
+	tests := []struct {
+		name             string
+		quantity         float64
+		unit             model.Unit
+		expectedQuantity float64
+		expectedUnit     model.Unit
+	}{
+		{"Larger unit", 1500, unitGram, 1.5, unitKilogram},
+		{"Smaller unit", 0.25, unitKilogram, 250, unitGram},
+		{"Exactly one larger unit", 0.001, unitKilogram, 1, unitGram},
+		{"Too small for every unit", 0.0005, unitGram, 0.5, unitMilligram},
+		{"Already readable", 250, unitGram, 250, unitGram},
+		{"Unit out of the metric system", 40, unitOunce, 40, unitOunce},
+		{"Custom unit", 100, unitHandful, 100, unitHandful},
+		{"Pieces are not counted in dozens", 24, unitPiece, 24, unitPiece},
+		{"Zero quantity", 0, unitKilogram, 0, unitKilogram},
+		{"Milliliters to liters", 1500, unitMilliliter, 1.5, unitLiter},
+		{"Liters to milliliters", 0.25, unitLiter, 250, unitMilliliter},
+		{"Milliliters not turned into deciliters", 250, unitMilliliter, 250, unitMilliliter},
+		{"Milliliters not turned into centiliters", 20, unitMilliliter, 20, unitMilliliter},
+		{"Centiliters left as they are", 25, unitCentiliter, 25, unitCentiliter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quantity, unit := readableQuantity(tt.quantity, &tt.unit, units)
+
+			if math.Abs(quantity-tt.expectedQuantity) > 1e-9 || unit != tt.expectedUnit {
+				t.Errorf("readableQuantity() = %v %s, want %v %s", quantity, unit.Name, tt.expectedQuantity, tt.expectedUnit.Name)
+			}
+		})
+	}
+}
+
+func TestCreateUnit(t *testing.T) {
+	tests := []struct {
+		name         string
+		unit         model.Unit
+		expectedName string
+		createErr    error
+		err          error
+	}{
+		{
+			name:         "Valid custom unit",
+			unit:         model.Unit{Name: " Bowl ", Factor: 250, UnitType: enum.Volume, GroupID: new(group1.ID)},
+			expectedName: "Bowl",
+		},
+		{
+			name:         "Name of a custom unit of another group",
+			unit:         model.Unit{Name: "Handful", Factor: 25, UnitType: enum.Weight, GroupID: new(group1.ID)},
+			expectedName: "Handful",
+		},
+		{
+			name: "Catalogue unit",
+			unit: model.Unit{Name: "Stone", Factor: 6350.29, UnitType: enum.Weight},
+			err:  customErrors.NewForbiddenError(nil),
+		},
+		{
+			name: "Invalid fields",
+			unit: model.Unit{Name: "", Factor: 0, GroupID: new(group1.ID)},
+			err:  customErrors.NewInvalidParamsError([]string{"name", "factor", "unit_type"}, nil),
+		},
+		{
+			name: "Name of a catalogue unit",
+			unit: model.Unit{Name: "gram", Factor: 1, UnitType: enum.Weight, GroupID: new(group1.ID)},
+			err:  customErrors.NewConflictError("Unit", "a unit with this name already exists", nil),
+		},
+		{
+			name:      "Repository error",
+			unit:      model.Unit{Name: "Bowl", Factor: 250, UnitType: enum.Volume, GroupID: new(int64(-1))},
+			createErr: customErrors.NewNotFoundError("groups", "id", nil),
+			err:       customErrors.NewNotFoundError("groups", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpUnitServiceData()
+			repo.createErr = tt.createErr
+
+			id, err := service.Create(&tt.unit)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Create() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+			if id != 42 || repo.created.Name != tt.expectedName {
+				t.Errorf("Create() created %+v with id %d, want name %q", repo.created, id, tt.expectedName)
+			}
+		})
+	}
+}
+
+func TestUpdateUnit(t *testing.T) {
+	tests := []struct {
+		name string
+		unit model.Unit
+		err  error
+	}{
+		{
+			name: "Custom unit",
+			unit: model.Unit{ID: unitHandful.ID, Name: "Big handful", Factor: 50, UnitType: enum.Weight},
+		},
+		{
+			name: "Catalogue unit",
+			unit: model.Unit{ID: unitGram.ID, Name: "Grams", Factor: 1, UnitType: enum.Weight},
+			err:  customErrors.NewForbiddenError(nil),
+		},
+		{
+			name: "Name of a catalogue unit",
+			unit: model.Unit{ID: unitHandful.ID, Name: "Kilogram", Factor: 50, UnitType: enum.Weight},
+			err:  customErrors.NewConflictError("Unit", "a unit with this name already exists", nil),
+		},
+		{
+			name: "Unknown unit",
+			unit: model.Unit{ID: -1, Name: "Mug", Factor: 300, UnitType: enum.Volume},
+			err:  customErrors.NewNotFoundError("units", "id", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpUnitServiceData()
+
+			err := service.Update(&tt.unit)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Update() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Update() unexpected error = %v", err)
+			}
+			if repo.updated.GroupID == nil || *repo.updated.GroupID != group2.ID {
+				t.Errorf("Update() should keep the group of the unit, got %v", repo.updated.GroupID)
+			}
+		})
+	}
+}
+
+func TestDeleteUnit(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        int64
+		deleteErr error
+		err       error
+	}{
+		{"Custom unit", unitHandful.ID, nil, nil},
+		{"Catalogue unit", unitGram.ID, nil, customErrors.NewForbiddenError(nil)},
+		{
+			"Unit in use", unitHandful.ID,
+			customErrors.NewConflictError("Unit", "can't delete unit used by ingredients, groceries or the pantry", nil),
+			customErrors.NewConflictError("Unit", "can't delete unit used by ingredients, groceries or the pantry", nil),
+		},
+		{"Unknown unit", -1, nil, customErrors.NewNotFoundError("units", "id", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := setUpUnitServiceData()
+			repo.deleteErr = tt.deleteErr
+
+			err := service.Delete(tt.id)
+
+			if tt.err != nil {
+				if !utils.CompareErrors(err, tt.err) {
+					t.Fatalf("Delete() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Delete() unexpected error = %v", err)
+			}
+			if repo.deletedID != tt.id {
+				t.Errorf("Delete() deleted %d, want %d", repo.deletedID, tt.id)
+			}
+		})
+	}
+}
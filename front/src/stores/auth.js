@@ -1,6 +1,16 @@
 import {defineStore} from 'pinia'
 import {ref, computed} from 'vue'
 
+// Returns the header carrying the CSRF token of the session, required by the server on unsafe requests.
+// The token is read from the cookie set by the server; there's none before the first response.
+export function csrfHeader() {
+    const cookie = document.cookie
+        .split('; ')
+        .find((c) => c.startsWith('yumsday_csrf='))
+
+    return cookie ? { 'X-CSRF-Token': cookie.slice('yumsday_csrf='.length) } : {}
+}
+
 export const useAuthStore = defineStore('auth', () => {
     const user = ref(null)
 
@@ -11,7 +21,7 @@ export const useAuthStore = defineStore('auth', () => {
         const res = await fetch('/auth/login', {
             method: 'POST',
             credentials: 'include', // send and receive cookies; not necessary for same-origin requests but good practice
-            headers: { 'Content-Type': 'application/json' },
+            headers: { 'Content-Type': 'application/json', ...csrfHeader() },
             body: JSON.stringify({ username, password }),
         })
 
@@ -48,6 +58,7 @@ export const useAuthStore = defineStore('auth', () => {
             await fetch('/auth/logout', {
                 method: 'POST',
                 credentials: 'include',
+                headers: csrfHeader(),
             })
         } finally {
             user.value = null
@@ -64,6 +64,10 @@ export default defineConfig({
         target: backendTarget,
         changeOrigin: true,
       },
+      '/public': {
+        target: backendTarget,
+        changeOrigin: true,
+      },
     },
   },
 })
@@ -103,9 +103,15 @@ func run(cmd *cobra.Command, args []string) {
 	// like the persistence of sessions in the db.
 	var tasksWG sync.WaitGroup
 
+	handler, err := backend.NewAPIServer(db, migrationsFs, &tasksWG)
+	if err != nil {
+		slog.Error("Failed to migrate db", "error", err)
+		return
+	}
+
 	server := &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", cfg.Host, cfg.Port), // TCP address to listen on, in the form "host:port"
-		Handler: backend.NewAPIServer(db, migrationsFs, &tasksWG),
+		Handler: handler,
 	}
 
 	// Goroutine waiting for a signal from the OS to shut "gracefully" the server and its working goroutines.